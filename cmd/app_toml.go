@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/JohnnyMcGee/metadef/core"
+	"github.com/hjson/hjson-go/v4"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Convert definitions from other formats",
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Convert definitions to other formats",
+}
+
+var importAppTomlCmd = &cobra.Command{
+	Use:   "app-toml <shopify.app.toml>",
+	Short: "Convert the metaobject and metafield definitions of a Shopify app configuration file",
+	Long: `Convert the metaobject definitions declared under metaobjects.app in a
+Shopify app configuration file to a definitions file of $app: types.
+
+Metafield definitions declared under <owner>.metafields.app are written to the
+file given with --metafield-definitions, as definitions in the $app namespace.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log.Printf("Importing definitions from app configuration %s\n", args[0])
+
+		input, err := os.ReadFile(args[0])
+		if err != nil {
+			log.Fatalf("Error reading app configuration: %v\n", err)
+			return err
+		}
+
+		app, err := core.ParseAppToml(input)
+		if err != nil {
+			log.Fatalf("Error parsing app configuration: %v\n", err)
+			return err
+		}
+
+		definitions, err := core.ImportAppToml(app)
+		if err != nil {
+			log.Fatalf("Error converting definitions: %v\n", err)
+			return err
+		}
+
+		metafieldDefinitions, err := core.ImportAppTomlMetafields(app)
+		if err != nil {
+			log.Fatalf("Error converting metafield definitions: %v\n", err)
+			return err
+		}
+
+		if metafieldDefinitionsFile != "" {
+			payload, err := hjson.Marshal(metafieldDefinitions)
+			if err != nil {
+				log.Fatalf("Error marshalling data: %v\n", err)
+				return err
+			}
+
+			os.WriteFile(metafieldDefinitionsFile, payload, 0644)
+		} else if len(metafieldDefinitions) > 0 {
+			log.Printf("Skipped the metafield definitions, use --metafield-definitions to write them to a file\n")
+		}
+
+		payload, err := hjson.Marshal(definitions)
+		if err != nil {
+			log.Fatalf("Error marshalling data: %v\n", err)
+			return err
+		}
+
+		if outFile != "" {
+			os.WriteFile(outFile, payload, 0644)
+		} else {
+			log.Printf("%s\n", payload)
+		}
+
+		return nil
+	},
+}
+
+var exportAppTomlCmd = &cobra.Command{
+	Use:   "app-toml <definitions file>",
+	Short: "Convert $app: metaobject and metafield definitions to Shopify app configuration",
+	Long: `Convert the $app: definitions of a definitions file to the metaobjects.app
+tables of a Shopify app configuration file, to be merged into shopify.app.toml.
+Definitions of other types are skipped.
+
+The $app namespace definitions of the metafield definitions file given with
+--metafield-definitions are converted to <owner>.metafields.app tables too.
+Definitions in other namespaces are skipped.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log.Printf("Exporting definitions from file %s\n", args[0])
+
+		definitions := readLocalDefinitions(args[0])
+
+		app, skipped, err := core.ExportAppToml(definitions)
+		if err != nil {
+			log.Fatalf("Error converting definitions: %v\n", err)
+			return err
+		}
+
+		for _, defType := range skipped {
+			log.Printf("Skipped definition %s, which isn't an $app: type\n", defType)
+		}
+
+		if metafieldDefinitionsFile != "" {
+			owners, err := readLocalMetafieldDefinitions(metafieldDefinitionsFile)
+			if err != nil {
+				log.Fatalf("Error reading local metafield definitions: %v\n", err)
+				return err
+			}
+
+			metafields, skipped, err := core.ExportAppTomlMetafields(owners)
+			if err != nil {
+				log.Fatalf("Error converting metafield definitions: %v\n", err)
+				return err
+			}
+
+			for _, key := range skipped {
+				log.Printf("Skipped metafield definition %s, which isn't in the $app namespace\n", key)
+			}
+
+			app.Metafields = metafields
+		}
+
+		payload, err := core.MarshalAppToml(app)
+		if err != nil {
+			log.Fatalf("Error marshalling data: %v\n", err)
+			return err
+		}
+
+		if outFile != "" {
+			os.WriteFile(outFile, payload, 0644)
+		} else {
+			log.Printf("%s\n", payload)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	importAppTomlCmd.Flags().StringVar(&metafieldDefinitionsFile, "metafield-definitions", "", "Metafield definitions file to write the app's metafield definitions to")
+	exportAppTomlCmd.Flags().StringVar(&metafieldDefinitionsFile, "metafield-definitions", "", "Metafield definitions file whose $app definitions are converted too")
+
+	importCmd.AddCommand(importAppTomlCmd)
+	exportCmd.AddCommand(exportAppTomlCmd)
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/JohnnyMcGee/metadef/core"
+	"github.com/spf13/cobra"
+)
+
+var metafieldDefinitionsFile string
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Audit the metaobjects in the Shopify store",
+}
+
+var auditReferencesCmd = &cobra.Command{
+	Use:   "references <definitions file>",
+	Short: "Report broken and duplicate references, and types no reference can reach",
+	Long: `Scan the store entries of the defined types and report reference fields
+pointing at deleted metaobjects, products, variants or files, and list
+references holding the same resource more than once.
+
+Types with entries which no reference field in the definitions file can point
+at are reported as unreachable, unless they have the online store capability.
+Pass the metafield definitions file with --metafield-definitions so types only
+referenced from product, collection or other metafields count as reachable.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Auditing references in shop %s\n", shop)
+
+		definitions := readLocalDefinitions(args[0])
+
+		var metafieldDefinitions map[string]core.MetafieldOwnerDefinitions
+		if metafieldDefinitionsFile != "" {
+			var err error
+			metafieldDefinitions, err = readLocalMetafieldDefinitions(metafieldDefinitionsFile)
+			if err != nil {
+				log.Fatalf("Error reading local metafield definitions: %v\n", err)
+				return err
+			}
+		}
+
+		es := newEntryService()
+
+		audit, err := es.AuditReferences(definitions, metafieldDefinitions)
+		if err != nil {
+			log.Fatalf("Error auditing references: %v\n", err)
+			return err
+		}
+
+		for _, i := range audit.Dangling {
+			fmt.Printf("Dangling reference %s\n", i)
+		}
+
+		for _, i := range audit.Duplicates {
+			fmt.Printf("Duplicate reference %s\n", i)
+		}
+
+		for _, t := range audit.Unreachable {
+			fmt.Printf("Unreachable type %s: %d entries\n", t.Type, t.Entries)
+		}
+
+		issues := len(audit.Dangling) + len(audit.Duplicates) + len(audit.Unreachable)
+		if issues > 0 {
+			log.Fatalf("Found %d reference issues\n", issues)
+		}
+
+		log.Printf("No reference issues\n")
+
+		return nil
+	},
+}
+
+func init() {
+	auditReferencesCmd.Flags().StringVar(&metafieldDefinitionsFile, "metafield-definitions", "", "Metafield definitions file whose references also reach types")
+
+	auditCmd.AddCommand(auditReferencesCmd)
+}
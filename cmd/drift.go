@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/JohnnyMcGee/metadef/core"
+	"github.com/spf13/cobra"
+)
+
+var driftJson bool
+
+var driftCmd = &cobra.Command{
+	Use:   "drift <file>",
+	Short: "Compare local metaobject definitions with every configured shop",
+	Long: `Compare local metaobject definitions with every shop in the config file at
+once, and print a matrix of types by shops. Each type is in-sync, changed,
+missing from the shop, or extra in the shop. Exits with an error when any shop
+drifted or couldn't be compared.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Comparing definitions from file %s with %d shops\n", args[0], len(config.Shops))
+
+		definitions := readLocalDefinitions(args[0])
+
+		services := make(map[string]*core.MetaobjectService, len(config.Shops))
+		for s := range config.Shops {
+			ms, err := newMetaobjectService(s)
+			if err != nil {
+				log.Fatalf("Error comparing definitions: %v\n", err)
+				return err
+			}
+
+			services[s] = ms
+		}
+
+		results := core.DetectDrift(services, definitions)
+
+		if driftJson {
+			payload, err := json.MarshalIndent(results, "", "  ")
+			if err != nil {
+				log.Fatalf("Error marshalling data: %v\n", err)
+				return err
+			}
+
+			fmt.Println(string(payload))
+		} else {
+			printDrift(results)
+		}
+
+		drifted := 0
+		for _, r := range results {
+			if r.Drifted() {
+				drifted++
+			}
+		}
+
+		if drifted > 0 {
+			log.Fatalf("%d of %d shops drifted from %s\n", drifted, len(results), args[0])
+		}
+
+		return nil
+	},
+}
+
+func printDrift(results []core.ShopDrift) {
+	typeSet := make(map[string]bool)
+	for _, r := range results {
+		for defType := range r.Types {
+			typeSet[defType] = true
+		}
+	}
+
+	types := make([]string, 0, len(typeSet))
+	for defType := range typeSet {
+		types = append(types, defType)
+	}
+	sort.Strings(types)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	header := []string{"TYPE"}
+	for _, r := range results {
+		header = append(header, r.Shop)
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for _, defType := range types {
+		row := []string{defType}
+		for _, r := range results {
+			switch {
+			case r.Error != "":
+				row = append(row, "error")
+			case r.Types[defType] == "":
+				row = append(row, "-")
+			default:
+				row = append(row, string(r.Types[defType]))
+			}
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	w.Flush()
+
+	for _, r := range results {
+		if r.Error != "" {
+			fmt.Printf("\n%s: %s\n", r.Shop, r.Error)
+		}
+	}
+}
+
+func init() {
+	driftCmd.Flags().BoolVar(&driftJson, "json", false, "Print the results as JSON")
+}
@@ -0,0 +1,670 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JohnnyMcGee/metadef/core"
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/spf13/cobra"
+)
+
+var (
+	entriesDir string
+	bulk       bool
+	prune      bool
+	force      bool
+	locales    []string
+	count      int
+	seed       int64
+	script     string
+	dryRun     bool
+	resolve    bool
+)
+
+var entriesCmd = &cobra.Command{
+	Use:   "entries",
+	Short: "Manage metaobject entries",
+	Long: `Pull, diff and push metaobject entries. Entries are stored one file per
+metaobject in the entries directory, grouped by type: <dir>/<type>/<handle>.hjson
+`,
+}
+
+func init() {
+	entriesCmd.PersistentFlags().StringVarP(&entriesDir, "dir", "d", "entries", "Entries directory")
+	entriesPushCmd.Flags().BoolVar(&bulk, "bulk", false, "Upsert entries with a bulk operation, for large imports")
+	entriesPushCmd.Flags().BoolVar(&prune, "prune", false, "Delete store entries which have no local entry file")
+	entriesPushCmd.Flags().BoolVar(&force, "force", false, "Prune entries even when they are still referenced")
+	entriesPushCmd.Flags().BoolVarP(&yes, "yes", "y", false, "Delete pruned entries without asking for confirmation")
+
+	entriesCmd.AddCommand(entriesPullCmd)
+	entriesCmd.AddCommand(entriesDiffCmd)
+	entriesCmd.AddCommand(entriesPushCmd)
+	entriesCmd.AddCommand(entriesValidateCmd)
+	entriesCmd.AddCommand(entriesTranslationsCmd)
+	entriesCmd.AddCommand(entriesPublishCmd)
+	entriesCmd.AddCommand(entriesUnpublishCmd)
+	entriesCmd.AddCommand(entriesGenerateCmd)
+	entriesCmd.AddCommand(entriesTransformCmd)
+	entriesCmd.AddCommand(entriesUrlCollisionsCmd)
+
+	entriesValidateCmd.Flags().BoolVar(&resolve, "resolve", false, "Look up references which can't be checked locally in the store")
+
+	entriesTransformCmd.Flags().StringVarP(&script, "script", "f", "", "Transform script file")
+	entriesTransformCmd.MarkFlagRequired("script")
+	entriesTransformCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the changes without upserting them")
+	entriesTransformCmd.Flags().BoolVarP(&yes, "yes", "y", false, "Upsert the changes without asking for confirmation")
+
+	entriesGenerateCmd.Flags().IntVarP(&count, "count", "n", 10, "Number of entries to generate")
+	entriesGenerateCmd.Flags().Int64Var(&seed, "seed", 0, "Random seed, to generate the same entries again (default random)")
+
+	entriesTranslationsCmd.PersistentFlags().StringSliceVarP(&locales, "locale", "l", nil, "Locales to translate, defaults to every locale except the primary locale")
+	entriesTranslationsCmd.AddCommand(entriesTranslationsPullCmd)
+	entriesTranslationsCmd.AddCommand(entriesTranslationsPushCmd)
+	entriesTranslationsCmd.AddCommand(entriesTranslationsMissingCmd)
+}
+
+func newEntryService() *core.MetaobjectEntryService {
+	client := shopify.NewShopifyAdminClient(shop, config.Shops[shop], config.Version)
+	return &core.MetaobjectEntryService{ShopifyClient: &client, Dir: entriesDir, AppId: config.Apps[shop]}
+}
+
+var entriesPullCmd = &cobra.Command{
+	Use:   "pull [type...]",
+	Short: "Pull metaobject entries from the Shopify store",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Pulling entries from shop %s to directory %s\n", shop, entriesDir)
+
+		es := newEntryService()
+
+		entries, err := es.Pull(args)
+		if err != nil {
+			log.Fatalf("Error pulling entries: %v\n", err)
+			return err
+		}
+
+		if err := core.WriteEntryDirectory(entriesDir, entries); err != nil {
+			log.Fatalf("Error writing entries: %v\n", err)
+			return err
+		}
+
+		return nil
+	},
+}
+
+var entriesDiffCmd = &cobra.Command{
+	Use:   "diff [type...]",
+	Short: "Compare local metaobject entries with the Shopify store",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Diffing entries from directory %s to shop %s\n", entriesDir, shop)
+
+		entries, err := core.ReadEntryDirectory(entriesDir, args)
+		if err != nil {
+			log.Fatalf("Error reading local entries: %v\n", err)
+		}
+
+		diffMap, err := newEntryService().Diff(entries)
+		if err != nil {
+			log.Fatalf("Error diffing entries: %v\n", err)
+			return err
+		}
+
+		printDiffs(diffMap)
+
+		return nil
+	},
+}
+
+var entriesPushCmd = &cobra.Command{
+	Use:   "push [type...]",
+	Short: "Push local metaobject entries to the Shopify store",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Pushing entries from directory %s to shop %s\n", entriesDir, shop)
+
+		entries, err := core.ReadEntryDirectory(entriesDir, args)
+		if err != nil {
+			log.Fatalf("Error reading local entries: %v\n", err)
+		}
+
+		es := newEntryService()
+
+		if bulk {
+			err = bulkPushEntries(es, entries)
+		} else {
+			err = es.Push(entries)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if prune {
+			return pruneEntries(es, entries)
+		}
+
+		return nil
+	},
+}
+
+func bulkPushEntries(es *core.MetaobjectEntryService, entries map[string]map[string]core.MetaobjectEntry) error {
+	results, err := es.BulkPush(entries)
+
+	failed := 0
+	for _, r := range results {
+		if len(r.UserErrors) > 0 {
+			failed++
+			log.Printf("Error upserting entry %s: %v\n", core.EntryKey(r.Type, r.Handle), r.UserErrors)
+		}
+	}
+
+	log.Printf("Bulk import finished: %d upserted, %d failed\n", len(results)-failed, failed)
+
+	if err != nil {
+		log.Fatalf("Error running bulk import: %v\n", err)
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d entries failed to upsert", failed, len(results))
+	}
+
+	return nil
+}
+
+// pruneEntries deletes store entries which have no local entry file. Entries
+// still referenced by other metaobjects or metafields are kept unless forced.
+func pruneEntries(es *core.MetaobjectEntryService, entries map[string]map[string]core.MetaobjectEntry) error {
+	prunes, err := es.PlanPrune(entries)
+	if err != nil {
+		log.Fatalf("Error planning prune: %v\n", err)
+		return err
+	}
+
+	if len(prunes) == 0 {
+		log.Printf("No entries to prune\n")
+		return nil
+	}
+
+	deletes := make([]core.EntryPrune, 0, len(prunes))
+
+	fmt.Println()
+	fmt.Println("Entries to delete:")
+	for _, p := range prunes {
+		key := core.EntryKey(p.Type, p.Handle)
+
+		if len(p.ReferencedBy) == 0 {
+			fmt.Printf("  \x1b[31m- %s\x1b[0m\n", key)
+			deletes = append(deletes, p)
+			continue
+		}
+
+		if force {
+			fmt.Printf("  \x1b[31m- %s\x1b[0m (referenced by %s)\n", key, strings.Join(p.ReferencedBy, ", "))
+			deletes = append(deletes, p)
+		} else {
+			fmt.Printf("  \x1b[33m! %s\x1b[0m skipped, referenced by %s\n", key, strings.Join(p.ReferencedBy, ", "))
+		}
+	}
+	fmt.Println()
+
+	if len(deletes) == 0 {
+		log.Printf("No unreferenced entries to prune, use --force to delete referenced entries\n")
+		return nil
+	}
+
+	if !yes && !confirm(fmt.Sprintf("Delete %d entries from shop %s?", len(deletes), shop)) {
+		log.Printf("Prune cancelled\n")
+		return nil
+	}
+
+	return es.Prune(deletes)
+}
+
+var entriesValidateCmd = &cobra.Command{
+	Use:   "validate <definitions file> [type...]",
+	Short: "Validate local metaobject entries against local definitions",
+	Long: `Validate local metaobject entries against local definitions without contacting
+the store. Metaobject references to types without local entries can't be
+checked and are reported as warnings.
+
+With --resolve, product, collection, variant and file references, and
+metaobject references to types without local entries, are looked up in the
+store and reported when they don't exist.
+`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		definitions := readLocalDefinitions(args[0])
+
+		entries, err := core.ReadEntryDirectory(entriesDir, nil)
+		if err != nil {
+			log.Fatalf("Error reading local entries: %v\n", err)
+		}
+
+		violations := core.ValidateEntries(definitions, entries, args[1:])
+
+		if resolve {
+			initDefaults()
+			log.Printf("Using config file %s\n", configFile)
+			log.Printf("Looking up references in shop %s\n", shop)
+
+			es := newEntryService()
+
+			unresolved, err := es.ValidateReferences(definitions, entries, args[1:])
+			if err != nil {
+				log.Fatalf("Error looking up references: %v\n", err)
+				return err
+			}
+
+			// The references which couldn't be checked locally were looked up.
+			checked := make([]core.EntryViolation, 0, len(violations)+len(unresolved))
+			for _, v := range violations {
+				if !v.Warning {
+					checked = append(checked, v)
+				}
+			}
+			violations = append(checked, unresolved...)
+		}
+
+		invalid := 0
+		for _, v := range violations {
+			path := filepath.Join(entriesDir, v.Type)
+			if v.Handle != "" {
+				path = core.EntryFilePath(entriesDir, v.Type, v.Handle)
+			}
+
+			if line := core.FindEntryFieldLine(path, v.Field); line > 0 {
+				path = fmt.Sprintf("%s:%d", path, line)
+			}
+
+			message := v.Message
+			if v.Warning {
+				message = "warning: " + message
+			} else {
+				invalid++
+			}
+
+			if v.Field == "" {
+				fmt.Printf("%s: %s\n", path, message)
+			} else {
+				fmt.Printf("%s: %s: %s\n", path, v.Field, message)
+			}
+		}
+
+		if invalid > 0 {
+			log.Fatalf("Found %d validation errors\n", invalid)
+		}
+
+		log.Printf("All entries are valid\n")
+
+		return nil
+	},
+}
+
+var entriesTranslationsCmd = &cobra.Command{
+	Use:   "translations",
+	Short: "Manage translations of translatable metaobject entries",
+	Long: `Pull and push translations of metaobject entries. Translations are stored next
+to the entry file, one file per locale: <dir>/<type>/<handle>.<locale>.hjson
+`,
+}
+
+// translationTargets returns the types and locales a translations command
+// applies to, defaulting to every translatable type and every locale other
+// than the shop's primary locale.
+func translationTargets(es *core.MetaobjectEntryService, types []string) ([]string, []string) {
+	var err error
+
+	if len(types) == 0 {
+		types, err = es.TranslatableTypes()
+		if err != nil {
+			log.Fatalf("Error listing translatable types: %v\n", err)
+		}
+	}
+
+	languages := locales
+	if len(languages) == 0 {
+		languages, err = es.Locales()
+		if err != nil {
+			log.Fatalf("Error listing locales: %v\n", err)
+		}
+	}
+
+	return types, languages
+}
+
+var entriesTranslationsPullCmd = &cobra.Command{
+	Use:   "pull [type...]",
+	Short: "Pull entry translations from the Shopify store",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Pulling translations from shop %s to directory %s\n", shop, entriesDir)
+
+		es := newEntryService()
+		types, languages := translationTargets(es, args)
+
+		translations, err := es.PullTranslations(types, languages)
+		if err != nil {
+			log.Fatalf("Error pulling translations: %v\n", err)
+			return err
+		}
+
+		for defType, typeTranslations := range translations {
+			for handle, entryTranslations := range typeTranslations {
+				for locale, t := range entryTranslations {
+					path := core.TranslationFilePath(entriesDir, defType, handle, locale)
+					if err := core.WriteTranslationFile(path, t); err != nil {
+						log.Fatalf("Error writing translations: %v\n", err)
+						return err
+					}
+				}
+			}
+		}
+
+		return nil
+	},
+}
+
+var entriesTranslationsPushCmd = &cobra.Command{
+	Use:   "push [type...]",
+	Short: "Push local entry translations to the Shopify store",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Pushing translations from directory %s to shop %s\n", entriesDir, shop)
+
+		translations, err := core.ReadTranslationDirectory(entriesDir, args)
+		if err != nil {
+			log.Fatalf("Error reading local translations: %v\n", err)
+		}
+
+		return newEntryService().PushTranslations(translations)
+	},
+}
+
+var entriesTranslationsMissingCmd = &cobra.Command{
+	Use:   "missing [type...]",
+	Short: "Report missing and outdated entry translations per locale and field",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Checking translations in shop %s\n", shop)
+
+		es := newEntryService()
+		types, languages := translationTargets(es, args)
+
+		missing, err := es.MissingTranslations(types, languages)
+		if err != nil {
+			log.Fatalf("Error checking translations: %v\n", err)
+			return err
+		}
+
+		// Group by locale, then by type and field.
+		report := make(map[string]map[string][]string)
+		for _, m := range missing {
+			if report[m.Locale] == nil {
+				report[m.Locale] = make(map[string][]string)
+			}
+
+			field := m.Type + "." + m.Field
+			handle := m.Handle
+			if m.Outdated {
+				handle += " (outdated)"
+			}
+
+			report[m.Locale][field] = append(report[m.Locale][field], handle)
+		}
+
+		for _, locale := range languages {
+			fields := report[locale]
+
+			count := 0
+			for _, handles := range fields {
+				count += len(handles)
+			}
+
+			fmt.Println()
+			fmt.Printf("%s: %d missing\n", locale, count)
+			fmt.Println("---------------------------------")
+
+			keys := make([]string, 0, len(fields))
+			for field := range fields {
+				keys = append(keys, field)
+			}
+			sort.Strings(keys)
+
+			for _, field := range keys {
+				handles := fields[field]
+				sort.Strings(handles)
+				fmt.Printf("%s: %d entries: %s\n", field, len(handles), strings.Join(handles, ", "))
+			}
+		}
+
+		return nil
+	},
+}
+
+// setEntryStatus sets the status of entries in the store, and in their local
+// entry files when they exist, so the next push doesn't revert it. Only the
+// status line of the files is changed, keeping comments and formatting.
+func setEntryStatus(defType string, handles []string, status shopify.MetaobjectStatus) error {
+	initDefaults()
+	log.Printf("Using config file %s\n", configFile)
+	log.Printf("Setting status of %d %s entries in shop %s to %s\n", len(handles), defType, shop, status)
+
+	if err := newEntryService().SetStatus(defType, handles, status); err != nil {
+		log.Fatalf("Error setting entry status: %v\n", err)
+		return err
+	}
+
+	for _, handle := range handles {
+		path := core.EntryFilePath(entriesDir, defType, handle)
+
+		patched, err := core.SetEntryFileStatus(path, status)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			log.Fatalf("Error updating local entry: %v\n", err)
+			return err
+		}
+
+		if !patched {
+			log.Printf("Entry file %s is out of date, set its status to %s\n", path, status)
+		}
+	}
+
+	return nil
+}
+
+var entriesPublishCmd = &cobra.Command{
+	Use:   "publish <type> <handle...>",
+	Short: "Set the status of metaobject entries to ACTIVE",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setEntryStatus(args[0], args[1:], shopify.MetaobjectStatusActive)
+	},
+}
+
+var entriesUnpublishCmd = &cobra.Command{
+	Use:   "unpublish <type> <handle...>",
+	Short: "Set the status of metaobject entries to DRAFT",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setEntryStatus(args[0], args[1:], shopify.MetaobjectStatusDraft)
+	},
+}
+
+var entriesGenerateCmd = &cobra.Command{
+	Use:   "generate <definitions file> <type>",
+	Short: "Generate fake metaobject entries from a local definition",
+	Long: `Generate entry files with fake values for a metaobject type, to seed development
+stores. Values follow the field types and validations of the local definition,
+and metaobject references point at existing local entries. Push the generated
+entries with "metadef entries push".
+`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		definitions := readLocalDefinitions(args[0])
+		defType := args[1]
+
+		definition, ok := definitions[defType]
+		if !ok {
+			log.Fatalf("Error generating entries: metaobject definition %s not found in %s\n", defType, args[0])
+		}
+
+		entries, err := core.ReadEntryDirectory(entriesDir, nil)
+		if errors.Is(err, os.ErrNotExist) {
+			entries = nil
+		} else if err != nil {
+			log.Fatalf("Error reading local entries: %v\n", err)
+			return err
+		}
+
+		if !cmd.Flags().Changed("seed") {
+			seed = time.Now().UnixNano()
+		}
+		log.Printf("Generating %d %s entries with seed %d\n", count, defType, seed)
+
+		generated, err := core.NewEntryGenerator(seed, entries).Generate(defType, definition, count)
+		if err != nil {
+			log.Fatalf("Error generating entries: %v\n", err)
+			return err
+		}
+
+		if err := core.WriteEntryDirectory(entriesDir, map[string]map[string]core.MetaobjectEntry{defType: generated}); err != nil {
+			log.Fatalf("Error writing entries: %v\n", err)
+			return err
+		}
+
+		log.Printf("Wrote %d entries to %s\n", len(generated), filepath.Join(entriesDir, defType))
+
+		return nil
+	},
+}
+
+var entriesTransformCmd = &cobra.Command{
+	Use:   "transform <type>",
+	Short: "Transform the store entries of a type with a script",
+	Long: `Run a transform script on every store entry of a type, to reshape data after a
+definition change. Each line of the script assigns an expression to a field:
+
+  first_name = split(name, " ")[0]
+  last_name = join(slice(split(name, " "), 1), " ")
+  delete name
+
+The changes are shown before they are upserted. Transformed entries are also
+written to the entries directory.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Transforming %s entries in shop %s with script %s\n", args[0], shop, script)
+
+		src, err := os.ReadFile(script)
+		if err != nil {
+			log.Fatalf("Error reading transform script: %v\n", err)
+			return err
+		}
+
+		ts, err := core.ParseTransformScript(string(src))
+		if err != nil {
+			log.Fatalf("Error parsing transform script %s: %v\n", script, err)
+			return err
+		}
+
+		es := newEntryService()
+
+		entries, err := es.Transform(args[0], ts)
+		if err != nil {
+			log.Fatalf("Error transforming entries: %v\n", err)
+			return err
+		}
+
+		diffs, err := es.Diff(entries)
+		if err != nil {
+			log.Fatalf("Error diffing transformed entries: %v\n", err)
+			return err
+		}
+
+		printDiffs(diffs)
+
+		if len(diffs) == 0 {
+			log.Printf("The script changes no entries\n")
+			return nil
+		}
+
+		log.Printf("The script changes %d of %d entries\n", len(diffs), len(entries[args[0]]))
+
+		if dryRun {
+			return nil
+		}
+
+		if !yes && !confirm(fmt.Sprintf("Upsert %d transformed entries?", len(diffs))) {
+			log.Printf("Transform cancelled\n")
+			return nil
+		}
+
+		if err := es.Push(entries); err != nil {
+			log.Fatalf("Error pushing transformed entries: %v\n", err)
+			return err
+		}
+
+		if err := core.WriteEntryDirectory(entriesDir, entries); err != nil {
+			log.Fatalf("Error writing entries: %v\n", err)
+			return err
+		}
+
+		return nil
+	},
+}
+
+var entriesUrlCollisionsCmd = &cobra.Command{
+	Use:   "url-collisions <definitions file>",
+	Short: "Report storefront URLs claimed by more than one metaobject type",
+	Long: `Report the URL paths of types with the online store capability which are shared
+by more than one type, and the local entries whose URLs collide under them.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		definitions := readLocalDefinitions(args[0])
+
+		entries, err := core.ReadEntryDirectory(entriesDir, nil)
+		if errors.Is(err, os.ErrNotExist) {
+			entries = nil
+		} else if err != nil {
+			log.Fatalf("Error reading local entries: %v\n", err)
+			return err
+		}
+
+		collisions := core.FindUrlCollisions(definitions, entries)
+
+		for _, c := range collisions {
+			fmt.Printf("%s: %s\n", c.Path, strings.Join(c.Keys, ", "))
+		}
+
+		if len(collisions) > 0 {
+			log.Fatalf("Found %d URL collisions\n", len(collisions))
+		}
+
+		log.Printf("No URL collisions\n")
+
+		return nil
+	},
+}
@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/core"
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/hjson/hjson-go/v4"
+	"github.com/spf13/cobra"
+)
+
+var ownerTypes []string
+
+var metafieldDefinitionsCmd = &cobra.Command{
+	Use:   "metafield-definitions",
+	Short: "Manage metafield definitions",
+	Long: `Pull, diff and push metafield definitions. Definitions are grouped by owner
+type, and keyed by <namespace>.<key>:
+
+  {
+    PRODUCT: {
+      definitions: {
+        custom.care_guide: {
+          type: multi_line_text_field
+        }
+      }
+    }
+  }
+`,
+}
+
+func newMetafieldDefinitionService() *core.MetafieldDefinitionService {
+	client := shopify.NewShopifyAdminClient(shop, config.Shops[shop], config.Version)
+	return &core.MetafieldDefinitionService{ShopifyClient: &client, AppId: config.Apps[shop]}
+}
+
+func readLocalMetafieldDefinitions(path string) (map[string]core.MetafieldOwnerDefinitions, error) {
+	input, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var owners map[string]core.MetafieldOwnerDefinitions
+	if err := hjson.Unmarshal(input, &owners); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return owners, nil
+}
+
+var metafieldDefinitionsPullCmd = &cobra.Command{
+	Use:   "pull",
+	Short: "Pull metafield definitions from the Shopify store",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Pulling metafield definitions from shop %s\n", shop)
+
+		ms := newMetafieldDefinitionService()
+
+		owners, err := ms.Pull(ownerTypes)
+		if err != nil {
+			log.Fatalf("Error pulling metafield definitions: %v\n", err)
+			return err
+		}
+
+		payload, err := hjson.Marshal(owners)
+		if err != nil {
+			log.Fatalf("Error marshalling data: %v\n", err)
+			return err
+		}
+
+		if outFile != "" {
+			os.WriteFile(outFile, payload, 0644)
+		} else {
+			log.Printf("%s\n", payload)
+		}
+
+		return nil
+	},
+}
+
+var metafieldDefinitionsDiffCmd = &cobra.Command{
+	Use:   "diff <file>",
+	Short: "Compare local metafield definitions with the Shopify store",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Diffing metafield definitions from file %s to shop %s\n", args[0], shop)
+
+		owners, err := readLocalMetafieldDefinitions(args[0])
+		if err != nil {
+			log.Fatalf("Error reading local metafield definitions: %v\n", err)
+			return err
+		}
+
+		ms := newMetafieldDefinitionService()
+
+		diffs, err := ms.Diff(owners)
+		if err != nil {
+			log.Fatalf("Error diffing metafield definitions: %v\n", err)
+			return err
+		}
+
+		printDiffs(diffs)
+
+		return nil
+	},
+}
+
+var metafieldDefinitionsPushCmd = &cobra.Command{
+	Use:   "push <file>",
+	Short: "Push local metafield definitions to the Shopify store",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Pushing metafield definitions from file %s to shop %s\n", args[0], shop)
+
+		owners, err := readLocalMetafieldDefinitions(args[0])
+		if err != nil {
+			log.Fatalf("Error reading local metafield definitions: %v\n", err)
+			return err
+		}
+
+		ms := newMetafieldDefinitionService()
+
+		if err := ms.Push(owners); err != nil {
+			log.Fatalf("Error pushing metafield definitions: %v\n", err)
+			return err
+		}
+
+		return nil
+	},
+}
+
+var metafieldDefinitionsStandardCmd = &cobra.Command{
+	Use:   "standard [owner type]",
+	Short: "List the standard metafield definitions which can be enabled",
+	Long: `List Shopify's standard metafield definitions with their template IDs. A
+definition declared with standard: <template ID> is enabled from the template
+on push, under the namespace and key of the template.
+`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+
+		ms := newMetafieldDefinitionService()
+
+		templates, err := ms.StandardTemplates()
+		if err != nil {
+			log.Fatalf("Error listing standard metafield definitions: %v\n", err)
+			return err
+		}
+
+		for _, t := range templates {
+			if len(args) > 0 && !slices.Contains(t.OwnerTypes, strings.ToUpper(args[0])) {
+				continue
+			}
+
+			fmt.Printf("%s\t%s\t%s\t%s\t%s\n", t.Id, t.Key, t.Type, strings.Join(t.OwnerTypes, ","), t.Name)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	metafieldDefinitionsPullCmd.Flags().StringSliceVar(&ownerTypes, "owner-type", core.DefaultMetafieldOwnerTypes, "Owner types to pull, e.g. PRODUCT, PRODUCTVARIANT, COLLECTION, CUSTOMER")
+
+	metafieldDefinitionsCmd.AddCommand(metafieldDefinitionsPullCmd)
+	metafieldDefinitionsCmd.AddCommand(metafieldDefinitionsDiffCmd)
+	metafieldDefinitionsCmd.AddCommand(metafieldDefinitionsPushCmd)
+	metafieldDefinitionsCmd.AddCommand(metafieldDefinitionsStandardCmd)
+}
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/JohnnyMcGee/metadef/core"
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/hjson/hjson-go/v4"
+	"github.com/spf13/cobra"
+)
+
+var valueOwnerTypes []string
+
+var metafieldsCmd = &cobra.Command{
+	Use:   "metafields",
+	Short: "Manage metafield values of products and collections",
+	Long: `Pull, diff and push the metafield values of products and collections.
+Values are grouped by owner type and resource handle, and keyed by
+<namespace>.<key>:
+
+  {
+    PRODUCT: {
+      classic-tee: {
+        custom.care_guide: Machine wash cold
+      }
+    }
+  }
+`,
+}
+
+func newMetafieldService() *core.MetafieldService {
+	client := shopify.NewShopifyAdminClient(shop, config.Shops[shop], config.Version)
+	return &core.MetafieldService{ShopifyClient: &client}
+}
+
+func readLocalMetafields(path string) (map[string]map[string]core.MetafieldValues, error) {
+	input, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var owners map[string]map[string]core.MetafieldValues
+	if err := hjson.Unmarshal(input, &owners); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return owners, nil
+}
+
+var metafieldsPullCmd = &cobra.Command{
+	Use:   "pull",
+	Short: "Pull metafield values from the Shopify store",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Pulling metafields from shop %s\n", shop)
+
+		ms := newMetafieldService()
+
+		owners, err := ms.Pull(valueOwnerTypes)
+		if err != nil {
+			log.Fatalf("Error pulling metafields: %v\n", err)
+			return err
+		}
+
+		payload, err := hjson.Marshal(owners)
+		if err != nil {
+			log.Fatalf("Error marshalling data: %v\n", err)
+			return err
+		}
+
+		if outFile != "" {
+			os.WriteFile(outFile, payload, 0644)
+		} else {
+			log.Printf("%s\n", payload)
+		}
+
+		return nil
+	},
+}
+
+var metafieldsDiffCmd = &cobra.Command{
+	Use:   "diff <file>",
+	Short: "Compare local metafield values with the Shopify store",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Diffing metafields from file %s to shop %s\n", args[0], shop)
+
+		owners, err := readLocalMetafields(args[0])
+		if err != nil {
+			log.Fatalf("Error reading local metafields: %v\n", err)
+			return err
+		}
+
+		diffs, err := newMetafieldService().Diff(owners)
+		if err != nil {
+			log.Fatalf("Error diffing metafields: %v\n", err)
+			return err
+		}
+
+		printDiffs(diffs)
+
+		return nil
+	},
+}
+
+var metafieldsPushCmd = &cobra.Command{
+	Use:   "push <file>",
+	Short: "Push local metafield values to the Shopify store",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Pushing metafields from file %s to shop %s\n", args[0], shop)
+
+		owners, err := readLocalMetafields(args[0])
+		if err != nil {
+			log.Fatalf("Error reading local metafields: %v\n", err)
+			return err
+		}
+
+		ms := newMetafieldService()
+
+		diffs, err := ms.Diff(owners)
+		if err != nil {
+			log.Fatalf("Error diffing metafields: %v\n", err)
+			return err
+		}
+
+		if len(diffs) == 0 {
+			log.Printf("Metafields are in sync with shop %s\n", shop)
+			return nil
+		}
+
+		printDiffs(diffs)
+
+		if !yes && !confirm(fmt.Sprintf("Push the metafields of %d resources to shop %s?", len(diffs), shop)) {
+			log.Printf("Push cancelled\n")
+			return nil
+		}
+
+		if err := ms.Push(owners); err != nil {
+			log.Fatalf("Error pushing metafields: %v\n", err)
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	metafieldsPullCmd.Flags().StringSliceVar(&valueOwnerTypes, "owner-type", core.MetafieldValueOwnerTypes, "Owner types to pull, PRODUCT or COLLECTION")
+
+	metafieldsPushCmd.Flags().BoolVarP(&yes, "yes", "y", false, "Push the changes without asking for confirmation")
+
+	metafieldsCmd.AddCommand(metafieldsPullCmd)
+	metafieldsCmd.AddCommand(metafieldsDiffCmd)
+	metafieldsCmd.AddCommand(metafieldsPushCmd)
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/JohnnyMcGee/metadef/core"
+)
+
+var (
+	pushShops    []string
+	allShops     bool
+	pushParallel int
+	failFast     bool
+)
+
+// rollout pushes the definitions of a file to the shops of --shops or
+// --all-shops and prints a summary of each shop.
+func rollout(path string) error {
+	shops := pushShops
+	if allShops {
+		shops = make([]string, 0, len(config.Shops))
+		for s := range config.Shops {
+			shops = append(shops, s)
+		}
+		sort.Strings(shops)
+	}
+
+	log.Printf("Pushing definitions from file %s to %d shops\n", path, len(shops))
+
+	definitions := readLocalDefinitions(path)
+
+	services := make(map[string]*core.MetaobjectService, len(shops))
+	for _, s := range shops {
+		ms, err := newMetaobjectService(s)
+		if err != nil {
+			log.Fatalf("Error pushing definitions: %v\n", err)
+			return err
+		}
+
+		services[s] = ms
+	}
+
+	results := core.Rollout(shops, services, definitions, pushParallel, failFast)
+
+	printRollout(results)
+
+	failed := 0
+	for _, r := range results {
+		if r.Status == core.RolloutFailed {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		err := fmt.Errorf("%d of %d shops failed", failed, len(results))
+		log.Fatalf("Error pushing definitions: %v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+func printRollout(results []core.ShopRollout) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "SHOP\tSTATUS\tCHANGED")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%s\t%d\n", r.Shop, r.Status, r.Changed)
+	}
+
+	w.Flush()
+
+	for _, r := range results {
+		if r.Error != nil {
+			fmt.Printf("\n%s: %v\n", r.Shop, r.Error)
+		}
+	}
+}
+
+func init() {
+	pushCmd.Flags().StringSliceVar(&pushShops, "shops", nil, "Push to these shops of the config file concurrently")
+	pushCmd.Flags().BoolVar(&allShops, "all-shops", false, "Push to every shop of the config file concurrently")
+	pushCmd.Flags().IntVar(&pushParallel, "parallel", 4, "Number of shops to push to at a time")
+	pushCmd.Flags().BoolVar(&failFast, "fail-fast", false, "Push the first shop alone, and skip the remaining shops once a shop fails")
+	pushCmd.MarkFlagsMutuallyExclusive("shops", "all-shops")
+}
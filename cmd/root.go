@@ -4,10 +4,12 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/JohnnyMcGee/metadef/core"
 	"github.com/JohnnyMcGee/metadef/shopify"
@@ -24,10 +26,14 @@ var (
 	outFile    string
 	configFile string
 	config     Config
+	yes        bool
 )
 
 type Config struct {
-	Shops   map[string]string `hjson:"shops"`
+	Shops map[string]string `hjson:"shops"`
+	// Apps maps shops to the ID of the app their token belongs to, which
+	// resolves $app: types.
+	Apps    map[string]string `hjson:"apps"`
 	Version string            `hjson:"version"`
 }
 
@@ -70,6 +76,14 @@ func init() {
 	rootCmd.AddCommand(pullCmd)
 	rootCmd.AddCommand(diffCmd)
 	rootCmd.AddCommand(pushCmd)
+	rootCmd.AddCommand(syncCmd)
+	rootCmd.AddCommand(driftCmd)
+	rootCmd.AddCommand(entriesCmd)
+	rootCmd.AddCommand(metafieldDefinitionsCmd)
+	rootCmd.AddCommand(metafieldsCmd)
+	rootCmd.AddCommand(auditCmd)
+	rootCmd.AddCommand(importCmd)
+	rootCmd.AddCommand(exportCmd)
 }
 
 func initDefaults() {
@@ -99,26 +113,56 @@ func initDefaults() {
 	}
 }
 
+// confirm asks the user a yes/no question on stdin.
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+
+	return answer == "y" || answer == "yes"
+}
+
+func readLocalDefinitions(path string) map[string]core.MetaobjectDefinition {
+	input, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Error reading local definitions: %v\n", err)
+	}
+
+	var inputDefinitions map[string]core.MetaobjectDefinition
+	hjson.Unmarshal(input, &inputDefinitions)
+
+	return inputDefinitions
+}
+
 var pushCmd = &cobra.Command{
 	Use:   "push <file or directory>",
 	Short: "Push local metaobject definitions to the Shopify store",
-	Args:  cobra.ExactArgs(1),
+	Long: `Push local metaobject definitions to the Shopify store. With --shops or
+--all-shops, the definitions are pushed to several stores concurrently, followed
+by a summary of each shop.
+`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		initDefaults()
 		log.Printf("Using config file %s\n", configFile)
+
+		if allShops || len(pushShops) > 0 {
+			return rollout(args[0])
+		}
+
 		log.Printf("Pushing definitions from file %s to shop %s\n", args[0], shop)
 		client := shopify.NewShopifyAdminClient(shop, config.Shops[shop], config.Version)
-		ms := &core.MetaobjectService{ShopifyClient: &client}
+		ms := &core.MetaobjectService{ShopifyClient: &client, AppId: config.Apps[shop]}
 
-		input, err := os.ReadFile(args[0])
-		if err != nil {
-			log.Fatalf("Error reading local definitions: %v\n", err)
-		}
+		inputDefinitions := readLocalDefinitions(args[0])
 
-		var inputDefinitions map[string]core.MetaobjectDefinition
-		hjson.Unmarshal(input, &inputDefinitions)
+		if err := ms.Push(inputDefinitions); err != nil {
+			log.Fatalf("Error pushing definitions: %v\n", err)
+			return err
+		}
 
-		return ms.Push(inputDefinitions)
+		return nil
 	},
 }
 
@@ -132,44 +176,65 @@ var diffCmd = &cobra.Command{
 		log.Printf("Diffing metaobject definitions from file %s to shop %s\n", args[0], shop)
 
 		client := shopify.NewShopifyAdminClient(shop, config.Shops[shop], config.Version)
-		ms := &core.MetaobjectService{ShopifyClient: &client}
+		ms := &core.MetaobjectService{ShopifyClient: &client, AppId: config.Apps[shop]}
 
-		input, err := os.ReadFile(args[0])
+		inputDefinitions := readLocalDefinitions(args[0])
+
+		diffMap, err := ms.Diff(inputDefinitions)
 		if err != nil {
-			log.Fatalf("Error reading local definitions: %v\n", err)
+			log.Fatalf("Error diffing definitions: %v\n", err)
+			return err
 		}
 
-		var inputDefinitions map[string]core.MetaobjectDefinition
-		hjson.Unmarshal(input, &inputDefinitions)
+		printDiffs(diffMap)
 
-		diffMap, err := ms.Diff(inputDefinitions)
+		impacts, err := ms.Impact(inputDefinitions)
 		if err != nil {
-			log.Fatalf("Error diffing definitions: %v\n", err)
+			log.Fatalf("Error counting affected entries: %v\n", err)
 			return err
 		}
 
-		dmp := diffmatchpatch.New()
+		printImpacts(impacts)
+
+		return nil
+	},
+}
 
-		for key, diffs := range diffMap {
-			inserts := 0
-			deletes := 0
+func printImpacts(impacts []core.DefinitionImpact) {
+	for _, impact := range impacts {
+		fmt.Println()
+		fmt.Printf("%s: %d entries\n", impact.Type, impact.Entries)
 
-			for _, diff := range diffs {
-				if diff.Type == diffmatchpatch.DiffInsert {
-					inserts += len(diff.Text)
-				} else if diff.Type == diffmatchpatch.DiffDelete {
-					deletes += len(diff.Text)
-				}
+		for _, f := range impact.Fields {
+			if f.Deleted {
+				fmt.Printf("  \x1b[31mdeleted field %s\x1b[0m: %d entries hold a value\n", f.Field, f.Entries)
+			} else {
+				fmt.Printf("  \x1b[33mrequired field %s\x1b[0m: %d entries lack a value\n", f.Field, f.Entries)
 			}
+		}
+	}
+}
+
+func printDiffs(diffMap map[string][]diffmatchpatch.Diff) {
+	dmp := diffmatchpatch.New()
+
+	for key, diffs := range diffMap {
+		inserts := 0
+		deletes := 0
 
-			fmt.Println()
-			fmt.Printf("%s: \x1b[32m+%d\x1b[0m \x1b[31m-%d\x1b[0m\n", key, inserts, deletes)
-			fmt.Println("---------------------------------")
-			fmt.Println(dmp.DiffPrettyText(diffs))
+		for _, diff := range diffs {
+			if diff.Type == diffmatchpatch.DiffInsert {
+				inserts += len(diff.Text)
+			} else if diff.Type == diffmatchpatch.DiffDelete {
+				deletes += len(diff.Text)
+			}
 		}
 
-		return nil
-	},
+		fmt.Println()
+		fmt.Printf("%s: \x1b[32m+%d\x1b[0m \x1b[31m-%d\x1b[0m\n", key, inserts, deletes)
+		fmt.Println("---------------------------------")
+		fmt.Println(dmp.DiffPrettyText(diffs))
+	}
 }
 
 var pullCmd = &cobra.Command{
@@ -181,7 +246,7 @@ var pullCmd = &cobra.Command{
 		log.Printf("Pulling metaobject definitions from shop %s\n", shop)
 
 		client := shopify.NewShopifyAdminClient(shop, config.Shops[shop], config.Version)
-		ms := &core.MetaobjectService{ShopifyClient: &client}
+		ms := &core.MetaobjectService{ShopifyClient: &client, AppId: config.Apps[shop]}
 
 		defs, err := ms.Pull()
 		if err != nil {
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/JohnnyMcGee/metadef/core"
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fromShop string
+	toShop   string
+)
+
+func newMetaobjectService(shop string) (*core.MetaobjectService, error) {
+	token, ok := config.Shops[shop]
+	if !ok {
+		return nil, fmt.Errorf("shop %s not found in config file %s", shop, configFile)
+	}
+
+	client := shopify.NewShopifyAdminClient(shop, token, config.Version)
+	return &core.MetaobjectService{ShopifyClient: &client, AppId: config.Apps[shop], Shop: shop}, nil
+}
+
+var syncCmd = &cobra.Command{
+	Use:   "sync --from <shop> --to <shop>",
+	Short: "Copy metaobject definitions from one shop to another",
+	Long: `Pull the metaobject definitions of one shop, show how they differ from another
+shop, and push them there. References between definitions are matched by type,
+so they point at the definitions of the target shop.
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initDefaults()
+		log.Printf("Using config file %s\n", configFile)
+		log.Printf("Syncing definitions from shop %s to shop %s\n", fromShop, toShop)
+
+		source, err := newMetaobjectService(fromShop)
+		if err != nil {
+			log.Fatalf("Error syncing definitions: %v\n", err)
+			return err
+		}
+
+		target, err := newMetaobjectService(toShop)
+		if err != nil {
+			log.Fatalf("Error syncing definitions: %v\n", err)
+			return err
+		}
+
+		definitions, err := source.Pull()
+		if err != nil {
+			log.Fatalf("Error pulling definitions: %v\n", err)
+			return err
+		}
+
+		diffMap, err := target.Diff(definitions)
+		if err != nil {
+			log.Fatalf("Error diffing definitions: %v\n", err)
+			return err
+		}
+
+		if len(diffMap) == 0 {
+			log.Printf("Shop %s is in sync with shop %s\n", toShop, fromShop)
+			return nil
+		}
+
+		printDiffs(diffMap)
+
+		impacts, err := target.Impact(definitions)
+		if err != nil {
+			log.Fatalf("Error counting affected entries: %v\n", err)
+			return err
+		}
+
+		printImpacts(impacts)
+
+		if !yes && !confirm(fmt.Sprintf("Push %d changed definitions to shop %s?", len(diffMap), toShop)) {
+			log.Printf("Sync cancelled\n")
+			return nil
+		}
+
+		if err := target.Push(definitions); err != nil {
+			log.Fatalf("Error pushing definitions: %v\n", err)
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	syncCmd.Flags().StringVar(&fromShop, "from", "", "Shop to copy definitions from")
+	syncCmd.Flags().StringVar(&toShop, "to", "", "Shop to copy definitions to")
+	syncCmd.Flags().BoolVarP(&yes, "yes", "y", false, "Push the changes without asking for confirmation")
+	syncCmd.MarkFlagRequired("from")
+	syncCmd.MarkFlagRequired("to")
+}
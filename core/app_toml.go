@@ -0,0 +1,570 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+// AppToml is the part of a Shopify app configuration file, shopify.app.toml,
+// declaring the metaobject and metafield definitions of the app. Metaobject
+// definitions are keyed by type under metaobjects.app, and are $app: types
+// once deployed. Metafield definitions are keyed by owner table, e.g.
+// product.variant, and by key under <owner>.metafields.app, and are in the
+// $app namespace once deployed.
+type AppToml struct {
+	Metaobjects struct {
+		App map[string]AppTomlMetaobject `toml:"app"`
+	} `toml:"metaobjects"`
+	Metafields map[string]map[string]AppTomlMetafield `toml:"-"`
+}
+
+// appTomlOwner is an owner table of app TOML. Variants are nested in the
+// product table.
+type appTomlOwner struct {
+	Metafields struct {
+		App map[string]AppTomlMetafield `toml:"app"`
+	} `toml:"metafields"`
+	Variant *appTomlOwner `toml:"variant,omitempty"`
+}
+
+// appTomlOwnerTypes are the metafield owner types by their app TOML table.
+var appTomlOwnerTypes = map[string]shopify.MetafieldOwnerType{
+	"article":          shopify.MetafieldOwnerTypeArticle,
+	"blog":             shopify.MetafieldOwnerTypeBlog,
+	"collection":       shopify.MetafieldOwnerTypeCollection,
+	"company":          shopify.MetafieldOwnerTypeCompany,
+	"company_location": shopify.MetafieldOwnerTypeCompanyLocation,
+	"customer":         shopify.MetafieldOwnerTypeCustomer,
+	"location":         shopify.MetafieldOwnerTypeLocation,
+	"market":           shopify.MetafieldOwnerTypeMarket,
+	"order":            shopify.MetafieldOwnerTypeOrder,
+	"page":             shopify.MetafieldOwnerTypePage,
+	"product":          shopify.MetafieldOwnerTypeProduct,
+	"product.variant":  shopify.MetafieldOwnerTypeProductvariant,
+}
+
+// appMetafieldNamespace is the namespace of the app whose token is used. The
+// API resolves it to app--<app id>.
+const appMetafieldNamespace = "$app"
+
+type AppTomlMetaobject struct {
+	Name             string                  `toml:"name,omitempty"`
+	Description      string                  `toml:"description,omitempty"`
+	DisplayNameField string                  `toml:"display_name_field,omitempty"`
+	Access           *AppTomlAccess          `toml:"access,omitempty"`
+	Capabilities     *AppTomlCapabilities    `toml:"capabilities,omitempty"`
+	Fields           map[string]AppTomlField `toml:"fields,omitempty"`
+}
+
+// AppTomlAccess holds access levels in the lower case form of app TOML, e.g.
+// merchant_read_write.
+type AppTomlAccess struct {
+	Admin      string `toml:"admin,omitempty"`
+	Storefront string `toml:"storefront,omitempty"`
+}
+
+type AppTomlCapabilities struct {
+	Publishable  bool `toml:"publishable,omitempty"`
+	Translatable bool `toml:"translatable,omitempty"`
+}
+
+// AppTomlMetafield is a metafield definition. Like fields, references name
+// their metaobject types in the type.
+type AppTomlMetafield struct {
+	Name         string                        `toml:"name,omitempty"`
+	Type         string                        `toml:"type"`
+	Description  string                        `toml:"description,omitempty"`
+	Access       *AppTomlMetafieldAccess       `toml:"access,omitempty"`
+	Capabilities *AppTomlMetafieldCapabilities `toml:"capabilities,omitempty"`
+	Validations  map[string]any                `toml:"validations,omitempty"`
+}
+
+// AppTomlMetafieldAccess holds metafield access levels in lower case, e.g.
+// merchant_read.
+type AppTomlMetafieldAccess struct {
+	Admin           string `toml:"admin,omitempty"`
+	Storefront      string `toml:"storefront,omitempty"`
+	CustomerAccount string `toml:"customer_account,omitempty"`
+}
+
+type AppTomlMetafieldCapabilities struct {
+	AdminFilterable          bool `toml:"admin_filterable,omitempty"`
+	SmartCollectionCondition bool `toml:"smart_collection_condition,omitempty"`
+	UniqueValues             bool `toml:"unique_values,omitempty"`
+}
+
+// AppTomlField is a field definition. References name their metaobject types
+// in the field type, e.g. metaobject_reference<$app:author>.
+type AppTomlField struct {
+	Name        string         `toml:"name,omitempty"`
+	Type        string         `toml:"type"`
+	Description string         `toml:"description,omitempty"`
+	Required    bool           `toml:"required,omitempty"`
+	Validations map[string]any `toml:"validations,omitempty"`
+}
+
+// ParseAppToml reads the metaobject and metafield definitions of an app
+// configuration file. Other sections of the file are ignored.
+func ParseAppToml(input []byte) (AppToml, error) {
+	var app AppToml
+	if _, err := toml.Decode(string(input), &app); err != nil {
+		return AppToml{}, err
+	}
+
+	var tables map[string]toml.Primitive
+	md, err := toml.Decode(string(input), &tables)
+	if err != nil {
+		return AppToml{}, err
+	}
+
+	app.Metafields = make(map[string]map[string]AppTomlMetafield)
+
+	for table, primitive := range tables {
+		if _, ok := appTomlOwnerTypes[table]; !ok {
+			continue
+		}
+
+		var owner appTomlOwner
+		if err := md.PrimitiveDecode(primitive, &owner); err != nil {
+			return AppToml{}, fmt.Errorf("%s: %w", table, err)
+		}
+
+		if len(owner.Metafields.App) > 0 {
+			app.Metafields[table] = owner.Metafields.App
+		}
+
+		if owner.Variant != nil && len(owner.Variant.Metafields.App) > 0 {
+			app.Metafields[table+".variant"] = owner.Variant.Metafields.App
+		}
+	}
+
+	return app, nil
+}
+
+// MarshalAppToml writes the metaobject and metafield definitions in app TOML,
+// to be merged into shopify.app.toml.
+func MarshalAppToml(app AppToml) ([]byte, error) {
+	tables := make(map[string]any)
+
+	if len(app.Metaobjects.App) > 0 {
+		tables["metaobjects"] = app.Metaobjects
+	}
+
+	owners := make(map[string]*appTomlOwner)
+	owner := func(table string) *appTomlOwner {
+		if owners[table] == nil {
+			owners[table] = &appTomlOwner{}
+			tables[table] = owners[table]
+		}
+		return owners[table]
+	}
+
+	for table, metafields := range app.Metafields {
+		if parent, ok := strings.CutSuffix(table, ".variant"); ok {
+			o := owner(parent)
+			o.Variant = &appTomlOwner{}
+			o.Variant.Metafields.App = metafields
+			continue
+		}
+
+		owner(table).Metafields.App = metafields
+	}
+
+	var b bytes.Buffer
+	enc := toml.NewEncoder(&b)
+	enc.Indent = ""
+
+	if err := enc.Encode(tables); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// appTomlFieldType returns the app TOML type of a field, naming referenced
+// metaobject types in the type. The validations naming them are left out of
+// the returned validations.
+func appTomlFieldType(field FieldDefinition) (string, map[string]any) {
+	validations := make(map[string]any, len(field.Validations))
+	for k, v := range field.Validations {
+		// Numbers are read from definition files as floats, which TOML
+		// would write with a fraction.
+		if f, ok := v.(float64); ok && f == float64(int64(f)) {
+			v = int64(f)
+		}
+		validations[k] = v
+	}
+
+	defTypes := referencedTypes(validations)
+	delete(validations, "metaobject_definition")
+	delete(validations, "metaobject_definitions")
+
+	if len(validations) == 0 {
+		validations = nil
+	}
+
+	if len(defTypes) == 0 {
+		return field.Type, validations
+	}
+
+	return field.Type + "<" + strings.Join(defTypes, ",") + ">", validations
+}
+
+// parseAppTomlFieldType splits the referenced metaobject types off an app
+// TOML field type, returning them as reference validations.
+func parseAppTomlFieldType(fieldType string) (string, map[string]any) {
+	base, rest, ok := strings.Cut(fieldType, "<")
+	if !ok {
+		return fieldType, nil
+	}
+
+	defTypes := strings.Split(strings.TrimSuffix(rest, ">"), ",")
+	for i, d := range defTypes {
+		defTypes[i] = strings.TrimSpace(d)
+	}
+
+	if baseFieldType(base) == "mixed_reference" {
+		values := make([]any, len(defTypes))
+		for i, d := range defTypes {
+			values[i] = d
+		}
+
+		return base, map[string]any{"metaobject_definitions": values}
+	}
+
+	return base, map[string]any{"metaobject_definition": defTypes[0]}
+}
+
+// ExportAppToml converts $app: definitions to app TOML. Types outside the
+// app's namespace can't be declared by the app and are skipped; their types
+// are returned.
+func ExportAppToml(definitions map[string]MetaobjectDefinition) (AppToml, []string, error) {
+	var app AppToml
+	app.Metaobjects.App = make(map[string]AppTomlMetaobject, len(definitions))
+	skipped := make([]string, 0)
+
+	for defType, d := range definitions {
+		if !strings.HasPrefix(defType, appTypePrefix) {
+			skipped = append(skipped, defType)
+			continue
+		}
+
+		if d.Standard != "" {
+			return AppToml{}, nil, fmt.Errorf("definition %s: standard definitions can't be declared in app TOML", defType)
+		}
+
+		m := AppTomlMetaobject{
+			Name:             d.Name,
+			Description:      d.Description,
+			DisplayNameField: d.DisplayNameKey,
+			Fields:           make(map[string]AppTomlField, len(d.FieldDefinitions)),
+		}
+
+		if m.Name == "" {
+			m.Name = titleCase(strings.TrimPrefix(defType, appTypePrefix))
+		}
+
+		if d.Access != nil && (d.Access.Admin != "" || d.Access.Storefront != "") {
+			m.Access = &AppTomlAccess{
+				Admin:      strings.ToLower(string(d.Access.Admin)),
+				Storefront: strings.ToLower(string(d.Access.Storefront)),
+			}
+		}
+
+		if c := d.Capabilities; c != nil {
+			if c.OnlineStore != nil || c.Renderable != nil {
+				return AppToml{}, nil, fmt.Errorf("definition %s: only the publishable and translatable capabilities can be declared in app TOML", defType)
+			}
+
+			if c.Publishable || c.Translatable {
+				m.Capabilities = &AppTomlCapabilities{Publishable: c.Publishable, Translatable: c.Translatable}
+			}
+		}
+
+		for key, f := range d.FieldDefinitions {
+			fieldType, validations := appTomlFieldType(f)
+
+			field := AppTomlField{
+				Name:        f.Name,
+				Type:        fieldType,
+				Description: f.Description,
+				Required:    f.Required,
+				Validations: validations,
+			}
+
+			if field.Name == "" {
+				field.Name = titleCase(key)
+			}
+
+			m.Fields[key] = field
+		}
+
+		app.Metaobjects.App[strings.TrimPrefix(defType, appTypePrefix)] = m
+	}
+
+	sort.Strings(skipped)
+
+	return app, skipped, nil
+}
+
+// ImportAppToml converts the metaobject definitions of app TOML to $app:
+// definitions, leaving out values which are the defaults of definition files.
+func ImportAppToml(app AppToml) (map[string]MetaobjectDefinition, error) {
+	definitions := make(map[string]MetaobjectDefinition, len(app.Metaobjects.App))
+
+	for key, m := range app.Metaobjects.App {
+		defType := appTypePrefix + key
+
+		d := MetaobjectDefinition{
+			Name:             m.Name,
+			Description:      m.Description,
+			DisplayNameKey:   m.DisplayNameField,
+			FieldDefinitions: make(map[string]FieldDefinition, len(m.Fields)),
+		}
+
+		if d.Name == titleCase(key) {
+			d.Name = ""
+		}
+
+		if m.Access != nil {
+			admin, storefront := defaultAccess(defType)
+			access := &Access{}
+
+			if a := shopify.MetaobjectAdminAccess(strings.ToUpper(m.Access.Admin)); a != "" && a != admin {
+				if !isMerchantAdminAccess(a) {
+					return nil, fmt.Errorf("definition %s: unknown admin access %s", defType, m.Access.Admin)
+				}
+				access.Admin = a
+			}
+
+			if s := shopify.MetaobjectStorefrontAccess(strings.ToUpper(m.Access.Storefront)); s != "" && s != storefront {
+				if s != shopify.MetaobjectStorefrontAccessPublicRead {
+					return nil, fmt.Errorf("definition %s: unknown storefront access %s", defType, m.Access.Storefront)
+				}
+				access.Storefront = s
+			}
+
+			if access.Admin != "" || access.Storefront != "" {
+				d.Access = access
+			}
+		}
+
+		if m.Capabilities != nil && (m.Capabilities.Publishable || m.Capabilities.Translatable) {
+			d.Capabilities = &Capabilities{
+				Publishable:  m.Capabilities.Publishable,
+				Translatable: m.Capabilities.Translatable,
+			}
+		}
+
+		for fieldKey, f := range m.Fields {
+			fieldType, validations := parseAppTomlFieldType(f.Type)
+			for k, v := range f.Validations {
+				if validations == nil {
+					validations = make(map[string]any, len(f.Validations))
+				}
+				validations[k] = v
+			}
+
+			field := FieldDefinition{
+				Type:        fieldType,
+				Name:        f.Name,
+				Description: f.Description,
+				Required:    f.Required,
+				Validations: validations,
+			}
+
+			if field.Name == titleCase(fieldKey) {
+				field.Name = ""
+			}
+
+			d.FieldDefinitions[fieldKey] = field
+		}
+
+		definitions[defType] = d
+	}
+
+	return definitions, nil
+}
+
+// ExportAppTomlMetafields converts the metafield definitions in the $app
+// namespace to the owner tables of app TOML. Definitions in other namespaces
+// can't be declared by the app and are skipped; their keys are returned as
+// <owner type>/<namespace>.<key>. Pins aren't declared in app TOML.
+func ExportAppTomlMetafields(owners map[string]MetafieldOwnerDefinitions) (map[string]map[string]AppTomlMetafield, []string, error) {
+	tables := make(map[string]string, len(appTomlOwnerTypes))
+	for table, ownerType := range appTomlOwnerTypes {
+		tables[string(ownerType)] = table
+	}
+
+	metafields := make(map[string]map[string]AppTomlMetafield)
+	skipped := make([]string, 0)
+
+	for ownerType, owner := range owners {
+		for key, d := range owner.Definitions {
+			definitionKey := MetafieldDefinitionKey(ownerType, key)
+
+			namespace, k, err := splitMetafieldKey(key)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if namespace != appMetafieldNamespace {
+				skipped = append(skipped, definitionKey)
+				continue
+			}
+
+			table, ok := tables[strings.ToUpper(ownerType)]
+			if !ok {
+				return nil, nil, fmt.Errorf("definition %s: %s metafields can't be declared in app TOML", definitionKey, ownerType)
+			}
+
+			if d.Standard != "" {
+				return nil, nil, fmt.Errorf("definition %s: standard definitions can't be declared in app TOML", definitionKey)
+			}
+
+			if d.Constraints != nil {
+				return nil, nil, fmt.Errorf("definition %s: constraints can't be declared in app TOML", definitionKey)
+			}
+
+			fieldType, validations := appTomlFieldType(FieldDefinition{Type: d.Type, Validations: d.Validations})
+
+			m := AppTomlMetafield{
+				Name:        d.Name,
+				Type:        fieldType,
+				Description: d.Description,
+				Validations: validations,
+			}
+
+			if m.Name == "" {
+				m.Name = titleCase(k)
+			}
+
+			if a := d.Access; a != nil && *a != (MetafieldAccess{}) {
+				m.Access = &AppTomlMetafieldAccess{
+					Admin:           strings.ToLower(string(a.Admin)),
+					Storefront:      strings.ToLower(string(a.Storefront)),
+					CustomerAccount: strings.ToLower(string(a.CustomerAccount)),
+				}
+			}
+
+			if c := d.Capabilities; c != nil && *c != (MetafieldCapabilities{}) {
+				m.Capabilities = &AppTomlMetafieldCapabilities{
+					AdminFilterable:          c.AdminFilterable,
+					SmartCollectionCondition: c.SmartCollectionCondition,
+					UniqueValues:             c.UniqueValues,
+				}
+			}
+
+			if metafields[table] == nil {
+				metafields[table] = make(map[string]AppTomlMetafield)
+			}
+			metafields[table][k] = m
+		}
+	}
+
+	sort.Strings(skipped)
+
+	return metafields, skipped, nil
+}
+
+// ImportAppTomlMetafields converts the metafield definitions of app TOML to
+// definitions in the $app namespace, grouped by owner type, leaving out
+// values which are the defaults of metafield definition files.
+func ImportAppTomlMetafields(app AppToml) (map[string]MetafieldOwnerDefinitions, error) {
+	owners := make(map[string]MetafieldOwnerDefinitions, len(app.Metafields))
+
+	for table, metafields := range app.Metafields {
+		ownerType, ok := appTomlOwnerTypes[table]
+		if !ok {
+			return nil, fmt.Errorf("unknown metafield owner %s", table)
+		}
+
+		owner := MetafieldOwnerDefinitions{Definitions: make(map[string]MetafieldDefinition, len(metafields))}
+
+		for key, m := range metafields {
+			definitionKey := MetafieldDefinitionKey(string(ownerType), appMetafieldNamespace+"."+key)
+
+			fieldType, validations := parseAppTomlFieldType(m.Type)
+			for k, v := range m.Validations {
+				if validations == nil {
+					validations = make(map[string]any, len(m.Validations))
+				}
+				validations[k] = v
+			}
+
+			d := MetafieldDefinition{
+				Type:        fieldType,
+				Name:        m.Name,
+				Description: m.Description,
+				Validations: validations,
+			}
+
+			if d.Name == titleCase(key) {
+				d.Name = ""
+			}
+
+			if m.Access != nil {
+				access, err := importAppTomlMetafieldAccess(*m.Access)
+				if err != nil {
+					return nil, fmt.Errorf("definition %s: %w", definitionKey, err)
+				}
+				d.Access = access
+			}
+
+			if c := m.Capabilities; c != nil && *c != (AppTomlMetafieldCapabilities{}) {
+				d.Capabilities = &MetafieldCapabilities{
+					AdminFilterable:          c.AdminFilterable,
+					SmartCollectionCondition: c.SmartCollectionCondition,
+					UniqueValues:             c.UniqueValues,
+				}
+			}
+
+			owner.Definitions[appMetafieldNamespace+"."+key] = d
+		}
+
+		owners[string(ownerType)] = owner
+	}
+
+	return owners, nil
+}
+
+// importAppTomlMetafieldAccess returns the access levels of app TOML which
+// differ from the defaults, or nil when none do.
+func importAppTomlMetafieldAccess(access AppTomlMetafieldAccess) (*MetafieldAccess, error) {
+	a := &MetafieldAccess{}
+
+	switch admin := shopify.MetafieldAdminAccess(strings.ToUpper(access.Admin)); admin {
+	case "", shopify.MetafieldAdminAccessMerchantReadWrite:
+	case shopify.MetafieldAdminAccessMerchantRead:
+		a.Admin = admin
+	default:
+		return nil, fmt.Errorf("unknown admin access %s", access.Admin)
+	}
+
+	switch storefront := shopify.MetafieldStorefrontAccess(strings.ToUpper(access.Storefront)); storefront {
+	case "", shopify.MetafieldStorefrontAccessPublicRead:
+	case shopify.MetafieldStorefrontAccessNone:
+		a.Storefront = storefront
+	default:
+		return nil, fmt.Errorf("unknown storefront access %s", access.Storefront)
+	}
+
+	switch customerAccount := shopify.MetafieldCustomerAccountAccess(strings.ToUpper(access.CustomerAccount)); customerAccount {
+	case "", shopify.MetafieldCustomerAccountAccessNone:
+	case shopify.MetafieldCustomerAccountAccessRead, shopify.MetafieldCustomerAccountAccessReadWrite:
+		a.CustomerAccount = customerAccount
+	default:
+		return nil, fmt.Errorf("unknown customer account access %s", access.CustomerAccount)
+	}
+
+	if *a == (MetafieldAccess{}) {
+		return nil, nil
+	}
+
+	return a, nil
+}
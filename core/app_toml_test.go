@@ -0,0 +1,261 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+const testAppToml = `
+client_id = "1234"
+
+[access_scopes]
+scopes = "write_metaobjects"
+
+[metaobjects.app.author]
+name = "Author"
+display_name_field = "name"
+
+[metaobjects.app.author.access]
+admin = "merchant_read"
+storefront = "public_read"
+
+[metaobjects.app.author.capabilities]
+publishable = true
+
+[metaobjects.app.author.fields.name]
+name = "Full name"
+type = "single_line_text_field"
+required = true
+
+[metaobjects.app.author.fields.mentor]
+name = "Mentor"
+type = "metaobject_reference<$app:author>"
+
+[metaobjects.app.author.fields.related]
+name = "Related"
+type = "list.mixed_reference<$app:author,$app:book>"
+
+[metaobjects.app.author.fields.rating]
+name = "Rating"
+type = "rating"
+
+[metaobjects.app.author.fields.rating.validations]
+scale_min = "1"
+scale_max = "5"
+
+[product.metafields.app.author]
+name = "Written by"
+type = "metaobject_reference<$app:author>"
+
+[product.metafields.app.author.access]
+storefront = "none"
+customer_account = "read"
+
+[product.metafields.app.author.capabilities]
+admin_filterable = true
+
+[product.metafields.app.pages]
+name = "Pages"
+type = "number_integer"
+
+[product.metafields.app.pages.validations]
+min = 1
+
+[product.variant.metafields.app.edition]
+name = "Edition"
+type = "single_line_text_field"
+
+[product.variant.metafields.app.edition.access]
+admin = "merchant_read_write"
+`
+
+func TestImportAppToml(t *testing.T) {
+	app, err := ParseAppToml([]byte(testAppToml))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	definitions, err := ImportAppToml(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantDefinitions := map[string]MetaobjectDefinition{
+		"$app:author": {
+			DisplayNameKey: "name",
+			Access:         &Access{Admin: shopify.MetaobjectAdminAccessMerchantRead, Storefront: shopify.MetaobjectStorefrontAccessPublicRead},
+			Capabilities:   &Capabilities{Publishable: true},
+			FieldDefinitions: map[string]FieldDefinition{
+				"name":    {Type: "single_line_text_field", Name: "Full name", Required: true},
+				"mentor":  {Type: "metaobject_reference", Validations: map[string]any{"metaobject_definition": "$app:author"}},
+				"related": {Type: "list.mixed_reference", Validations: map[string]any{"metaobject_definitions": []any{"$app:author", "$app:book"}}},
+				"rating":  {Type: "rating", Validations: map[string]any{"scale_min": "1", "scale_max": "5"}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(definitions, wantDefinitions) {
+		t.Errorf("ImportAppToml = %#v, want %#v", definitions, wantDefinitions)
+	}
+
+	metafields, err := ImportAppTomlMetafields(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantMetafields := map[string]MetafieldOwnerDefinitions{
+		"PRODUCT": {Definitions: map[string]MetafieldDefinition{
+			"$app.author": {
+				Type:         "metaobject_reference",
+				Name:         "Written by",
+				Validations:  map[string]any{"metaobject_definition": "$app:author"},
+				Access:       &MetafieldAccess{Storefront: shopify.MetafieldStorefrontAccessNone, CustomerAccount: shopify.MetafieldCustomerAccountAccessRead},
+				Capabilities: &MetafieldCapabilities{AdminFilterable: true},
+			},
+			"$app.pages": {Type: "number_integer", Validations: map[string]any{"min": int64(1)}},
+		}},
+		"PRODUCTVARIANT": {Definitions: map[string]MetafieldDefinition{
+			"$app.edition": {Type: "single_line_text_field"},
+		}},
+	}
+	if !reflect.DeepEqual(metafields, wantMetafields) {
+		t.Errorf("ImportAppTomlMetafields = %#v, want %#v", metafields, wantMetafields)
+	}
+}
+
+func TestAppTomlRoundTrip(t *testing.T) {
+	app, err := ParseAppToml([]byte(testAppToml))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	definitions, err := ImportAppToml(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metafieldDefinitions, err := ImportAppTomlMetafields(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exported, skipped, err := ExportAppToml(definitions)
+	if err != nil || len(skipped) != 0 {
+		t.Fatalf("ExportAppToml skipped %v: %v", skipped, err)
+	}
+
+	exported.Metafields, skipped, err = ExportAppTomlMetafields(metafieldDefinitions)
+	if err != nil || len(skipped) != 0 {
+		t.Fatalf("ExportAppTomlMetafields skipped %v: %v", skipped, err)
+	}
+
+	out, err := MarshalAppToml(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reparsed, err := ParseAppToml(out)
+	if err != nil {
+		t.Fatalf("parsing exported TOML: %v\n%s", err, out)
+	}
+
+	// Access equal to the defaults isn't written back.
+	want := app
+	edition := want.Metafields["product.variant"]["edition"]
+	edition.Access = nil
+	want.Metafields["product.variant"]["edition"] = edition
+
+	if !reflect.DeepEqual(reparsed, want) {
+		t.Errorf("exported TOML doesn't round trip:\n%s", out)
+	}
+}
+
+func TestAppTomlFieldType(t *testing.T) {
+	tests := []struct {
+		name            string
+		field           FieldDefinition
+		wantType        string
+		wantValidations map[string]any
+	}{
+		{
+			name:            "plain",
+			field:           FieldDefinition{Type: "number_integer", Validations: map[string]any{"min": 1.0}},
+			wantType:        "number_integer",
+			wantValidations: map[string]any{"min": int64(1)},
+		},
+		{
+			name:     "single type",
+			field:    FieldDefinition{Type: "metaobject_reference", Validations: map[string]any{"metaobject_definition": "$app:author"}},
+			wantType: "metaobject_reference<$app:author>",
+		},
+		{
+			name:     "types from a file",
+			field:    FieldDefinition{Type: "list.mixed_reference", Validations: map[string]any{"metaobject_definitions": []any{"$app:author", "$app:book"}}},
+			wantType: "list.mixed_reference<$app:author,$app:book>",
+		},
+		{
+			name:     "types from a pulled definition",
+			field:    FieldDefinition{Type: "list.mixed_reference", Validations: map[string]any{"metaobject_definitions": []string{"$app:author", "$app:book"}}},
+			wantType: "list.mixed_reference<$app:author,$app:book>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotType, gotValidations := appTomlFieldType(tt.field)
+			if gotType != tt.wantType || !reflect.DeepEqual(gotValidations, tt.wantValidations) {
+				t.Errorf("appTomlFieldType = %q, %v, want %q, %v", gotType, gotValidations, tt.wantType, tt.wantValidations)
+			}
+		})
+	}
+}
+
+func TestExportAppTomlMetafieldsSkipsOtherNamespaces(t *testing.T) {
+	owners := map[string]MetafieldOwnerDefinitions{
+		"PRODUCT": {Definitions: map[string]MetafieldDefinition{
+			"custom.care_guide": {Type: "multi_line_text_field"},
+			"$app.pages":        {Type: "number_integer"},
+		}},
+	}
+
+	metafields, skipped, err := ExportAppTomlMetafields(owners)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(skipped, []string{"PRODUCT/custom.care_guide"}) {
+		t.Errorf("skipped = %v, want the custom definition", skipped)
+	}
+
+	want := map[string]map[string]AppTomlMetafield{
+		"product": {"pages": {Name: "Pages", Type: "number_integer"}},
+	}
+	if !reflect.DeepEqual(metafields, want) {
+		t.Errorf("ExportAppTomlMetafields = %v, want %v", metafields, want)
+	}
+
+	owners = map[string]MetafieldOwnerDefinitions{
+		"SHOP": {Definitions: map[string]MetafieldDefinition{"$app.flag": {Type: "boolean"}}},
+	}
+	if _, _, err := ExportAppTomlMetafields(owners); err == nil {
+		t.Errorf("ExportAppTomlMetafields of shop metafields succeeded, want an error")
+	}
+}
+
+func TestImportAppTomlMetafieldsRejectsUnknownAccess(t *testing.T) {
+	app, err := ParseAppToml([]byte(`
+[product.metafields.app.pages]
+type = "number_integer"
+
+[product.metafields.app.pages.access]
+admin = "public_read_write"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ImportAppTomlMetafields(app); err == nil {
+		t.Errorf("ImportAppTomlMetafields succeeded, want an error for the admin access")
+	}
+}
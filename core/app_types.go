@@ -0,0 +1,63 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+// appTypePrefix starts the metaobject types in the reserved namespace of the
+// app whose token is used. The API resolves $app:<type> to
+// app--<app id>--<type>.
+const appTypePrefix = "$app:"
+
+func isAppType(defType string) bool {
+	return strings.HasPrefix(defType, appTypePrefix) || strings.HasPrefix(defType, appNamespacePrefix)
+}
+
+// appLocalType returns the type as written in definition files: types in the
+// namespace of the app are written as $app:<type>.
+func appLocalType(defType string, appId string) string {
+	prefix := appNamespacePrefix + appId + "--"
+	if appId != "" && strings.HasPrefix(defType, prefix) {
+		return appTypePrefix + strings.TrimPrefix(defType, prefix)
+	}
+
+	return defType
+}
+
+// localMetaobjectDefinitions returns the store definitions with the types of
+// the app written as $app:<type>. Types reserved by other apps are managed by
+// those apps and left out.
+func localMetaobjectDefinitions(definitions []shopify.Cli_MetaobjectDefinition, appId string) []shopify.Cli_MetaobjectDefinition {
+	local := make([]shopify.Cli_MetaobjectDefinition, 0, len(definitions))
+
+	for _, d := range definitions {
+		d.Type = appLocalType(d.Type, appId)
+
+		if strings.HasPrefix(d.Type, appNamespacePrefix) {
+			continue
+		}
+
+		local = append(local, d)
+	}
+
+	return local
+}
+
+// checkAppTypes makes sure the app is known when local definitions use
+// $app: types, since they can't be matched with the store otherwise.
+func checkAppTypes(definitions map[string]MetaobjectDefinition, appId string) error {
+	if appId != "" {
+		return nil
+	}
+
+	for defType := range definitions {
+		if strings.HasPrefix(defType, appTypePrefix) {
+			return fmt.Errorf("definition %s is in the app's namespace, set the app ID of the shop in the apps config", defType)
+		}
+	}
+
+	return nil
+}
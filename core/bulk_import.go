@@ -0,0 +1,194 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/Khan/genqlient/graphql"
+)
+
+// The mutation run once per line of the uploaded variables file.
+const bulkUpsertMutation = `mutation call($handle: MetaobjectHandleInput!, $metaobject: MetaobjectUpsertInput!) {
+  metaobjectUpsert(handle: $handle, metaobject: $metaobject) {
+    metaobject {
+      id
+      handle
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+var BulkPollInterval = 3 * time.Second
+
+type BulkImportResult struct {
+	Type       string
+	Handle     string
+	Id         string
+	UserErrors []string
+}
+
+type bulkUpsertVariables struct {
+	Handle     shopify.MetaobjectHandleInput `json:"handle"`
+	Metaobject shopify.MetaobjectUpsertInput `json:"metaobject"`
+}
+
+type bulkUpsertResultLine struct {
+	Data struct {
+		MetaobjectUpsert shopify.UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayload `json:"metaobjectUpsert"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+	LineNumber int `json:"__lineNumber"`
+}
+
+func NewBulkUpsertVariables(upserts []EntryUpsert) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+
+	for _, u := range upserts {
+		err := enc.Encode(bulkUpsertVariables{
+			Handle: shopify.MetaobjectHandleInput{
+				Type:   u.Type,
+				Handle: u.Handle,
+			},
+			Metaobject: u.Input,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encoding bulk variables for %s: %w", EntryKey(u.Type, u.Handle), err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// RunBulkUpsert uploads the upserts as a JSONL variables file, runs them as a
+// bulk mutation and waits for the operation to finish. The result contains
+// one entry per upsert, in the same order.
+func RunBulkUpsert(client graphql.Client, upserts []EntryUpsert) ([]BulkImportResult, error) {
+	if len(upserts) == 0 {
+		return nil, nil
+	}
+
+	variables, err := NewBulkUpsertVariables(upserts)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := StagedUpload(client, shopify.StagedUploadInput{
+		Resource: shopify.StagedUploadTargetGenerateUploadResourceBulkMutationVariables,
+		Filename: "metaobject-upserts.jsonl",
+		MimeType: "text/jsonl",
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := shopify.RunBulkMutation(context.Background(), client, bulkUpsertMutation, stagedUploadPath(target))
+	if err != nil {
+		return nil, fmt.Errorf("running bulk mutation: %w", err)
+	}
+
+	if len(res.BulkOperationRunMutation.UserErrors) > 0 {
+		return nil, fmt.Errorf("running bulk mutation: %v", res.BulkOperationRunMutation.UserErrors)
+	}
+
+	operation, err := waitForBulkOperation(client, res.BulkOperationRunMutation.BulkOperation.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resultUrl := operation.Url
+	if resultUrl == "" {
+		resultUrl = operation.PartialDataUrl
+	}
+
+	results := make([]BulkImportResult, len(upserts))
+	for i, u := range upserts {
+		results[i] = BulkImportResult{Type: u.Type, Handle: u.Handle}
+	}
+
+	if resultUrl != "" {
+		if err := readBulkUpsertResults(resultUrl, results); err != nil {
+			return results, err
+		}
+	}
+
+	if operation.Status != shopify.BulkOperationStatusCompleted {
+		return results, fmt.Errorf("bulk operation %s finished with status %s (%s)", operation.Id, operation.Status, operation.ErrorCode)
+	}
+
+	return results, nil
+}
+
+func waitForBulkOperation(client graphql.Client, id string) (shopify.Cli_BulkOperation, error) {
+	for {
+		res, err := shopify.GetCurrentBulkMutation(context.Background(), client)
+		if err != nil {
+			return shopify.Cli_BulkOperation{}, fmt.Errorf("polling bulk operation %s: %w", id, err)
+		}
+
+		operation := res.CurrentBulkOperation
+		if operation.Id != id {
+			return shopify.Cli_BulkOperation{}, fmt.Errorf("bulk operation %s is no longer the current operation", id)
+		}
+
+		switch operation.Status {
+		case shopify.BulkOperationStatusCreated, shopify.BulkOperationStatusRunning, shopify.BulkOperationStatusCanceling:
+			log.Printf("Bulk operation %s: %s, %s objects processed\n", id, operation.Status, operation.ObjectCount)
+			time.Sleep(BulkPollInterval)
+		default:
+			return operation, nil
+		}
+	}
+}
+
+func readBulkUpsertResults(url string, results []BulkImportResult) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return fmt.Errorf("downloading bulk operation results: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("downloading bulk operation results: %s", resp.Status)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		var line bulkUpsertResultLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return fmt.Errorf("parsing bulk operation result: %w", err)
+		}
+
+		if line.LineNumber < 0 || line.LineNumber >= len(results) {
+			return fmt.Errorf("bulk operation result for unknown line %d", line.LineNumber)
+		}
+
+		r := &results[line.LineNumber]
+		r.Id = line.Data.MetaobjectUpsert.Metaobject.Id
+
+		for _, e := range line.Errors {
+			r.UserErrors = append(r.UserErrors, e.Message)
+		}
+
+		for _, e := range line.Data.MetaobjectUpsert.UserErrors {
+			r.UserErrors = append(r.UserErrors, fmt.Sprintf("%v: %s", e.Field, e.Message))
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,138 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+// DefinitionImpact counts the store entries affected by pushing a changed
+// definition.
+type DefinitionImpact struct {
+	Type    string
+	Entries int
+	Fields  []FieldImpact
+}
+
+// FieldImpact is a field which is deleted or becomes required, with the
+// number of entries holding a value for a deleted field, or lacking a value
+// for a newly required one.
+type FieldImpact struct {
+	Field    string
+	Deleted  bool
+	Required bool
+	Entries  int
+}
+
+// Impact returns, for each local definition which differs from the store,
+// how many entries the type has and how many of them are affected by deleted
+// and newly required fields.
+func (ms *MetaobjectService) Impact(definitions map[string]MetaobjectDefinition) ([]DefinitionImpact, error) {
+	data, err := shopify.ListMetaobjectDefinitions(context.Background(), *ms.ShopifyClient, 250)
+	if err != nil {
+		return nil, fmt.Errorf("listing metaobject definitions: %w", err)
+	}
+
+	nodes := localMetaobjectDefinitions(data.MetaobjectDefinitions.Nodes, ms.AppId)
+	remoteDefinitions := CreateMetaobjectDefinitionMap(nodes)
+	counts := make(map[string]int, len(nodes))
+	for _, d := range nodes {
+		counts[d.Type] = d.MetaobjectsCount
+	}
+
+	types := make([]string, 0, len(definitions))
+	for defType := range definitions {
+		types = append(types, defType)
+	}
+	sort.Strings(types)
+
+	impacts := make([]DefinitionImpact, 0)
+
+	for _, defType := range types {
+		remote, ok := remoteDefinitions[defType]
+		if !ok {
+			continue
+		}
+
+		fields := changedFields(definitions[defType], remote)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if counts[defType] > 0 {
+			metaobjects, err := ms.listFieldValues(defType)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, m := range metaobjects {
+				values := make(map[string]bool, len(m.Fields))
+				for _, f := range m.Fields {
+					values[f.Key] = f.Value != ""
+				}
+
+				for i, f := range fields {
+					if (f.Deleted && values[f.Field]) || (f.Required && !values[f.Field]) {
+						fields[i].Entries++
+					}
+				}
+			}
+		}
+
+		impacts = append(impacts, DefinitionImpact{
+			Type:    defType,
+			Entries: counts[defType],
+			Fields:  fields,
+		})
+	}
+
+	return impacts, nil
+}
+
+// listFieldValues lists only the field values of every entry of a type,
+// which is cheap enough to list 250 entries at a time.
+func (ms *MetaobjectService) listFieldValues(defType string) ([]shopify.Cli_MetaobjectFieldValues, error) {
+	var metaobjects []shopify.Cli_MetaobjectFieldValues
+	cursor := ""
+
+	for {
+		data, err := shopify.ListMetaobjectFieldValues(context.Background(), *ms.ShopifyClient, defType, 250, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("listing entries of type %s: %w", defType, err)
+		}
+
+		metaobjects = append(metaobjects, data.Metaobjects.Nodes...)
+
+		if !data.Metaobjects.PageInfo.HasNextPage {
+			return metaobjects, nil
+		}
+
+		cursor = data.Metaobjects.PageInfo.EndCursor
+	}
+}
+
+// changedFields returns the fields of the remote definition which are
+// missing locally, and the local fields which become required.
+func changedFields(local MetaobjectDefinition, remote MetaobjectDefinition) []FieldImpact {
+	fields := make([]FieldImpact, 0)
+
+	for key := range remote.FieldDefinitions {
+		if _, ok := local.FieldDefinitions[key]; !ok {
+			fields = append(fields, FieldImpact{Field: key, Deleted: true})
+		}
+	}
+
+	for key, f := range local.FieldDefinitions {
+		if f.Required && !remote.FieldDefinitions[key].Required {
+			fields = append(fields, FieldImpact{Field: key, Required: true})
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Field < fields[j].Field
+	})
+
+	return fields
+}
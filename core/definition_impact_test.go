@@ -0,0 +1,72 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChangedFields(t *testing.T) {
+	remote := MetaobjectDefinition{FieldDefinitions: map[string]FieldDefinition{
+		"title":    {Type: "single_line_text_field", Required: true},
+		"subtitle": {Type: "single_line_text_field"},
+		"body":     {Type: "rich_text_field"},
+	}}
+
+	tests := []struct {
+		name   string
+		fields map[string]FieldDefinition
+		want   []FieldImpact
+	}{
+		{
+			name:   "unchanged",
+			fields: remote.FieldDefinitions,
+			want:   []FieldImpact{},
+		},
+		{
+			name: "deleted",
+			fields: map[string]FieldDefinition{
+				"title": {Type: "single_line_text_field", Required: true},
+			},
+			want: []FieldImpact{
+				{Field: "body", Deleted: true},
+				{Field: "subtitle", Deleted: true},
+			},
+		},
+		{
+			name: "newly required",
+			fields: map[string]FieldDefinition{
+				"title":    {Type: "single_line_text_field", Required: true},
+				"subtitle": {Type: "single_line_text_field", Required: true},
+				"body":     {Type: "rich_text_field"},
+			},
+			want: []FieldImpact{{Field: "subtitle", Required: true}},
+		},
+		{
+			name: "new required field",
+			fields: map[string]FieldDefinition{
+				"title":    {Type: "single_line_text_field", Required: true},
+				"subtitle": {Type: "single_line_text_field"},
+				"body":     {Type: "rich_text_field"},
+				"author":   {Type: "single_line_text_field", Required: true},
+			},
+			want: []FieldImpact{{Field: "author", Required: true}},
+		},
+		{
+			name: "no longer required",
+			fields: map[string]FieldDefinition{
+				"title":    {Type: "single_line_text_field"},
+				"subtitle": {Type: "single_line_text_field"},
+				"body":     {Type: "rich_text_field"},
+			},
+			want: []FieldImpact{},
+		},
+	}
+
+	for _, tt := range tests {
+		local := MetaobjectDefinition{FieldDefinitions: tt.fields}
+
+		if got := changedFields(local, remote); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: changedFields = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"sort"
+)
+
+// creationOrder orders the definitions to create so referenced definitions
+// are created before the definitions referencing them. Definitions which
+// reference each other are ordered by type.
+func creationOrder(definitions map[string]MetaobjectDefinition, create map[string]bool) []string {
+	keys := make([]string, 0, len(create))
+	for key := range create {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	order := make([]string, 0, len(keys))
+	// visited is false while a definition's references are being visited,
+	// and true once it is ordered.
+	visited := make(map[string]bool, len(keys))
+
+	var visit func(key string)
+	visit = func(key string) {
+		if _, ok := visited[key]; ok {
+			return
+		}
+		visited[key] = false
+
+		for _, ref := range definitionReferences(definitions[key]) {
+			if create[ref] {
+				visit(ref)
+			}
+		}
+
+		visited[key] = true
+		order = append(order, key)
+	}
+
+	for _, key := range keys {
+		visit(key)
+	}
+
+	return order
+}
+
+// definitionReferences returns the types the fields of a definition
+// reference, sorted.
+func definitionReferences(definition MetaobjectDefinition) []string {
+	set := make(map[string]bool)
+	for _, f := range definition.FieldDefinitions {
+		for _, defType := range referencedTypes(f.Validations) {
+			set[defType] = true
+		}
+	}
+
+	refs := make([]string, 0, len(set))
+	for defType := range set {
+		refs = append(refs, defType)
+	}
+	sort.Strings(refs)
+
+	return refs
+}
+
+// withoutPendingReferences leaves out the fields which reference definitions
+// that don't exist yet, such as the definition itself or definitions which
+// reference each other. They are added once every definition is created.
+func withoutPendingReferences(definition MetaobjectDefinition, referenceIds map[string]string) (MetaobjectDefinition, []string) {
+	fields := make(map[string]FieldDefinition, len(definition.FieldDefinitions))
+	pending := make([]string, 0)
+
+	for key, f := range definition.FieldDefinitions {
+		ready := true
+		for _, defType := range referencedTypes(f.Validations) {
+			if _, ok := referenceIds[defType]; !ok {
+				ready = false
+			}
+		}
+
+		if ready {
+			fields[key] = f
+		} else {
+			pending = append(pending, key)
+		}
+	}
+	sort.Strings(pending)
+
+	definition.FieldDefinitions = fields
+
+	return definition, pending
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func referenceField(defTypes ...any) FieldDefinition {
+	if len(defTypes) == 1 {
+		return FieldDefinition{Type: "metaobject_reference", Validations: map[string]any{"metaobject_definition": defTypes[0]}}
+	}
+	return FieldDefinition{Type: "mixed_reference", Validations: map[string]any{"metaobject_definitions": defTypes}}
+}
+
+func TestCreationOrder(t *testing.T) {
+	definitions := map[string]MetaobjectDefinition{
+		"author": {FieldDefinitions: map[string]FieldDefinition{"name": {Type: "single_line_text_field"}}},
+		"book":   {FieldDefinitions: map[string]FieldDefinition{"author": referenceField("author"), "shelf": referenceField("shelf")}},
+		"review": {FieldDefinitions: map[string]FieldDefinition{"subject": referenceField("book", "author")}},
+		"shelf":  {FieldDefinitions: map[string]FieldDefinition{"library": referenceField("library")}},
+		// library exists in the store already.
+		"library": {},
+		"a":       {FieldDefinitions: map[string]FieldDefinition{"next": referenceField("b")}},
+		"b":       {FieldDefinitions: map[string]FieldDefinition{"next": referenceField("a"), "self": referenceField("b")}},
+	}
+	create := map[string]bool{"author": true, "book": true, "review": true, "shelf": true, "a": true, "b": true}
+
+	want := []string{"b", "a", "author", "shelf", "book", "review"}
+	if got := creationOrder(definitions, create); !reflect.DeepEqual(got, want) {
+		t.Errorf("creationOrder = %v, want %v", got, want)
+	}
+}
+
+func TestWithoutPendingReferences(t *testing.T) {
+	definition := MetaobjectDefinition{FieldDefinitions: map[string]FieldDefinition{
+		"name":   {Type: "single_line_text_field"},
+		"author": referenceField("author"),
+		"parent": referenceField("category"),
+		"mixed":  referenceField("author", "category"),
+	}}
+
+	got, pending := withoutPendingReferences(definition, map[string]string{"author": "gid://shopify/MetaobjectDefinition/1"})
+
+	if want := []string{"mixed", "parent"}; !reflect.DeepEqual(pending, want) {
+		t.Errorf("pending = %v, want %v", pending, want)
+	}
+	if len(got.FieldDefinitions) != 2 || got.FieldDefinitions["author"].Type == "" || got.FieldDefinitions["name"].Type == "" {
+		t.Errorf("fields = %v", got.FieldDefinitions)
+	}
+	if len(definition.FieldDefinitions) != 4 {
+		t.Errorf("the original definition lost fields")
+	}
+}
+
+func TestReferenceValidationsRoundTrip(t *testing.T) {
+	referenceTypes := map[string]string{
+		"gid://shopify/MetaobjectDefinition/1": "author",
+		"gid://shopify/MetaobjectDefinition/2": "book",
+	}
+	validations := map[string]any{
+		"metaobject_definition_ids": []any{"gid://shopify/MetaobjectDefinition/1", "gid://shopify/MetaobjectDefinition/2"},
+	}
+
+	normalizeReferenceValidations(validations, referenceTypes)
+
+	if want := []any{"author", "book"}; !reflect.DeepEqual(validations["metaobject_definitions"], want) {
+		t.Fatalf("metaobject_definitions = %#v, want %#v", validations["metaobject_definitions"], want)
+	}
+
+	referenceIds := map[string]string{"author": "gid://other/1", "book": "gid://other/2"}
+	for _, v := range []any{validations["metaobject_definitions"], []string{"author", "book"}} {
+		inputs, err := NewMetaobjectFieldValidations(map[string]any{"metaobject_definitions": v}, referenceIds)
+		if err != nil {
+			t.Fatalf("%#v: %v", v, err)
+		}
+		if len(inputs) != 1 || inputs[0].Name != "metaobject_definition_ids" || inputs[0].Value != `["gid://other/1","gid://other/2"]` {
+			t.Errorf("%#v: validations %v", v, inputs)
+		}
+	}
+
+	if _, err := NewMetaobjectFieldValidations(map[string]any{"metaobject_definitions": []any{"author", "missing"}}, referenceIds); err == nil {
+		t.Errorf("a missing type was accepted")
+	}
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"sort"
+	"sync"
+)
+
+// DriftStatus is how the definition of a type in a shop compares with the
+// local definition.
+type DriftStatus string
+
+const (
+	DriftInSync  DriftStatus = "in-sync"
+	DriftChanged DriftStatus = "changed"
+	// DriftMissing is a local definition the shop doesn't have.
+	DriftMissing DriftStatus = "missing"
+	// DriftExtra is a shop definition which isn't defined locally.
+	DriftExtra DriftStatus = "extra"
+)
+
+// ShopDrift is the drift of a single shop, or the error which kept it from
+// being compared.
+type ShopDrift struct {
+	Shop  string                 `json:"shop"`
+	Types map[string]DriftStatus `json:"types,omitempty"`
+	Error string                 `json:"error,omitempty"`
+}
+
+// Drifted reports whether the shop failed or differs from the local
+// definitions.
+func (d ShopDrift) Drifted() bool {
+	if d.Error != "" {
+		return true
+	}
+
+	for _, status := range d.Types {
+		if status != DriftInSync {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Drift compares the local definitions with the store, type by type.
+func (ms *MetaobjectService) Drift(definitions map[string]MetaobjectDefinition) (map[string]DriftStatus, error) {
+	if err := checkAppTypes(definitions, ms.AppId); err != nil {
+		return nil, err
+	}
+
+	remoteDefinitions, err := ms.remoteDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := diffDefinitions(definitions, remoteDefinitions)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]DriftStatus, len(definitions))
+
+	for defType := range definitions {
+		if _, ok := remoteDefinitions[defType]; !ok {
+			statuses[defType] = DriftMissing
+		} else if _, ok := diffs[defType]; ok {
+			statuses[defType] = DriftChanged
+		} else {
+			statuses[defType] = DriftInSync
+		}
+	}
+
+	for defType := range remoteDefinitions {
+		if _, ok := definitions[defType]; !ok {
+			statuses[defType] = DriftExtra
+		}
+	}
+
+	return statuses, nil
+}
+
+// DetectDrift compares the local definitions with every shop concurrently.
+// The results are sorted by shop.
+func DetectDrift(services map[string]*MetaobjectService, definitions map[string]MetaobjectDefinition) []ShopDrift {
+	results := make([]ShopDrift, 0, len(services))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for shop, ms := range services {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			d := ShopDrift{Shop: shop}
+
+			types, err := ms.Drift(definitions)
+			if err != nil {
+				d.Error = err.Error()
+			} else {
+				d.Types = types
+			}
+
+			mu.Lock()
+			results = append(results, d)
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Shop < results[j].Shop
+	})
+
+	return results
+}
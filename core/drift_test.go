@@ -0,0 +1,130 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// definitionsClient answers ListMetaobjectDefinitions with fixed definitions.
+type definitionsClient struct {
+	definitions string
+}
+
+func (c definitionsClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	return json.Unmarshal([]byte(`{"metaobjectDefinitions":{"nodes":[`+c.definitions+`],"pageInfo":{}}}`), resp.Data)
+}
+
+const driftDefinitionsJson = `{
+	"id": "gid://shopify/MetaobjectDefinition/1",
+	"type": "author",
+	"name": "Author",
+	"access": {"admin": "MERCHANT_READ_WRITE", "storefront": "NONE"},
+	"fieldDefinitions": [
+		{"key": "name", "name": "Name", "type": {"name": "single_line_text_field"}, "validations": []}
+	]
+}, {
+	"id": "gid://shopify/MetaobjectDefinition/2",
+	"type": "book",
+	"name": "Book",
+	"access": {"admin": "MERCHANT_READ_WRITE", "storefront": "NONE"},
+	"fieldDefinitions": [
+		{"key": "title", "name": "Title", "type": {"name": "single_line_text_field"}, "validations": []}
+	]
+}, {
+	"id": "gid://shopify/MetaobjectDefinition/3",
+	"type": "genre",
+	"name": "Genre",
+	"access": {"admin": "MERCHANT_READ_WRITE", "storefront": "NONE"},
+	"fieldDefinitions": []
+}`
+
+func driftService(definitions string) *MetaobjectService {
+	var client graphql.Client = definitionsClient{definitions: definitions}
+	return &MetaobjectService{ShopifyClient: &client}
+}
+
+func TestDrift(t *testing.T) {
+	ms := driftService(driftDefinitionsJson)
+
+	definitions, err := ms.Pull()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	book := definitions["book"]
+	book.Description = "A book"
+	definitions["book"] = book
+	definitions["series"] = MetaobjectDefinition{Name: "Series"}
+	delete(definitions, "genre")
+
+	got, err := ms.Drift(definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]DriftStatus{
+		"author": DriftInSync,
+		"book":   DriftChanged,
+		"series": DriftMissing,
+		"genre":  DriftExtra,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Drift = %v, want %v", got, want)
+	}
+
+	// Drift and Diff agree on which types changed.
+	diffs, err := ms.Diff(definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for defType, status := range got {
+		if _, ok := diffs[defType]; ok != (status == DriftChanged || status == DriftMissing) {
+			t.Errorf("Diff of %s is %v, but its drift is %s", defType, ok, status)
+		}
+	}
+}
+
+func TestDetectDrift(t *testing.T) {
+	var failing graphql.Client = failingClient{mu: new(sync.Mutex), started: new(int)}
+
+	inSync := driftService(driftDefinitionsJson)
+	definitions, err := inSync.Pull()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	services := map[string]*MetaobjectService{
+		"c-shop": driftService(driftDefinitionsJson),
+		"a-shop": {ShopifyClient: &failing},
+		"b-shop": driftService(`{"id": "gid://shopify/MetaobjectDefinition/1", "type": "author", "name": "Author", "fieldDefinitions": []}`),
+	}
+
+	results := DetectDrift(services, definitions)
+
+	shops := make([]string, len(results))
+	for i, r := range results {
+		shops[i] = r.Shop
+	}
+	if !reflect.DeepEqual(shops, []string{"a-shop", "b-shop", "c-shop"}) {
+		t.Fatalf("shops = %v, want them sorted", shops)
+	}
+
+	if !strings.HasSuffix(results[0].Error, "boom") || !results[0].Drifted() {
+		t.Errorf("failing shop = %+v, want its error reported as drift", results[0])
+	}
+
+	if results[1].Types["book"] != DriftMissing || !results[1].Drifted() {
+		t.Errorf("drifted shop = %+v, want book missing", results[1])
+	}
+
+	if results[2].Drifted() {
+		t.Errorf("in sync shop = %+v, want no drift", results[2])
+	}
+}
@@ -0,0 +1,193 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+// FilePollInterval is how often the status of an uploaded file is checked
+// while the store processes it.
+var FilePollInterval = 2 * time.Second
+
+// localAsset is a file in the repository which a file_reference field points
+// at with a path relative to its entry file, e.g. ./images/cover.png. Assets
+// are uploaded under a name holding a hash of their content, so a file which
+// was uploaded before is found in the store instead of being uploaded again.
+type localAsset struct {
+	Path     string
+	FileName string
+	Content  []byte
+}
+
+func isLocalAssetPath(value string) bool {
+	return strings.HasPrefix(value, "./") || strings.HasPrefix(value, "../")
+}
+
+func readLocalAsset(path string) (localAsset, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return localAsset{}, fmt.Errorf("reading asset %s: %w", path, err)
+	}
+
+	sum := sha256.Sum256(content)
+	ext := filepath.Ext(path)
+	name := strings.TrimSuffix(filepath.Base(path), ext)
+
+	return localAsset{
+		Path:     path,
+		FileName: name + "-" + hex.EncodeToString(sum[:])[:12] + ext,
+		Content:  content,
+	}, nil
+}
+
+// resolveAssets returns a copy of the entry with the local asset paths of
+// its file_reference fields replaced by the file names the assets are stored
+// under, along with the assets it references.
+func (es *MetaobjectEntryService) resolveAssets(defType string, entry MetaobjectEntry, fieldTypes map[string]string) (MetaobjectEntry, []localAsset, error) {
+	e := MetaobjectEntry{
+		Status: entry.Status,
+		Fields: make(map[string]any, len(entry.Fields)),
+	}
+	assets := make([]localAsset, 0)
+
+	for key, value := range entry.Fields {
+		if baseFieldType(fieldTypes[key]) != "file_reference" {
+			e.Fields[key] = value
+			continue
+		}
+
+		resolved, err := mapReferences(value, func(ref string) (string, error) {
+			if !isLocalAssetPath(ref) {
+				return ref, nil
+			}
+
+			asset, err := readLocalAsset(filepath.Join(es.Dir, defType, ref))
+			if err != nil {
+				return "", err
+			}
+
+			assets = append(assets, asset)
+			return asset.FileName, nil
+		})
+		if err != nil {
+			return MetaobjectEntry{}, nil, fmt.Errorf("field %s: %w", key, err)
+		}
+
+		e.Fields[key] = resolved
+	}
+
+	return e, assets, nil
+}
+
+// uploadAssets uploads the assets which aren't in the store yet.
+func (es *MetaobjectEntryService) uploadAssets(assets []localAsset) error {
+	for _, asset := range assets {
+		_, err := es.resolver().ResolveId("file_reference", asset.FileName)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, ErrReferenceNotFound) {
+			return err
+		}
+
+		id, err := es.uploadAsset(asset)
+		if err != nil {
+			return err
+		}
+
+		es.resolver().ids[es.resolver().cacheKey("file_reference", asset.FileName)] = id
+		log.Printf("Uploaded asset %s as %s\n", asset.Path, asset.FileName)
+	}
+
+	return nil
+}
+
+func (es *MetaobjectEntryService) uploadAsset(asset localAsset) (string, error) {
+	mimeType := mime.TypeByExtension(filepath.Ext(asset.FileName))
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+
+	resource := shopify.StagedUploadTargetGenerateUploadResourceFile
+	contentType := shopify.FileContentTypeFile
+
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		resource = shopify.StagedUploadTargetGenerateUploadResourceImage
+		contentType = shopify.FileContentTypeImage
+	case strings.HasPrefix(mimeType, "video/"):
+		resource = shopify.StagedUploadTargetGenerateUploadResourceVideo
+		contentType = shopify.FileContentTypeVideo
+	}
+
+	target, err := StagedUpload(*es.ShopifyClient, shopify.StagedUploadInput{
+		Resource: resource,
+		Filename: asset.FileName,
+		MimeType: mimeType,
+		FileSize: strconv.Itoa(len(asset.Content)),
+	}, asset.Content)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := shopify.CreateFiles(context.Background(), *es.ShopifyClient, []shopify.FileCreateInput{{
+		OriginalSource:          target.ResourceUrl,
+		Filename:                asset.FileName,
+		ContentType:             contentType,
+		DuplicateResolutionMode: shopify.FileCreateInputDuplicateResolutionModeRaiseError,
+	}})
+	if err != nil {
+		return "", fmt.Errorf("creating file %s: %w", asset.FileName, err)
+	}
+
+	if len(res.FileCreate.UserErrors) > 0 {
+		return "", fmt.Errorf("creating file %s: %v", asset.FileName, res.FileCreate.UserErrors)
+	}
+
+	if len(res.FileCreate.Files) == 0 {
+		return "", errors.New("no file created for " + asset.FileName)
+	}
+
+	return es.waitForFile(res.FileCreate.Files[0])
+}
+
+// waitForFile polls a created file until the store has finished processing
+// it, and returns its id.
+func (es *MetaobjectEntryService) waitForFile(file shopify.Cli_File) (string, error) {
+	id := file.GetId()
+	query := "id:" + id[strings.LastIndex(id, "/")+1:]
+
+	for {
+		switch file.GetFileStatus() {
+		case shopify.FileStatusReady:
+			return id, nil
+		case shopify.FileStatusFailed:
+			return "", fmt.Errorf("processing file %s failed: %v", id, file.GetFileErrors())
+		}
+
+		time.Sleep(FilePollInterval)
+
+		res, err := shopify.FindFiles(context.Background(), *es.ShopifyClient, query)
+		if err != nil {
+			return "", fmt.Errorf("polling file %s: %w", id, err)
+		}
+
+		for _, f := range res.Files.Nodes {
+			if f.GetId() == id {
+				file = f
+			}
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/hjson/hjson-go/v4"
+)
+
+// Entries are stored one file per metaobject, grouped in a directory per
+// metaobject type: <dir>/<type>/<handle>.hjson
+const entryFileExtension = ".hjson"
+
+func EntryFilePath(dir string, defType string, handle string) string {
+	return filepath.Join(dir, defType, handle+entryFileExtension)
+}
+
+// ReadEntryDirectory reads the entries of the given types from dir. When no
+// types are given, every type directory is read.
+func ReadEntryDirectory(dir string, types []string) (map[string]map[string]MetaobjectEntry, error) {
+	if len(types) == 0 {
+		dirEntries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("reading entry directory %s: %w", dir, err)
+		}
+
+		for _, d := range dirEntries {
+			if d.IsDir() {
+				types = append(types, d.Name())
+			}
+		}
+	}
+
+	entries := make(map[string]map[string]MetaobjectEntry, len(types))
+
+	for _, defType := range types {
+		typeEntries, err := readEntryTypeDirectory(dir, defType)
+		if err != nil {
+			return nil, err
+		}
+
+		entries[defType] = typeEntries
+	}
+
+	return entries, nil
+}
+
+func readEntryTypeDirectory(dir string, defType string) (map[string]MetaobjectEntry, error) {
+	entries := make(map[string]MetaobjectEntry)
+
+	files, err := os.ReadDir(filepath.Join(dir, defType))
+	if errors.Is(err, os.ErrNotExist) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading entries of type %s: %w", defType, err)
+	}
+
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, entryFileExtension) {
+			continue
+		}
+
+		handle, locale := splitEntryFileName(name)
+		if locale != "" {
+			continue
+		}
+
+		entry, err := ReadEntryFile(EntryFilePath(dir, defType, handle))
+		if err != nil {
+			return nil, err
+		}
+
+		entries[handle] = entry
+	}
+
+	return entries, nil
+}
+
+func ReadEntryFile(path string) (MetaobjectEntry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return MetaobjectEntry{}, fmt.Errorf("reading entry file %s: %w", path, err)
+	}
+
+	var entry MetaobjectEntry
+	if err := hjson.Unmarshal(b, &entry); err != nil {
+		return MetaobjectEntry{}, fmt.Errorf("unmarshalling entry file %s: %w", path, err)
+	}
+
+	if entry.Fields == nil {
+		entry.Fields = make(map[string]any)
+	}
+
+	return entry, nil
+}
+
+// FindEntryFieldLine returns the line of an entry file where a field is set,
+// or 0 when it can't be found.
+func FindEntryFieldLine(path string, field string) int {
+	b, err := os.ReadFile(path)
+	if err != nil || field == "" {
+		return 0
+	}
+
+	re := regexp.MustCompile(`^\s*["']?` + regexp.QuoteMeta(field) + `["']?\s*:`)
+
+	for i, line := range strings.Split(string(b), "\n") {
+		if re.MatchString(line) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+var hjsonLiteral = regexp.MustCompile(`^(true|false|null|-?[0-9][0-9.eE+-]*)(?:\s*[,}\]]|\s*$|\s+#|\s*//)`)
+
+var entryStatusLine = regexp.MustCompile(`^(\s*["']?status["']?\s*:\s*)("[A-Za-z_]*"|'[A-Za-z_]*'|[A-Za-z_]*)(.*)$`)
+
+// SetEntryFileStatus changes the status of an entry file in place, keeping
+// its comments and formatting. The status line is replaced, or added before
+// the first key of the entry. It reports false and leaves the file alone when
+// the file isn't laid out one key per line.
+func SetEntryFileStatus(path string, status shopify.MetaobjectStatus) (bool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("reading entry file %s: %w", path, err)
+	}
+
+	lines := strings.Split(string(b), "\n")
+	keyLines := entryKeyLines(lines)
+
+	for _, i := range keyLines {
+		if m := entryStatusLine.FindStringSubmatch(lines[i]); m != nil {
+			value := string(status)
+			if m[2] != "" && (m[2][0] == '"' || m[2][0] == '\'') {
+				value = m[2][:1] + value + m[2][:1]
+			}
+
+			lines[i] = m[1] + value + m[3]
+			return true, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0644)
+		}
+	}
+
+	if len(keyLines) == 0 {
+		return false, nil
+	}
+
+	first := keyLines[0]
+	indent := lines[first][:len(lines[first])-len(strings.TrimLeft(lines[first], " \t"))]
+	statusLine := indent + "status: " + string(status)
+	if strings.HasPrefix(strings.TrimSpace(lines[first]), `"`) {
+		statusLine = indent + `"status": "` + string(status) + `",`
+	}
+	lines = append(lines[:first], append([]string{statusLine}, lines[first:]...)...)
+
+	return true, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0644)
+}
+
+// entryKeyLines returns the lines of a hjson file which start with a key of
+// the root object. It skips strings and comments to track nesting, and
+// returns nothing when a line holds more than one root key.
+func entryKeyLines(lines []string) []int {
+	keyLines := make([]int, 0)
+	// stack holds the open objects and lists, root is the depth of the
+	// root object's keys.
+	stack := make([]byte, 0)
+	root := -1
+	inBlockComment, inMultiline := false, false
+
+	for n, line := range lines {
+		atStart := !inBlockComment && !inMultiline
+		afterColon := false
+
+		for i := 0; i < len(line); i++ {
+			c := line[i]
+
+			switch {
+			case inBlockComment:
+				if strings.HasPrefix(line[i:], "*/") {
+					inBlockComment = false
+					i++
+				}
+				continue
+			case inMultiline:
+				if strings.HasPrefix(line[i:], "'''") {
+					inMultiline = false
+					i += 2
+				}
+				continue
+			case c == ' ' || c == '\t' || c == '\r' || c == ',':
+				continue
+			case c == ':':
+				afterColon = true
+				continue
+			case c == '#' || strings.HasPrefix(line[i:], "//"):
+				i = len(line)
+				continue
+			case strings.HasPrefix(line[i:], "/*"):
+				inBlockComment = true
+				i++
+				continue
+			}
+
+			if root < 0 {
+				// A root without braces starts with a key.
+				root = 0
+				if c == '{' {
+					root = 1
+				}
+			}
+
+			if atStart && len(stack) == root && c != '}' {
+				keyLines = append(keyLines, n)
+			} else if len(stack) == root && c != '}' && !afterColon {
+				// Another root key on the same line.
+				return nil
+			}
+			atStart = false
+
+			switch {
+			case strings.HasPrefix(line[i:], "'''"):
+				inMultiline = true
+				i += 2
+			case c == '"' || c == '\'':
+				for i++; i < len(line) && line[i] != c; i++ {
+					if line[i] == '\\' {
+						i++
+					}
+				}
+			case c == '{' || c == '[':
+				stack = append(stack, c)
+			case c == '}' || c == ']':
+				if len(stack) > 0 {
+					stack = stack[:len(stack)-1]
+				}
+			case afterColon || (len(stack) > 0 && stack[len(stack)-1] == '['):
+				// Quoteless strings run to the end of the line, unlike
+				// numbers, booleans and null.
+				if m := hjsonLiteral.FindStringSubmatchIndex(line[i:]); m != nil {
+					i += m[3] - 1
+				} else {
+					i = len(line)
+				}
+			default:
+				// Keys run to the colon.
+				for i+1 < len(line) && line[i+1] != ':' {
+					i++
+				}
+			}
+
+			afterColon = false
+		}
+	}
+
+	return keyLines
+}
+
+func WriteEntryFile(path string, entry MetaobjectEntry) error {
+	payload, err := hjson.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshalling entry file %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating entry directory for %s: %w", path, err)
+	}
+
+	return os.WriteFile(path, payload, 0644)
+}
+
+func WriteEntryDirectory(dir string, entries map[string]map[string]MetaobjectEntry) error {
+	for defType, typeEntries := range entries {
+		for handle, entry := range typeEntries {
+			if err := WriteEntryFile(EntryFilePath(dir, defType, handle), entry); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+func TestSetEntryFileStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		patched bool
+	}{
+		{
+			name: "replace",
+			in: `{
+  # published for the launch
+  "status": "DRAFT" # until review
+  fields: {
+    status: DRAFT
+    name: Jane Doe
+  }
+}
+`,
+			want: `{
+  # published for the launch
+  "status": "ACTIVE" # until review
+  fields: {
+    status: DRAFT
+    name: Jane Doe
+  }
+}
+`,
+			patched: true,
+		},
+		{
+			name: "insert",
+			in: `{
+  // the author
+  fields: {
+    status: DRAFT
+    bio: '''
+      Writes {books}
+      status: none
+      '''
+    tags: [
+      status: x
+    ]
+    rating: {value: 4, scale_max: 5}
+  }
+}
+`,
+			want: `{
+  // the author
+  status: ACTIVE
+  fields: {
+    status: DRAFT
+    bio: '''
+      Writes {books}
+      status: none
+      '''
+    tags: [
+      status: x
+    ]
+    rating: {value: 4, scale_max: 5}
+  }
+}
+`,
+			patched: true,
+		},
+		{
+			name: "json",
+			in: `{
+  "fields": {"name": "Jane"},
+  "status": "DRAFT"
+}`,
+			want: `{
+  "fields": {"name": "Jane"},
+  "status": "ACTIVE"
+}`,
+			patched: true,
+		},
+		{
+			name: "insert json",
+			in: `{
+  "fields": {"name": "Jane"}
+}`,
+			want: `{
+  "status": "ACTIVE",
+  "fields": {"name": "Jane"}
+}`,
+			patched: true,
+		},
+		{
+			name: "no braces",
+			in: `fields: {
+  name: Jane {the writer}
+}
+`,
+			want: `status: ACTIVE
+fields: {
+  name: Jane {the writer}
+}
+`,
+			patched: true,
+		},
+		{
+			name:    "one line",
+			in:      `{fields: {name: "Jane"}, status: DRAFT}`,
+			want:    `{fields: {name: "Jane"}, status: DRAFT}`,
+			patched: false,
+		},
+	}
+
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "jane.hjson")
+		if err := os.WriteFile(path, []byte(tt.in), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		patched, err := SetEntryFileStatus(path, shopify.MetaobjectStatusActive)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if patched != tt.patched {
+			t.Errorf("%s: patched = %v, want %v", tt.name, patched, tt.patched)
+		}
+
+		b, _ := os.ReadFile(path)
+		if string(b) != tt.want {
+			t.Errorf("%s: file is\n%s\nwant\n%s", tt.name, b, tt.want)
+		}
+
+		if !patched {
+			continue
+		}
+
+		entry, err := ReadEntryFile(path)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		} else if entry.Status != shopify.MetaobjectStatusActive {
+			t.Errorf("%s: status = %v", tt.name, entry.Status)
+		}
+	}
+}
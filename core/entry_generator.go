@@ -0,0 +1,624 @@
+package core
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var generatorWords = []string{
+	"amber", "autumn", "birch", "breeze", "canyon", "cedar", "coast", "copper",
+	"crystal", "dawn", "delta", "ember", "fern", "field", "forest", "garden",
+	"glacier", "harbor", "harvest", "hollow", "island", "ivory", "juniper", "lake",
+	"lantern", "linen", "maple", "meadow", "mesa", "mist", "moss", "north",
+	"oak", "ocean", "orchard", "pebble", "pine", "prairie", "quartz", "river",
+	"sage", "shore", "sierra", "slate", "spring", "stone", "summit", "sun",
+	"thistle", "timber", "valley", "velvet", "willow", "winter", "wool", "yarrow",
+}
+
+var handleSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Measurement units used when a field has no min or max validation to take
+// the unit from.
+var generatorUnits = map[string]string{
+	"dimension": "cm",
+	"volume":    "ml",
+	"weight":    "kg",
+}
+
+// EntryGenerator creates entries with fake but plausible values for a
+// metaobject definition, respecting field types and validations. The same
+// seed and existing entries always generate the same entries.
+type EntryGenerator struct {
+	rand *rand.Rand
+	// Entries holds the existing entries, which metaobject references are
+	// picked from. Generated entries are added as they are created.
+	Entries map[string]map[string]MetaobjectEntry
+	skipped map[string]bool
+}
+
+func NewEntryGenerator(seed int64, entries map[string]map[string]MetaobjectEntry) *EntryGenerator {
+	if entries == nil {
+		entries = make(map[string]map[string]MetaobjectEntry)
+	}
+
+	return &EntryGenerator{
+		rand:    rand.New(rand.NewSource(seed)),
+		Entries: entries,
+		skipped: make(map[string]bool),
+	}
+}
+
+// Generate creates count entries of a type, keyed by handle. Optional fields
+// are left empty now and then, like they would be in real data.
+func (g *EntryGenerator) Generate(defType string, definition MetaobjectDefinition, count int) (map[string]MetaobjectEntry, error) {
+	if definition.Standard != "" {
+		return nil, fmt.Errorf("the fields of %s come from the standard template %s and aren't in the definitions file", defType, definition.Standard)
+	}
+
+	keys := make([]string, 0, len(definition.FieldDefinitions))
+	for key := range definition.FieldDefinitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if g.Entries[defType] == nil {
+		g.Entries[defType] = make(map[string]MetaobjectEntry)
+	}
+
+	generated := make(map[string]MetaobjectEntry, count)
+
+	for i := 0; i < count; i++ {
+		entry := MetaobjectEntry{Fields: make(map[string]any, len(keys))}
+
+		for _, key := range keys {
+			field := definition.FieldDefinitions[key]
+			if !field.Required && g.rand.Intn(5) == 0 {
+				continue
+			}
+
+			value, err := g.fieldValue(key, field)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", key, err)
+			}
+
+			if value == nil {
+				if field.Required {
+					return nil, fmt.Errorf("field %s: can't generate a value for required %s field", key, field.Type)
+				}
+				continue
+			}
+
+			entry.Fields[key] = value
+		}
+
+		handle := g.handle(defType, entry.Fields[definition.DisplayNameKey])
+		g.Entries[defType][handle] = entry
+		generated[handle] = entry
+	}
+
+	return generated, nil
+}
+
+// handle derives a unique handle from the display name of an entry.
+func (g *EntryGenerator) handle(defType string, displayName any) string {
+	base := ""
+	if s, ok := displayName.(string); ok {
+		base = strings.Trim(handleSeparators.ReplaceAllString(strings.ToLower(s), "-"), "-")
+	}
+	if base == "" {
+		base = strings.ReplaceAll(defType, "_", "-")
+	}
+
+	handle := base
+	for n := 2; ; n++ {
+		if _, ok := g.Entries[defType][handle]; !ok {
+			return handle
+		}
+
+		handle = fmt.Sprintf("%s-%d", base, n)
+	}
+}
+
+func (g *EntryGenerator) fieldValue(key string, field FieldDefinition) (any, error) {
+	fieldType := baseFieldType(field.Type)
+
+	if !isListFieldType(field.Type) {
+		return g.singleValue(key, fieldType, field.Validations, nil)
+	}
+
+	min, max := 1.0, 3.0
+	if v, ok := toFloat(field.Validations["list.min"]); ok {
+		min = v
+	}
+	if v, ok := toFloat(field.Validations["list.max"]); ok && v < max {
+		max = v
+	}
+	if max < min {
+		max = min
+	}
+
+	count := int(min) + g.rand.Intn(int(max-min)+1)
+	items := make([]any, 0, count)
+	used := make(map[string]bool)
+
+	for i := 0; i < count; i++ {
+		item, err := g.singleValue(key, fieldType, field.Validations, used)
+		if err != nil {
+			return nil, err
+		}
+		if item == nil {
+			break
+		}
+
+		items = append(items, item)
+	}
+
+	if len(items) == 0 || float64(len(items)) < min {
+		return nil, nil
+	}
+
+	return items, nil
+}
+
+// singleValue generates a value of a field type. References are never
+// repeated within the used set. A nil value means no value can be generated.
+func (g *EntryGenerator) singleValue(key string, fieldType string, validations map[string]any, used map[string]bool) (any, error) {
+	switch fieldType {
+	case "single_line_text_field":
+		if choices, ok := validations["choices"].([]any); ok && len(choices) > 0 {
+			return fmt.Sprint(choices[g.rand.Intn(len(choices))]), nil
+		}
+		if pattern, ok := validations["regex"].(string); ok {
+			return g.regexValue(pattern, validations)
+		}
+		return g.text(g.title(2+g.rand.Intn(2)), validations), nil
+
+	case "multi_line_text_field":
+		if pattern, ok := validations["regex"].(string); ok {
+			return g.regexValue(pattern, validations)
+		}
+		return g.text(g.sentence()+" "+g.sentence(), validations), nil
+
+	case "rich_text_field":
+		return fmt.Sprintf("## %s\n\n%s **%s** %s", g.title(2), g.sentence(), g.word(), g.sentence()), nil
+
+	case "boolean":
+		return g.rand.Intn(2) == 0, nil
+
+	case "number_integer":
+		min, max := g.numberRange(validations, 0, 100)
+		span := int64(math.Floor(max)-math.Ceil(min)) + 1
+		if span <= 0 {
+			return int64(math.Ceil(min)), nil
+		}
+		return int64(math.Ceil(min)) + g.rand.Int63n(span), nil
+
+	case "number_decimal":
+		min, max := g.numberRange(validations, 0, 100)
+		precision := 2
+		if p, ok := toFloat(validations["max_precision"]); ok {
+			precision = int(p)
+		}
+
+		value := min + g.rand.Float64()*(max-min)
+		return strconv.FormatFloat(value, 'f', precision, 64), nil
+
+	case "date", "date_time":
+		return g.dateValue(fieldType, validations), nil
+
+	case "url":
+		domain := "example.com"
+		if domains, ok := validations["allowed_domains"].([]any); ok && len(domains) > 0 {
+			domain = fmt.Sprint(domains[g.rand.Intn(len(domains))])
+		}
+		return fmt.Sprintf("https://%s/%s-%s", domain, g.word(), g.word()), nil
+
+	case "color":
+		return fmt.Sprintf("#%06x", g.rand.Intn(0x1000000)), nil
+
+	case "rating":
+		min, max := 1.0, 5.0
+		if v, ok := toFloat(validations["scale_min"]); ok {
+			min = v
+		}
+		if v, ok := toFloat(validations["scale_max"]); ok {
+			max = v
+		}
+
+		value := min + math.Round(g.rand.Float64()*(max-min)*2)/2
+		return map[string]any{
+			"value":     strconv.FormatFloat(value, 'f', 1, 64),
+			"scale_min": strconv.FormatFloat(min, 'f', 1, 64),
+			"scale_max": strconv.FormatFloat(max, 'f', 1, 64),
+		}, nil
+
+	case "dimension", "volume", "weight":
+		return g.measurementValue(fieldType, validations), nil
+
+	case "money":
+		return map[string]any{
+			"amount":        fmt.Sprintf("%d.%02d", 5+g.rand.Intn(195), g.rand.Intn(100)),
+			"currency_code": "USD",
+		}, nil
+
+	case "link":
+		return map[string]any{
+			"text": g.title(2),
+			"url":  fmt.Sprintf("https://example.com/%s", g.word()),
+		}, nil
+
+	case "json":
+		if schema, ok := validations["schema"].(map[string]any); ok {
+			return g.schemaValue(schema, 0), nil
+		}
+		return map[string]any{"name": g.title(2), "count": float64(g.rand.Intn(10))}, nil
+
+	case "id":
+		return fmt.Sprintf("%s-%06d", g.word(), g.rand.Intn(1000000)), nil
+
+	case "metaobject_reference", "mixed_reference":
+		return g.referenceValue(key, validations, used), nil
+	}
+
+	if !g.skipped[key] {
+		log.Printf("Not generating field %s: %s values are not supported\n", key, fieldType)
+		g.skipped[key] = true
+	}
+
+	return nil, nil
+}
+
+func (g *EntryGenerator) word() string {
+	return generatorWords[g.rand.Intn(len(generatorWords))]
+}
+
+func (g *EntryGenerator) title(words int) string {
+	parts := make([]string, words)
+	for i := range parts {
+		w := []rune(g.word())
+		w[0] = unicode.ToUpper(w[0])
+		parts[i] = string(w)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (g *EntryGenerator) sentence() string {
+	parts := make([]string, 6+g.rand.Intn(6))
+	for i := range parts {
+		parts[i] = g.word()
+	}
+
+	s := []rune(strings.Join(parts, " "))
+	s[0] = unicode.ToUpper(s[0])
+
+	return string(s) + "."
+}
+
+// text pads or truncates text to the min and max length validations.
+func (g *EntryGenerator) text(s string, validations map[string]any) string {
+	if min, ok := toFloat(validations["min"]); ok {
+		for float64(len([]rune(s))) < min {
+			s += " " + g.word()
+		}
+	}
+
+	if max, ok := toFloat(validations["max"]); ok && float64(len([]rune(s))) > max {
+		s = strings.TrimSpace(string([]rune(s)[:int(max)]))
+	}
+
+	return s
+}
+
+func (g *EntryGenerator) numberRange(validations map[string]any, min float64, max float64) (float64, float64) {
+	if v, ok := toFloat(validations["min"]); ok {
+		min = v
+		if _, ok := validations["max"]; !ok {
+			max = min + 100
+		}
+	}
+	if v, ok := toFloat(validations["max"]); ok {
+		max = v
+		if _, ok := validations["min"]; !ok {
+			min = math.Min(0, max)
+		}
+	}
+
+	return min, max
+}
+
+func (g *EntryGenerator) dateValue(fieldType string, validations map[string]any) string {
+	min := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	if s, ok := validations["min"].(string); ok {
+		if t, err := parseDate(s); err == nil {
+			min = t
+			if _, ok := validations["max"]; !ok {
+				max = min.AddDate(2, 0, 0)
+			}
+		}
+	}
+	if s, ok := validations["max"].(string); ok {
+		if t, err := parseDate(s); err == nil {
+			max = t
+			if _, ok := validations["min"]; !ok {
+				min = max.AddDate(-2, 0, 0)
+			}
+		}
+	}
+
+	t := min
+	if span := max.Sub(min); span > 0 {
+		t = min.Add(time.Duration(g.rand.Int63n(int64(span))))
+	}
+
+	if fieldType == "date" {
+		return t.Format("2006-01-02")
+	}
+
+	return t.Truncate(time.Minute).Format(time.RFC3339)
+}
+
+func (g *EntryGenerator) measurementValue(fieldType string, validations map[string]any) map[string]any {
+	unit := generatorUnits[fieldType]
+	min, max := 1.0, 100.0
+
+	if m, ok := validations["min"].(map[string]any); ok {
+		unit = fmt.Sprint(m["unit"])
+		if v, ok := toFloat(m["value"]); ok {
+			min, max = v, v+100
+		}
+	}
+	if m, ok := validations["max"].(map[string]any); ok {
+		if u := fmt.Sprint(m["unit"]); u == unit || validations["min"] == nil {
+			unit = u
+			if v, ok := toFloat(m["value"]); ok {
+				max = v
+				if validations["min"] == nil {
+					min = math.Min(1, max)
+				}
+			}
+		}
+	}
+
+	value := math.Round((min+g.rand.Float64()*(max-min))*10) / 10
+
+	return map[string]any{"value": value, "unit": unit}
+}
+
+// referenceValue picks an existing entry of one of the types a reference
+// field allows.
+func (g *EntryGenerator) referenceValue(key string, validations map[string]any, used map[string]bool) any {
+	types := referencedTypes(validations)
+
+	candidates := make([]string, 0)
+	for _, defType := range types {
+		for handle := range g.Entries[defType] {
+			if ref := EntryKey(defType, handle); !used[ref] {
+				candidates = append(candidates, ref)
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		if !g.skipped[key] && len(used) == 0 {
+			log.Printf("Not generating field %s: no entries of type %s to reference\n", key, strings.Join(types, ", "))
+			g.skipped[key] = true
+		}
+		return nil
+	}
+
+	sort.Strings(candidates)
+	ref := candidates[g.rand.Intn(len(candidates))]
+	if used != nil {
+		used[ref] = true
+	}
+
+	return ref
+}
+
+// regexValue generates a string matching a regex validation, which also
+// respects the min and max length validations.
+func (g *EntryGenerator) regexValue(pattern string, validations map[string]any) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", fmt.Errorf("invalid regex validation %q: %w", pattern, err)
+	}
+	re = re.Simplify()
+
+	matcher, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid regex validation %q: %w", pattern, err)
+	}
+
+	min, hasMin := toFloat(validations["min"])
+	max, hasMax := toFloat(validations["max"])
+
+	for i := 0; i < 100; i++ {
+		var b strings.Builder
+		g.writeRegexSample(&b, re)
+
+		s := b.String()
+		length := float64(len([]rune(s)))
+
+		if matcher.MatchString(s) && (!hasMin || length >= min) && (!hasMax || length <= max) {
+			return s, nil
+		}
+	}
+
+	return "", fmt.Errorf("can't generate a value matching %q", pattern)
+}
+
+func (g *EntryGenerator) writeRegexSample(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+
+	case syntax.OpCharClass:
+		b.WriteRune(g.classRune(re.Rune))
+
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteRune(rune('a' + g.rand.Intn(26)))
+
+	case syntax.OpCapture:
+		g.writeRegexSample(b, re.Sub[0])
+
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := re.Min, re.Max
+		switch re.Op {
+		case syntax.OpStar:
+			min, max = 0, 3
+		case syntax.OpPlus:
+			min, max = 1, 4
+		case syntax.OpQuest:
+			min, max = 0, 1
+		}
+		if max < 0 {
+			max = min + 3
+		}
+
+		for n := min + g.rand.Intn(max-min+1); n > 0; n-- {
+			g.writeRegexSample(b, re.Sub[0])
+		}
+
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			g.writeRegexSample(b, sub)
+		}
+
+	case syntax.OpAlternate:
+		g.writeRegexSample(b, re.Sub[g.rand.Intn(len(re.Sub))])
+	}
+}
+
+// classRune picks a rune from a character class, preferring printable ASCII
+// so negated classes don't produce control characters.
+func (g *EntryGenerator) classRune(ranges []rune) rune {
+	inClass := func(r rune) bool {
+		for i := 0; i+1 < len(ranges); i += 2 {
+			if r >= ranges[i] && r <= ranges[i+1] {
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := 0; i < 200; i++ {
+		if r := rune(0x20 + g.rand.Intn(0x5f)); inClass(r) {
+			return r
+		}
+	}
+
+	if len(ranges) == 0 {
+		return 'a'
+	}
+
+	i := g.rand.Intn(len(ranges)/2) * 2
+	return ranges[i] + rune(g.rand.Intn(int(ranges[i+1]-ranges[i])+1))
+}
+
+// schemaValue generates a value matching the JSON schema of a json field
+// validation.
+func (g *EntryGenerator) schemaValue(schema map[string]any, depth int) any {
+	if enum, ok := schema["enum"].([]any); ok && len(enum) > 0 {
+		return enum[g.rand.Intn(len(enum))]
+	}
+	if c, ok := schema["const"]; ok {
+		return c
+	}
+
+	schemaType := schema["type"]
+	if types, ok := schemaType.([]any); ok && len(types) > 0 {
+		schemaType = types[0]
+	}
+
+	switch schemaType {
+	case "object":
+		value := make(map[string]any)
+		properties, _ := schema["properties"].(map[string]any)
+
+		keys := make([]string, 0, len(properties))
+		for key := range properties {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if s, ok := properties[key].(map[string]any); ok && depth < 5 {
+				value[key] = g.schemaValue(s, depth+1)
+			}
+		}
+
+		return value
+
+	case "array":
+		min, max := 1.0, 3.0
+		if v, ok := toFloat(schema["minItems"]); ok {
+			min = math.Max(v, 0)
+			max = math.Max(max, min)
+		}
+		if v, ok := toFloat(schema["maxItems"]); ok {
+			max = math.Min(max, v)
+			min = math.Min(min, max)
+		}
+
+		items, _ := schema["items"].(map[string]any)
+		value := make([]any, 0, int(max))
+
+		for n := int(min) + g.rand.Intn(int(max-min)+1); n > 0; n-- {
+			if items == nil || depth >= 5 {
+				value = append(value, g.word())
+				continue
+			}
+			value = append(value, g.schemaValue(items, depth+1))
+		}
+
+		return value
+
+	case "integer", "number":
+		min, max := 0.0, 100.0
+		if v, ok := toFloat(schema["minimum"]); ok {
+			min, max = v, math.Max(max, v+100)
+		}
+		if v, ok := toFloat(schema["maximum"]); ok {
+			max = v
+		}
+		if v, ok := toFloat(schema["exclusiveMinimum"]); ok {
+			min = v + 1
+		}
+		if v, ok := toFloat(schema["exclusiveMaximum"]); ok {
+			max = v - 1
+		}
+
+		value := min + g.rand.Float64()*(max-min)
+		if schemaType == "integer" {
+			return math.Round(value)
+		}
+		return math.Round(value*100) / 100
+
+	case "boolean":
+		return g.rand.Intn(2) == 0
+
+	case "null":
+		return nil
+	}
+
+	value := g.word()
+	if pattern, ok := schema["pattern"].(string); ok {
+		if s, err := g.regexValue(pattern, map[string]any{"min": schema["minLength"], "max": schema["maxLength"]}); err == nil {
+			return s
+		}
+	}
+
+	return g.text(value, map[string]any{"min": schema["minLength"], "max": schema["maxLength"]})
+}
@@ -0,0 +1,41 @@
+package core
+
+import "testing"
+
+func TestGenerateReferences(t *testing.T) {
+	entries := map[string]map[string]MetaobjectEntry{
+		"author": {
+			"ada":     {Fields: map[string]any{"name": "Ada"}},
+			"charles": {Fields: map[string]any{"name": "Charles"}},
+		},
+	}
+
+	definition := MetaobjectDefinition{
+		FieldDefinitions: map[string]FieldDefinition{
+			"author": {Type: "metaobject_reference", Required: true, Validations: map[string]any{"metaobject_definition": "author"}},
+			// Definitions pulled from a store hold []string rather than the
+			// []any read from definition files.
+			"editors": {Type: "list.metaobject_reference", Required: true, Validations: map[string]any{"metaobject_definitions": []string{"author"}}},
+			"related": {Type: "list.mixed_reference", Required: true, Validations: map[string]any{"metaobject_definitions": []any{"author"}}},
+		},
+	}
+
+	g := NewEntryGenerator(1, entries)
+
+	generated, err := g.Generate("book", definition, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(generated) != 3 {
+		t.Fatalf("generated %d entries, want 3", len(generated))
+	}
+
+	definitions := map[string]MetaobjectDefinition{
+		"author": {FieldDefinitions: map[string]FieldDefinition{"name": {Type: "single_line_text_field"}}},
+		"book":   definition,
+	}
+	if violations := ValidateEntries(definitions, g.Entries, nil); len(violations) != 0 {
+		t.Errorf("generated entries have violations: %v", violations)
+	}
+}
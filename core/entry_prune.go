@@ -0,0 +1,145 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+// Deleting more entries than this uses metaobjectBulkDelete instead of one
+// metaobjectDelete call per entry.
+const bulkDeleteThreshold = 10
+
+// EntryPrune is a store entry with no local entry file.
+type EntryPrune struct {
+	Type         string
+	Handle       string
+	Id           string
+	ReferencedBy []string
+}
+
+func describeReferencer(referencer shopify.Cli_Referencer) string {
+	switch r := referencer.(type) {
+	case *shopify.Cli_ReferencerMetaobject:
+		return "metaobject " + EntryKey(r.Type, r.Handle)
+	case *shopify.Cli_ReferencerProduct:
+		return "product " + r.Handle
+	case *shopify.Cli_ReferencerCollection:
+		return "collection " + r.Handle
+	case *shopify.Cli_ReferencerProductVariant:
+		return "variant " + r.Sku
+	case nil:
+		return "unknown"
+	}
+
+	return referencer.GetTypename()
+}
+
+// PlanPrune returns the store entries of the local entry types whose handles
+// are absent locally, along with the metaobjects and metafields which still
+// reference them. Types without a directory in the entries directory are
+// refused, so a mistyped type or directory doesn't delete every entry.
+func (es *MetaobjectEntryService) PlanPrune(entries map[string]map[string]MetaobjectEntry) ([]EntryPrune, error) {
+	types := make([]string, 0, len(entries))
+	for defType := range entries {
+		types = append(types, defType)
+	}
+	sort.Strings(types)
+
+	for _, defType := range types {
+		typeDir := filepath.Join(es.Dir, defType)
+		if info, err := os.Stat(typeDir); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("refusing to prune entries of type %s, directory %s not found", defType, typeDir)
+		}
+	}
+
+	prunes := make([]EntryPrune, 0)
+
+	for _, defType := range types {
+		metaobjects, err := es.ListEntries(defType)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range metaobjects {
+			if _, ok := entries[defType][m.Handle]; ok {
+				continue
+			}
+
+			res, err := shopify.GetMetaobjectReferencedBy(context.Background(), *es.ShopifyClient, m.Id)
+			if err != nil {
+				return nil, fmt.Errorf("fetching references to entry %s: %w", EntryKey(defType, m.Handle), err)
+			}
+
+			p := EntryPrune{Type: defType, Handle: m.Handle, Id: m.Id}
+			for _, r := range res.Metaobject.ReferencedBy.Nodes {
+				p.ReferencedBy = append(p.ReferencedBy, fmt.Sprintf("%s (%s.%s)", describeReferencer(r.Referencer), r.Namespace, r.Key))
+			}
+
+			prunes = append(prunes, p)
+		}
+	}
+
+	return prunes, nil
+}
+
+func (es *MetaobjectEntryService) Prune(prunes []EntryPrune) error {
+	if len(prunes) == 0 {
+		return nil
+	}
+
+	if len(prunes) <= bulkDeleteThreshold {
+		for _, p := range prunes {
+			key := EntryKey(p.Type, p.Handle)
+
+			res, err := shopify.DeleteMetaobject(context.Background(), *es.ShopifyClient, p.Id)
+			if err != nil {
+				return fmt.Errorf("deleting entry %s: %w", key, err)
+			}
+
+			if len(res.MetaobjectDelete.UserErrors) > 0 {
+				return fmt.Errorf("deleting entry %s: %v", key, res.MetaobjectDelete.UserErrors)
+			}
+
+			log.Printf("Deleted entry: %s\n", key)
+		}
+
+		return nil
+	}
+
+	ids := make([]string, len(prunes))
+	for i, p := range prunes {
+		ids[i] = p.Id
+	}
+
+	res, err := shopify.BulkDeleteMetaobjects(context.Background(), *es.ShopifyClient, ids)
+	if err != nil {
+		return fmt.Errorf("bulk deleting entries: %w", err)
+	}
+
+	if len(res.MetaobjectBulkDelete.UserErrors) > 0 {
+		return fmt.Errorf("bulk deleting entries: %v", res.MetaobjectBulkDelete.UserErrors)
+	}
+
+	job := res.MetaobjectBulkDelete.Job
+	for !job.Done {
+		time.Sleep(BulkPollInterval)
+
+		res, err := shopify.GetJob(context.Background(), *es.ShopifyClient, job.Id)
+		if err != nil {
+			return fmt.Errorf("polling bulk delete job %s: %w", job.Id, err)
+		}
+
+		job.Done = res.Job.Done
+	}
+
+	log.Printf("Deleted %d entries\n", len(prunes))
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPlanPruneRefusesTypesWithoutDirectory(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "author"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	es := &MetaobjectEntryService{Dir: dir}
+
+	entries, err := ReadEntryDirectory(dir, []string{"author", "autor"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = es.PlanPrune(entries)
+	if err == nil || !strings.Contains(err.Error(), "type autor") {
+		t.Errorf("PlanPrune error = %v, want a refusal to prune autor", err)
+	}
+}
@@ -0,0 +1,174 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/hjson/hjson-go/v4"
+)
+
+// UrlCollision is a storefront URL path claimed by more than one metaobject
+// type, or by entries of more than one type.
+type UrlCollision struct {
+	Path string
+	Keys []string
+}
+
+// detectRenames matches local entries whose handle isn't in the store with
+// store entries whose handle isn't local, and returns the previous handle of
+// each renamed entry. An entry is only renamed when its file names the
+// previous handle in renamedFrom. Other new entries whose fields are identical
+// to a store entry's are returned as likely renames, to be suggested.
+func detectRenames(entries map[string]MetaobjectEntry, normalized map[string]MetaobjectEntry, remoteEntries map[string]MetaobjectEntry) (renames map[string]string, likely map[string]string) {
+	renames = make(map[string]string)
+	likely = make(map[string]string)
+
+	added := make([]string, 0)
+	for handle := range entries {
+		if _, ok := remoteEntries[handle]; !ok {
+			added = append(added, handle)
+		}
+	}
+	sort.Strings(added)
+
+	removed := make(map[string]bool)
+	for handle := range remoteEntries {
+		if _, ok := entries[handle]; !ok {
+			removed[handle] = true
+		}
+	}
+
+	for _, handle := range added {
+		if from := entries[handle].RenamedFrom; removed[from] {
+			renames[handle] = from
+			delete(removed, from)
+		}
+	}
+
+	candidates := make([]string, 0, len(removed))
+	for handle := range removed {
+		candidates = append(candidates, handle)
+	}
+	sort.Strings(candidates)
+
+	for _, handle := range added {
+		if _, ok := renames[handle]; ok {
+			continue
+		}
+
+		local, err := hjson.Marshal(normalized[handle].Fields)
+		if err != nil {
+			continue
+		}
+
+		for _, from := range candidates {
+			if !removed[from] {
+				continue
+			}
+
+			remote, err := hjson.Marshal(remoteEntries[from].Fields)
+			if err == nil && string(local) == string(remote) {
+				likely[handle] = from
+				delete(removed, from)
+				break
+			}
+		}
+	}
+
+	return renames, likely
+}
+
+// rename changes the handle of a store entry along with its fields.
+func (es *MetaobjectEntryService) rename(u EntryUpsert) error {
+	from := EntryKey(u.Type, u.Rename.From)
+
+	res, err := shopify.UpdateMetaobject(context.Background(), *es.ShopifyClient, u.Rename.Id, shopify.MetaobjectUpdateInput{
+		Handle:            u.Handle,
+		Fields:            u.Input.Fields,
+		Capabilities:      u.Input.Capabilities,
+		RedirectNewHandle: u.Rename.Redirect,
+	})
+	if err != nil {
+		return fmt.Errorf("renaming entry %s: %w", from, err)
+	}
+
+	if len(res.MetaobjectUpdate.UserErrors) > 0 {
+		return fmt.Errorf("renaming entry %s: %v", from, res.MetaobjectUpdate.UserErrors)
+	}
+
+	r := es.resolver()
+	if r.ids == nil {
+		r.ids = make(map[string]string)
+	}
+	r.ids[r.cacheKey("metaobject_reference", EntryKey(u.Type, u.Handle))] = u.Rename.Id
+
+	if u.Rename.Redirect {
+		log.Printf("Renamed entry %s to %s, redirecting the previous URL\n", from, u.Handle)
+	} else {
+		log.Printf("Renamed entry %s to %s\n", from, u.Handle)
+	}
+
+	return nil
+}
+
+// entryUrlPrefix returns the storefront path under which the entries of a
+// type with the online store capability are published.
+func entryUrlPrefix(defType string, definition MetaobjectDefinition) (string, bool) {
+	if definition.Capabilities == nil || definition.Capabilities.OnlineStore == nil {
+		return "", false
+	}
+
+	urlHandle := definition.Capabilities.OnlineStore.UrlHandle
+	if urlHandle == "" {
+		urlHandle = strings.ReplaceAll(defType, "_", "-")
+	}
+
+	return "/pages/" + urlHandle, true
+}
+
+// FindUrlCollisions reports the storefront paths of online store types which
+// are shared by more than one type, and the entry URLs they collide on.
+func FindUrlCollisions(definitions map[string]MetaobjectDefinition, entries map[string]map[string]MetaobjectEntry) []UrlCollision {
+	prefixes := make(map[string][]string)
+	for defType, d := range definitions {
+		if prefix, ok := entryUrlPrefix(defType, d); ok {
+			prefixes[prefix] = append(prefixes[prefix], defType)
+		}
+	}
+
+	collisions := make([]UrlCollision, 0)
+
+	for prefix, types := range prefixes {
+		if len(types) < 2 {
+			continue
+		}
+
+		sort.Strings(types)
+		collisions = append(collisions, UrlCollision{Path: prefix, Keys: types})
+
+		paths := make(map[string][]string)
+		for _, defType := range types {
+			for handle := range entries[defType] {
+				path := prefix + "/" + handle
+				paths[path] = append(paths[path], EntryKey(defType, handle))
+			}
+		}
+
+		for path, keys := range paths {
+			if len(keys) > 1 {
+				sort.Strings(keys)
+				collisions = append(collisions, UrlCollision{Path: path, Keys: keys})
+			}
+		}
+	}
+
+	sort.Slice(collisions, func(i, j int) bool {
+		return collisions[i].Path < collisions[j].Path
+	})
+
+	return collisions
+}
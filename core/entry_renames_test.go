@@ -0,0 +1,31 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDetectRenames(t *testing.T) {
+	jane := map[string]any{"name": "Jane Doe"}
+	john := map[string]any{"name": "John Doe"}
+
+	entries := map[string]MetaobjectEntry{
+		"jane":     {Fields: jane},
+		"john-doe": {RenamedFrom: "john", Fields: map[string]any{"name": "John R. Doe"}},
+		"kept":     {Fields: jane},
+	}
+	remoteEntries := map[string]MetaobjectEntry{
+		"jane-doe": {Fields: jane},
+		"john":     {Fields: john},
+		"kept":     {Fields: jane},
+	}
+
+	renames, likely := detectRenames(entries, entries, remoteEntries)
+
+	if want := map[string]string{"john-doe": "john"}; !reflect.DeepEqual(renames, want) {
+		t.Errorf("renames = %v, want %v", renames, want)
+	}
+	if want := map[string]string{"jane": "jane-doe"}; !reflect.DeepEqual(likely, want) {
+		t.Errorf("likely renames = %v, want %v", likely, want)
+	}
+}
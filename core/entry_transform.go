@@ -0,0 +1,983 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+// A transform script reshapes the fields of every entry of a type. Each line
+// assigns an expression to a field, or deletes a field:
+//
+//	# split the name into first and last name
+//	first_name = split(name, " ")[0]
+//	last_name = join(slice(split(name, " "), 1), " ")
+//	height = {value: round(height.value * 2.54, 1), unit: "cm"}
+//	delete name
+//
+// Expressions see the fields as they were before the script ran, so the
+// order of the lines doesn't matter. Fields are referenced by key, and the
+// entry's handle, type and status by $handle, $type and $status. Assigning
+// null removes a field, and assigning to $status changes the entry status.
+//
+// Expressions support numbers, strings, true, false, null, lists [a, b] and
+// objects {key: value}, field access with . and [], the operators
+// + - * / % == != < <= > >= && || ! and cond ? a : b, and these functions:
+// len, upper, lower, trim, split, join, replace, slice, contains, matches,
+// number, string, round, floor, ceil, default and field.
+type TransformScript struct {
+	statements []transformStatement
+}
+
+type transformStatement struct {
+	line   int
+	target string
+	expr   transformExpr
+}
+
+type transformExpr func(env *transformEnv) (any, error)
+
+type transformEnv struct {
+	defType string
+	handle  string
+	entry   MetaobjectEntry
+}
+
+func ParseTransformScript(src string) (*TransformScript, error) {
+	script := &TransformScript{}
+
+	for i, line := range strings.Split(src, "\n") {
+		tokens, err := lexTransform(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		if len(tokens) == 1 {
+			continue
+		}
+
+		p := &transformParser{tokens: tokens}
+		s, err := p.statement()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		s.line = i + 1
+		script.statements = append(script.statements, s)
+	}
+
+	return script, nil
+}
+
+// Apply runs the script on an entry and returns the transformed entry.
+func (s *TransformScript) Apply(defType string, handle string, entry MetaobjectEntry) (MetaobjectEntry, error) {
+	env := &transformEnv{defType: defType, handle: handle, entry: entry}
+
+	e := MetaobjectEntry{
+		Status: entry.Status,
+		Fields: make(map[string]any, len(entry.Fields)),
+	}
+	for key, value := range entry.Fields {
+		e.Fields[key] = value
+	}
+
+	for _, st := range s.statements {
+		var value any
+		if st.expr != nil {
+			v, err := st.expr(env)
+			if err != nil {
+				return MetaobjectEntry{}, fmt.Errorf("line %d: %w", st.line, err)
+			}
+			value = v
+		}
+
+		if st.target == "$status" {
+			status, _ := value.(string)
+			e.Status = shopify.MetaobjectStatus(status)
+			continue
+		}
+
+		if value == nil {
+			delete(e.Fields, st.target)
+			continue
+		}
+
+		e.Fields[st.target] = value
+	}
+
+	return e, nil
+}
+
+type transformTokenKind int
+
+const (
+	transformTokenEOF transformTokenKind = iota
+	transformTokenNumber
+	transformTokenString
+	transformTokenIdent
+	transformTokenVar
+	transformTokenOp
+)
+
+type transformToken struct {
+	kind  transformTokenKind
+	text  string
+	value any
+}
+
+var transformOps = []string{"==", "!=", "<=", ">=", "&&", "||", "+", "-", "*", "/", "%", "<", ">", "!", "?", ":", "(", ")", "[", "]", "{", "}", ",", ".", "="}
+
+func lexTransform(line string) ([]transformToken, error) {
+	tokens := make([]transformToken, 0)
+	isIdent := func(r byte) bool {
+		return r == '_' || unicode.IsLetter(rune(r)) || unicode.IsDigit(rune(r))
+	}
+
+	for i := 0; i < len(line); {
+		c := line[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+
+		case c == '#':
+			i = len(line)
+
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(line) && (line[j] >= '0' && line[j] <= '9' || line[j] == '.') {
+				j++
+			}
+
+			n, err := strconv.ParseFloat(line[i:j], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %s", line[i:j])
+			}
+
+			tokens = append(tokens, transformToken{kind: transformTokenNumber, text: line[i:j], value: n})
+			i = j
+
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(line) && line[j] != c {
+				if line[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(line) {
+				return nil, errors.New("unterminated string")
+			}
+
+			raw := line[i+1 : j]
+			if c == '\'' {
+				raw = strings.ReplaceAll(strings.ReplaceAll(raw, `\'`, `'`), `"`, `\"`)
+			}
+
+			s, err := strconv.Unquote(`"` + raw + `"`)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %s", line[i:j+1])
+			}
+
+			tokens = append(tokens, transformToken{kind: transformTokenString, text: line[i : j+1], value: s})
+			i = j + 1
+
+		case c == '$' || isIdent(c):
+			j := i + 1
+			for j < len(line) && isIdent(line[j]) {
+				j++
+			}
+
+			kind := transformTokenIdent
+			if c == '$' {
+				kind = transformTokenVar
+			}
+
+			tokens = append(tokens, transformToken{kind: kind, text: line[i:j]})
+			i = j
+
+		default:
+			op := ""
+			for _, o := range transformOps {
+				if strings.HasPrefix(line[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q", c)
+			}
+
+			tokens = append(tokens, transformToken{kind: transformTokenOp, text: op})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, transformToken{kind: transformTokenEOF}), nil
+}
+
+type transformParser struct {
+	tokens []transformToken
+	pos    int
+}
+
+func (p *transformParser) peek() transformToken {
+	return p.tokens[p.pos]
+}
+
+func (p *transformParser) next() transformToken {
+	t := p.tokens[p.pos]
+	if t.kind != transformTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *transformParser) accept(op string) bool {
+	if t := p.peek(); t.kind == transformTokenOp && t.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *transformParser) expect(op string) error {
+	if !p.accept(op) {
+		return fmt.Errorf("expected %s, found %s", op, describeTransformToken(p.peek()))
+	}
+	return nil
+}
+
+func describeTransformToken(t transformToken) string {
+	if t.kind == transformTokenEOF {
+		return "end of line"
+	}
+	return strconv.Quote(t.text)
+}
+
+func (p *transformParser) statement() (transformStatement, error) {
+	t := p.next()
+
+	if t.kind == transformTokenIdent && t.text == "delete" && p.peek().kind == transformTokenIdent {
+		target := p.next().text
+		if p.peek().kind != transformTokenEOF {
+			return transformStatement{}, fmt.Errorf("unexpected %s", describeTransformToken(p.peek()))
+		}
+		return transformStatement{target: target}, nil
+	}
+
+	if t.kind != transformTokenIdent && !(t.kind == transformTokenVar && t.text == "$status") {
+		return transformStatement{}, fmt.Errorf("expected a field to assign, found %s", describeTransformToken(t))
+	}
+
+	if err := p.expect("="); err != nil {
+		return transformStatement{}, err
+	}
+
+	expr, err := p.expression()
+	if err != nil {
+		return transformStatement{}, err
+	}
+
+	if p.peek().kind != transformTokenEOF {
+		return transformStatement{}, fmt.Errorf("unexpected %s", describeTransformToken(p.peek()))
+	}
+
+	return transformStatement{target: t.text, expr: expr}, nil
+}
+
+func (p *transformParser) expression() (transformExpr, error) {
+	cond, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.accept("?") {
+		return cond, nil
+	}
+
+	a, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	b, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(env *transformEnv) (any, error) {
+		c, err := cond(env)
+		if err != nil {
+			return nil, err
+		}
+		if truthy(c) {
+			return a(env)
+		}
+		return b(env)
+	}, nil
+}
+
+// Binary operators by precedence, lowest first.
+var transformPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *transformParser) binary(level int) (transformExpr, error) {
+	if level == len(transformPrecedence) {
+		return p.unary()
+	}
+
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		if t.kind != transformTokenOp || !contains(transformPrecedence[level], t.text) {
+			return left, nil
+		}
+		p.next()
+
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		left = binaryTransformExpr(t.text, left, right)
+	}
+}
+
+func binaryTransformExpr(op string, left transformExpr, right transformExpr) transformExpr {
+	return func(env *transformEnv) (any, error) {
+		a, err := left(env)
+		if err != nil {
+			return nil, err
+		}
+
+		// && and || only evaluate the right side when needed.
+		switch op {
+		case "&&":
+			if !truthy(a) {
+				return false, nil
+			}
+		case "||":
+			if truthy(a) {
+				return true, nil
+			}
+		}
+
+		b, err := right(env)
+		if err != nil {
+			return nil, err
+		}
+
+		switch op {
+		case "&&", "||":
+			return truthy(b), nil
+		case "==":
+			return reflect.DeepEqual(normalizeNumber(a), normalizeNumber(b)), nil
+		case "!=":
+			return !reflect.DeepEqual(normalizeNumber(a), normalizeNumber(b)), nil
+		}
+
+		if op == "+" {
+			if la, ok := a.([]any); ok {
+				if lb, ok := b.([]any); ok {
+					return append(append([]any{}, la...), lb...), nil
+				}
+			}
+
+			_, aString := a.(string)
+			_, bString := b.(string)
+			if aString || bString {
+				return transformString(a) + transformString(b), nil
+			}
+		}
+
+		if sa, ok := a.(string); ok {
+			if sb, ok := b.(string); ok {
+				switch op {
+				case "<":
+					return sa < sb, nil
+				case "<=":
+					return sa <= sb, nil
+				case ">":
+					return sa > sb, nil
+				case ">=":
+					return sa >= sb, nil
+				}
+			}
+		}
+
+		x, xok := transformNumber(a)
+		y, yok := transformNumber(b)
+		if !xok || !yok {
+			return nil, fmt.Errorf("can't apply %s to %v and %v", op, a, b)
+		}
+
+		switch op {
+		case "+":
+			return x + y, nil
+		case "-":
+			return x - y, nil
+		case "*":
+			return x * y, nil
+		case "/":
+			if y == 0 {
+				return nil, errors.New("division by zero")
+			}
+			return x / y, nil
+		case "%":
+			if y == 0 {
+				return nil, errors.New("division by zero")
+			}
+			return math.Mod(x, y), nil
+		case "<":
+			return x < y, nil
+		case "<=":
+			return x <= y, nil
+		case ">":
+			return x > y, nil
+		case ">=":
+			return x >= y, nil
+		}
+
+		return nil, fmt.Errorf("unknown operator %s", op)
+	}
+}
+
+func (p *transformParser) unary() (transformExpr, error) {
+	if p.accept("!") {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(env *transformEnv) (any, error) {
+			v, err := operand(env)
+			if err != nil {
+				return nil, err
+			}
+			return !truthy(v), nil
+		}, nil
+	}
+
+	if p.accept("-") {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(env *transformEnv) (any, error) {
+			v, err := operand(env)
+			if err != nil {
+				return nil, err
+			}
+
+			n, ok := transformNumber(v)
+			if !ok {
+				return nil, fmt.Errorf("can't negate %v", v)
+			}
+			return -n, nil
+		}, nil
+	}
+
+	return p.postfix()
+}
+
+func (p *transformParser) postfix() (transformExpr, error) {
+	expr, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch {
+		case p.accept("."):
+			t := p.next()
+			if t.kind != transformTokenIdent {
+				return nil, fmt.Errorf("expected a key after ., found %s", describeTransformToken(t))
+			}
+			expr = indexTransformExpr(expr, func(*transformEnv) (any, error) { return t.text, nil })
+
+		case p.accept("["):
+			index, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			expr = indexTransformExpr(expr, index)
+
+		default:
+			return expr, nil
+		}
+	}
+}
+
+func indexTransformExpr(expr transformExpr, index transformExpr) transformExpr {
+	return func(env *transformEnv) (any, error) {
+		v, err := expr(env)
+		if err != nil {
+			return nil, err
+		}
+
+		i, err := index(env)
+		if err != nil {
+			return nil, err
+		}
+
+		switch c := v.(type) {
+		case nil:
+			return nil, nil
+		case map[string]any:
+			return c[transformString(i)], nil
+		case []any:
+			n, ok := transformNumber(i)
+			if !ok {
+				return nil, fmt.Errorf("list index %v is not a number", i)
+			}
+			if n < 0 {
+				n += float64(len(c))
+			}
+			if n < 0 || int(n) >= len(c) {
+				return nil, nil
+			}
+			return c[int(n)], nil
+		}
+
+		return nil, fmt.Errorf("can't index %v", v)
+	}
+}
+
+func (p *transformParser) primary() (transformExpr, error) {
+	t := p.next()
+
+	switch t.kind {
+	case transformTokenNumber, transformTokenString:
+		return func(*transformEnv) (any, error) { return t.value, nil }, nil
+
+	case transformTokenVar:
+		switch t.text {
+		case "$handle":
+			return func(env *transformEnv) (any, error) { return env.handle, nil }, nil
+		case "$type":
+			return func(env *transformEnv) (any, error) { return env.defType, nil }, nil
+		case "$status":
+			return func(env *transformEnv) (any, error) { return string(env.entry.Status), nil }, nil
+		}
+		return nil, fmt.Errorf("unknown variable %s", t.text)
+
+	case transformTokenIdent:
+		switch t.text {
+		case "true", "false":
+			value := t.text == "true"
+			return func(*transformEnv) (any, error) { return value, nil }, nil
+		case "null":
+			return func(*transformEnv) (any, error) { return nil, nil }, nil
+		}
+
+		if p.accept("(") {
+			return p.call(t.text)
+		}
+
+		return func(env *transformEnv) (any, error) { return env.entry.Fields[t.text], nil }, nil
+
+	case transformTokenOp:
+		switch t.text {
+		case "(":
+			expr, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			return expr, p.expect(")")
+
+		case "[":
+			items, err := p.list("]")
+			if err != nil {
+				return nil, err
+			}
+
+			return func(env *transformEnv) (any, error) {
+				return evalTransformExprs(env, items)
+			}, nil
+
+		case "{":
+			return p.object()
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected %s", describeTransformToken(t))
+}
+
+func (p *transformParser) list(end string) ([]transformExpr, error) {
+	items := make([]transformExpr, 0)
+	if p.accept(end) {
+		return items, nil
+	}
+
+	for {
+		item, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+
+		if p.accept(end) {
+			return items, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *transformParser) object() (transformExpr, error) {
+	keys := make([]string, 0)
+	values := make([]transformExpr, 0)
+
+	for !p.accept("}") {
+		if len(keys) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+
+		t := p.next()
+		key := t.text
+		if t.kind == transformTokenString {
+			key = t.value.(string)
+		} else if t.kind != transformTokenIdent {
+			return nil, fmt.Errorf("expected an object key, found %s", describeTransformToken(t))
+		}
+
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+
+		value, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	return func(env *transformEnv) (any, error) {
+		obj := make(map[string]any, len(keys))
+		for i, key := range keys {
+			v, err := values[i](env)
+			if err != nil {
+				return nil, err
+			}
+			obj[key] = v
+		}
+		return obj, nil
+	}, nil
+}
+
+func evalTransformExprs(env *transformEnv, exprs []transformExpr) ([]any, error) {
+	values := make([]any, len(exprs))
+	for i, expr := range exprs {
+		v, err := expr(env)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+type transformFunc struct {
+	minArgs int
+	maxArgs int
+	fn      func(env *transformEnv, args []any) (any, error)
+}
+
+var transformFuncs map[string]transformFunc
+
+func init() {
+	transformFuncs = map[string]transformFunc{
+		"len": {1, 1, func(_ *transformEnv, args []any) (any, error) {
+			switch v := args[0].(type) {
+			case nil:
+				return 0.0, nil
+			case string:
+				return float64(len([]rune(v))), nil
+			case []any:
+				return float64(len(v)), nil
+			case map[string]any:
+				return float64(len(v)), nil
+			}
+			return nil, fmt.Errorf("len of %v", args[0])
+		}},
+		"upper": {1, 1, func(_ *transformEnv, args []any) (any, error) {
+			return strings.ToUpper(transformString(args[0])), nil
+		}},
+		"lower": {1, 1, func(_ *transformEnv, args []any) (any, error) {
+			return strings.ToLower(transformString(args[0])), nil
+		}},
+		"trim": {1, 1, func(_ *transformEnv, args []any) (any, error) {
+			return strings.TrimSpace(transformString(args[0])), nil
+		}},
+		"split": {2, 2, func(_ *transformEnv, args []any) (any, error) {
+			if args[0] == nil {
+				return []any{}, nil
+			}
+
+			parts := strings.Split(transformString(args[0]), transformString(args[1]))
+			list := make([]any, len(parts))
+			for i, part := range parts {
+				list[i] = part
+			}
+			return list, nil
+		}},
+		"join": {2, 2, func(_ *transformEnv, args []any) (any, error) {
+			list, ok := args[0].([]any)
+			if !ok && args[0] != nil {
+				return nil, fmt.Errorf("join of %v, which is not a list", args[0])
+			}
+
+			parts := make([]string, len(list))
+			for i, item := range list {
+				parts[i] = transformString(item)
+			}
+			return strings.Join(parts, transformString(args[1])), nil
+		}},
+		"replace": {3, 3, func(_ *transformEnv, args []any) (any, error) {
+			return strings.ReplaceAll(transformString(args[0]), transformString(args[1]), transformString(args[2])), nil
+		}},
+		"slice": {2, 3, func(_ *transformEnv, args []any) (any, error) {
+			length := 0
+			switch v := args[0].(type) {
+			case string:
+				length = len([]rune(v))
+			case []any:
+				length = len(v)
+			case nil:
+				return nil, nil
+			default:
+				return nil, fmt.Errorf("slice of %v", args[0])
+			}
+
+			bound := func(arg any, def int) (int, error) {
+				n, ok := transformNumber(arg)
+				if !ok {
+					return def, fmt.Errorf("slice index %v is not a number", arg)
+				}
+				i := int(n)
+				if i < 0 {
+					i += length
+				}
+				return max(0, min(i, length)), nil
+			}
+
+			start, err := bound(args[1], 0)
+			if err != nil {
+				return nil, err
+			}
+			end := length
+			if len(args) == 3 {
+				if end, err = bound(args[2], length); err != nil {
+					return nil, err
+				}
+			}
+			end = max(start, end)
+
+			if s, ok := args[0].(string); ok {
+				return string([]rune(s)[start:end]), nil
+			}
+			return append([]any{}, args[0].([]any)[start:end]...), nil
+		}},
+		"contains": {2, 2, func(_ *transformEnv, args []any) (any, error) {
+			if list, ok := args[0].([]any); ok {
+				for _, item := range list {
+					if reflect.DeepEqual(normalizeNumber(item), normalizeNumber(args[1])) {
+						return true, nil
+					}
+				}
+				return false, nil
+			}
+			return strings.Contains(transformString(args[0]), transformString(args[1])), nil
+		}},
+		"matches": {2, 2, func(_ *transformEnv, args []any) (any, error) {
+			re, err := regexp.Compile(transformString(args[1]))
+			if err != nil {
+				return nil, err
+			}
+			return re.MatchString(transformString(args[0])), nil
+		}},
+		"number": {1, 1, func(_ *transformEnv, args []any) (any, error) {
+			if args[0] == nil {
+				return nil, nil
+			}
+			n, ok := transformNumber(args[0])
+			if !ok {
+				return nil, fmt.Errorf("%v is not a number", args[0])
+			}
+			return n, nil
+		}},
+		"string": {1, 1, func(_ *transformEnv, args []any) (any, error) {
+			if args[0] == nil {
+				return nil, nil
+			}
+			return transformString(args[0]), nil
+		}},
+		"round": {1, 2, func(_ *transformEnv, args []any) (any, error) {
+			n, ok := transformNumber(args[0])
+			if !ok {
+				return nil, fmt.Errorf("round of %v", args[0])
+			}
+			places := 0.0
+			if len(args) == 2 {
+				places, _ = transformNumber(args[1])
+			}
+			scale := math.Pow(10, places)
+			return math.Round(n*scale) / scale, nil
+		}},
+		"floor": {1, 1, func(_ *transformEnv, args []any) (any, error) {
+			n, ok := transformNumber(args[0])
+			if !ok {
+				return nil, fmt.Errorf("floor of %v", args[0])
+			}
+			return math.Floor(n), nil
+		}},
+		"ceil": {1, 1, func(_ *transformEnv, args []any) (any, error) {
+			n, ok := transformNumber(args[0])
+			if !ok {
+				return nil, fmt.Errorf("ceil of %v", args[0])
+			}
+			return math.Ceil(n), nil
+		}},
+		"default": {2, 2, func(_ *transformEnv, args []any) (any, error) {
+			if args[0] == nil || args[0] == "" {
+				return args[1], nil
+			}
+			return args[0], nil
+		}},
+		"field": {1, 1, func(env *transformEnv, args []any) (any, error) {
+			return env.entry.Fields[transformString(args[0])], nil
+		}},
+	}
+}
+
+func (p *transformParser) call(name string) (transformExpr, error) {
+	f, ok := transformFuncs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %s", name)
+	}
+
+	args, err := p.list(")")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) < f.minArgs || len(args) > f.maxArgs {
+		if f.minArgs == 1 && f.maxArgs == 1 {
+			return nil, fmt.Errorf("%s takes 1 argument, got %d", name, len(args))
+		}
+		if f.minArgs == f.maxArgs {
+			return nil, fmt.Errorf("%s takes %d arguments, got %d", name, f.minArgs, len(args))
+		}
+		return nil, fmt.Errorf("%s takes %d to %d arguments, got %d", name, f.minArgs, f.maxArgs, len(args))
+	}
+
+	return func(env *transformEnv) (any, error) {
+		values, err := evalTransformExprs(env, args)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := f.fn(env, values)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return v, nil
+	}, nil
+}
+
+func truthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case float64:
+		return v != 0
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	}
+
+	return true
+}
+
+// transformNumber converts numbers and numeric strings, like the values of
+// number_decimal fields, to float64.
+func transformNumber(value any) (float64, bool) {
+	if b, ok := value.(bool); ok {
+		if b {
+			return 1, true
+		}
+		return 0, true
+	}
+
+	return toFloat(value)
+}
+
+func transformString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(value)
+}
+
+// Transform pulls the store entries of a type and runs a script on each of
+// them. The transformed entries can be diffed and pushed like local entries.
+func (es *MetaobjectEntryService) Transform(defType string, script *TransformScript) (map[string]map[string]MetaobjectEntry, error) {
+	entries, err := es.Pull([]string{defType})
+	if err != nil {
+		return nil, err
+	}
+
+	transformed := make(map[string]MetaobjectEntry, len(entries[defType]))
+
+	for handle, entry := range entries[defType] {
+		e, err := script.Apply(defType, handle, entry)
+		if err != nil {
+			return nil, fmt.Errorf("entry %s: %w", EntryKey(defType, handle), err)
+		}
+
+		transformed[handle] = e
+	}
+
+	return map[string]map[string]MetaobjectEntry{defType: transformed}, nil
+}
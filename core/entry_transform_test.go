@@ -0,0 +1,219 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+func testEntry() MetaobjectEntry {
+	return MetaobjectEntry{
+		Status: shopify.MetaobjectStatusDraft,
+		Fields: map[string]any{
+			"name":   "Ada Lovelace",
+			"price":  "12.50",
+			"count":  3.0,
+			"tags":   []any{"a", "b", "c"},
+			"height": map[string]any{"value": 10.0, "unit": "in"},
+			"empty":  "",
+		},
+	}
+}
+
+func evalTransform(t *testing.T, expr string) any {
+	t.Helper()
+
+	script, err := ParseTransformScript("x = " + expr)
+	if err != nil {
+		t.Fatalf("parsing %q: %v", expr, err)
+	}
+
+	e, err := script.Apply("author", "ada", testEntry())
+	if err != nil {
+		t.Fatalf("applying %q: %v", expr, err)
+	}
+
+	return e.Fields["x"]
+}
+
+func TestTransformExpressions(t *testing.T) {
+	tests := []struct {
+		expr string
+		want any
+	}{
+		{`1 + 2 * 3`, 7.0},
+		{`(1 + 2) * 3`, 9.0},
+		{`10 - 4 - 3`, 3.0},
+		{`7 % 4`, 3.0},
+		{`-count + 1`, -2.0},
+		{`price * 2`, 25.0},
+		{`"a" + 1`, "a1"},
+		{`'it\'s'`, "it's"},
+		{`"tab\tx"`, "tab\tx"},
+		{`tags + ["d"]`, []any{"a", "b", "c", "d"}},
+		{`count == 3`, true},
+		{`price == 12.5`, false},
+		{`"b" < "c"`, true},
+		{`count >= 3 && count < 4`, true},
+		{`!empty || missing`, true},
+		{`missing && missing.value`, false},
+		{`count > 5 ? "many" : "few"`, "few"},
+		{`true ? false ? 1 : 2 : 3`, 2.0},
+		{`null`, nil},
+		{`height.value`, 10.0},
+		{`height["unit"]`, "in"},
+		{`tags[0]`, "a"},
+		{`tags[-1]`, "c"},
+		{`tags[10]`, nil},
+		{`missing.value`, nil},
+		{`{value: height.value * 2.54, "unit": "cm"}`, map[string]any{"value": 25.4, "unit": "cm"}},
+		{`[]`, []any{}},
+		{`$handle`, "ada"},
+		{`$type`, "author"},
+		{`$status`, "DRAFT"},
+		{`len(name)`, 12.0},
+		{`len(tags)`, 3.0},
+		{`len(missing)`, 0.0},
+		{`upper(name)`, "ADA LOVELACE"},
+		{`lower(name)`, "ada lovelace"},
+		{`trim("  x ")`, "x"},
+		{`split(name, " ")[1]`, "Lovelace"},
+		{`split(missing, " ")`, []any{}},
+		{`join(tags, "-")`, "a-b-c"},
+		{`replace(name, "a", "o")`, "Ado Loveloce"},
+		{`slice(name, 0, 3)`, "Ada"},
+		{`slice(name, -8)`, "Lovelace"},
+		{`slice(tags, 1)`, []any{"b", "c"}},
+		{`slice(tags, 2, 1)`, []any{}},
+		{`contains(tags, "b")`, true},
+		{`contains(name, "Love")`, true},
+		{`matches(name, "^A.a ")`, true},
+		{`number(price)`, 12.5},
+		{`string(count)`, "3"},
+		{`round(3.14159, 2)`, 3.14},
+		{`round(2.5)`, 3.0},
+		{`floor(2.7)`, 2.0},
+		{`ceil(2.1)`, 3.0},
+		{`default(empty, "none")`, "none"},
+		{`default(name, "none")`, "Ada Lovelace"},
+		{`field("na" + "me")`, "Ada Lovelace"},
+	}
+
+	for _, tt := range tests {
+		got := evalTransform(t, tt.expr)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %#v, want %#v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestTransformScript(t *testing.T) {
+	script, err := ParseTransformScript(`
+# split the name
+first_name = split(name, " ")[0]
+last_name = join(slice(split(name, " "), 1), " ")
+name = "renamed"   # later lines still see the old name
+delete count
+empty = null
+$status = "ACTIVE"
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := testEntry()
+	e, err := script.Apply("author", "ada", entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Fields["first_name"] != "Ada" || e.Fields["last_name"] != "Lovelace" {
+		t.Errorf("split name into %v and %v", e.Fields["first_name"], e.Fields["last_name"])
+	}
+	if e.Fields["name"] != "renamed" {
+		t.Errorf("name = %v", e.Fields["name"])
+	}
+	for _, key := range []string{"count", "empty"} {
+		if _, ok := e.Fields[key]; ok {
+			t.Errorf("field %s wasn't removed", key)
+		}
+	}
+	if e.Status != shopify.MetaobjectStatusActive {
+		t.Errorf("status = %v", e.Status)
+	}
+
+	if entry.Fields["name"] != "Ada Lovelace" || entry.Fields["count"] != 3.0 {
+		t.Errorf("Apply changed the original entry: %v", entry.Fields)
+	}
+}
+
+func TestTransformParseErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{`x = `, "line 1: unexpected end of line"},
+		{"\nx = (1", `line 2: expected ), found end of line`},
+		{`x = "open`, "unterminated string"},
+		{`x = 1.2.3`, "invalid number 1.2.3"},
+		{`x = a @ b`, `unexpected character '@'`},
+		{`x = 1 2`, `unexpected "2"`},
+		{`1 = x`, "expected a field to assign"},
+		{`$handle = x`, "expected a field to assign"},
+		{`x = $nope`, "unknown variable $nope"},
+		{`x = nope(1)`, "unknown function nope"},
+		{`x = len(1, 2)`, "len takes 1 argument, got 2"},
+		{`x = replace(a)`, "replace takes 3 arguments, got 1"},
+		{`x = slice(a)`, "slice takes 2 to 3 arguments, got 1"},
+		{`x = a.1`, "expected a key after ."},
+		{`x = {1: 2}`, "expected an object key"},
+		{`delete x y`, `unexpected "y"`},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseTransformScript(tt.src)
+		if err == nil {
+			t.Errorf("%q parsed without error", tt.src)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%q: error %q, want %q", tt.src, err, tt.want)
+		}
+	}
+}
+
+func TestTransformEvalErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`1 / 0`, "division by zero"},
+		{`1 % 0`, "division by zero"},
+		{`name - 1`, "can't apply -"},
+		{`-name`, "can't negate"},
+		{`count.value`, "can't index 3"},
+		{`tags["x"]`, "list index x is not a number"},
+		{`number(name)`, "number: Ada Lovelace is not a number"},
+		{`join(name, ",")`, "join: join of Ada Lovelace, which is not a list"},
+		{`matches(name, "(")`, "matches:"},
+		{`slice(count, 1)`, "slice: slice of 3"},
+	}
+
+	for _, tt := range tests {
+		script, err := ParseTransformScript("x = " + tt.expr)
+		if err != nil {
+			t.Fatalf("parsing %q: %v", tt.expr, err)
+		}
+
+		_, err = script.Apply("author", "ada", testEntry())
+		if err == nil {
+			t.Errorf("%s evaluated without error", tt.expr)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), "line 1: ") || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error %q, want %q", tt.expr, err, tt.want)
+		}
+	}
+}
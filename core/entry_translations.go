@@ -0,0 +1,355 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/hjson/hjson-go/v4"
+)
+
+// EntryTranslation holds the translated field values of an entry for one
+// locale. Translations are stored next to the entry file:
+// <dir>/<type>/<handle>.<locale>.hjson
+type EntryTranslation struct {
+	Fields map[string]string `json:"fields"`
+}
+
+// MissingTranslation is a translatable field of an entry which has no
+// translation for a locale, or whose translation is outdated.
+type MissingTranslation struct {
+	Type     string
+	Handle   string
+	Locale   string
+	Field    string
+	Outdated bool
+}
+
+type translatableResource = shopify.ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource
+
+func TranslationFilePath(dir string, defType string, handle string, locale string) string {
+	return filepath.Join(dir, defType, handle+"."+locale+entryFileExtension)
+}
+
+// splitEntryFileName splits an entry or translation file name into the entry
+// handle and the locale, which is empty for entry files.
+func splitEntryFileName(name string) (handle string, locale string) {
+	name = strings.TrimSuffix(name, entryFileExtension)
+	handle, locale, _ = strings.Cut(name, ".")
+	return handle, locale
+}
+
+// ReadTranslationDirectory reads the translation files of the given types,
+// keyed by type, handle and locale. When no types are given, every type
+// directory is read.
+func ReadTranslationDirectory(dir string, types []string) (map[string]map[string]map[string]EntryTranslation, error) {
+	if len(types) == 0 {
+		dirEntries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("reading entry directory %s: %w", dir, err)
+		}
+
+		for _, d := range dirEntries {
+			if d.IsDir() {
+				types = append(types, d.Name())
+			}
+		}
+	}
+
+	translations := make(map[string]map[string]map[string]EntryTranslation, len(types))
+
+	for _, defType := range types {
+		files, err := filepath.Glob(filepath.Join(dir, defType, "*.*"+entryFileExtension))
+		if err != nil {
+			return nil, err
+		}
+
+		typeTranslations := make(map[string]map[string]EntryTranslation)
+
+		for _, path := range files {
+			handle, locale := splitEntryFileName(filepath.Base(path))
+			if locale == "" {
+				continue
+			}
+
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("reading translation file %s: %w", path, err)
+			}
+
+			var t EntryTranslation
+			if err := hjson.Unmarshal(b, &t); err != nil {
+				return nil, fmt.Errorf("unmarshalling translation file %s: %w", path, err)
+			}
+
+			if typeTranslations[handle] == nil {
+				typeTranslations[handle] = make(map[string]EntryTranslation)
+			}
+			typeTranslations[handle][locale] = t
+		}
+
+		translations[defType] = typeTranslations
+	}
+
+	return translations, nil
+}
+
+func WriteTranslationFile(path string, translation EntryTranslation) error {
+	payload, err := hjson.Marshal(translation)
+	if err != nil {
+		return fmt.Errorf("marshalling translation file %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating entry directory for %s: %w", path, err)
+	}
+
+	return os.WriteFile(path, payload, 0644)
+}
+
+// TranslatableTypes returns the metaobject types with the translatable
+// capability enabled.
+func (es *MetaobjectEntryService) TranslatableTypes() ([]string, error) {
+	data, err := shopify.ListMetaobjectDefinitions(context.Background(), *es.ShopifyClient, 250)
+	if err != nil {
+		return nil, fmt.Errorf("listing metaobject definitions: %w", err)
+	}
+
+	types := make([]string, 0)
+	for _, d := range localMetaobjectDefinitions(data.MetaobjectDefinitions.Nodes, es.AppId) {
+		if d.Capabilities.Translatable.Enabled {
+			types = append(types, d.Type)
+		}
+	}
+
+	return types, nil
+}
+
+// Locales returns the shop's locales other than the primary locale.
+func (es *MetaobjectEntryService) Locales() ([]string, error) {
+	res, err := shopify.ListShopLocales(context.Background(), *es.ShopifyClient)
+	if err != nil {
+		return nil, fmt.Errorf("listing shop locales: %w", err)
+	}
+
+	locales := make([]string, 0, len(res.ShopLocales))
+	for _, l := range res.ShopLocales {
+		if !l.Primary {
+			locales = append(locales, l.Locale)
+		}
+	}
+
+	return locales, nil
+}
+
+// translatableResources returns the translatable content and the
+// translations for a locale of every entry of a type, keyed by handle.
+func (es *MetaobjectEntryService) translatableResources(defType string, locale string) (map[string]translatableResource, error) {
+	metaobjects, err := es.ListEntries(defType)
+	if err != nil {
+		return nil, err
+	}
+
+	handles := make(map[string]string, len(metaobjects))
+	ids := make([]string, 0, len(metaobjects))
+	for _, m := range metaobjects {
+		handles[m.Id] = m.Handle
+		ids = append(ids, m.Id)
+	}
+
+	resources := make(map[string]translatableResource, len(metaobjects))
+
+	for start := 0; start < len(ids); start += 250 {
+		end := min(start+250, len(ids))
+
+		res, err := shopify.ListTranslatableResources(context.Background(), *es.ShopifyClient, ids[start:end], locale)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s translations of type %s: %w", locale, defType, err)
+		}
+
+		for _, r := range res.TranslatableResourcesByIds.Nodes {
+			resources[handles[r.ResourceId]] = r
+		}
+	}
+
+	return resources, nil
+}
+
+func (es *MetaobjectEntryService) PullTranslations(types []string, locales []string) (map[string]map[string]map[string]EntryTranslation, error) {
+	translations := make(map[string]map[string]map[string]EntryTranslation, len(types))
+
+	for _, defType := range types {
+		typeTranslations := make(map[string]map[string]EntryTranslation)
+
+		for _, locale := range locales {
+			resources, err := es.translatableResources(defType, locale)
+			if err != nil {
+				return nil, err
+			}
+
+			for handle, r := range resources {
+				if len(r.Translations) == 0 {
+					continue
+				}
+
+				t := EntryTranslation{Fields: make(map[string]string, len(r.Translations))}
+				for _, tr := range r.Translations {
+					t.Fields[tr.Key] = tr.Value
+				}
+
+				if typeTranslations[handle] == nil {
+					typeTranslations[handle] = make(map[string]EntryTranslation)
+				}
+				typeTranslations[handle][locale] = t
+			}
+		}
+
+		translations[defType] = typeTranslations
+	}
+
+	return translations, nil
+}
+
+// PushTranslations registers local translations which are missing,
+// outdated or different in the store.
+func (es *MetaobjectEntryService) PushTranslations(translations map[string]map[string]map[string]EntryTranslation) error {
+	for defType, typeTranslations := range translations {
+		locales := make(map[string]bool)
+		for _, entryTranslations := range typeTranslations {
+			for locale := range entryTranslations {
+				locales[locale] = true
+			}
+		}
+
+		for locale := range locales {
+			resources, err := es.translatableResources(defType, locale)
+			if err != nil {
+				return err
+			}
+
+			for handle, entryTranslations := range typeTranslations {
+				t, ok := entryTranslations[locale]
+				if !ok {
+					continue
+				}
+
+				key := EntryKey(defType, handle)
+
+				r, ok := resources[handle]
+				if !ok {
+					return fmt.Errorf("entry %s not found for %s translation", key, locale)
+				}
+
+				inputs, err := newTranslationInputs(r, locale, t)
+				if err != nil {
+					return fmt.Errorf("entry %s: %w", key, err)
+				}
+
+				if len(inputs) == 0 {
+					continue
+				}
+
+				res, err := shopify.RegisterTranslations(context.Background(), *es.ShopifyClient, r.ResourceId, inputs)
+				if err != nil {
+					return fmt.Errorf("registering %s translations of entry %s: %w", locale, key, err)
+				}
+
+				if len(res.TranslationsRegister.UserErrors) > 0 {
+					return fmt.Errorf("registering %s translations of entry %s: %v", locale, key, res.TranslationsRegister.UserErrors)
+				}
+
+				log.Printf("Updated %s translations: %s\n", locale, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func newTranslationInputs(r translatableResource, locale string, t EntryTranslation) ([]shopify.TranslationInput, error) {
+	digests := make(map[string]string, len(r.TranslatableContent))
+	for _, c := range r.TranslatableContent {
+		digests[c.Key] = c.Digest
+	}
+
+	current := make(map[string]string, len(r.Translations))
+	outdated := make(map[string]bool, len(r.Translations))
+	for _, tr := range r.Translations {
+		current[tr.Key] = tr.Value
+		outdated[tr.Key] = tr.Outdated
+	}
+
+	fields := make([]string, 0, len(t.Fields))
+	for field := range t.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	inputs := make([]shopify.TranslationInput, 0, len(fields))
+
+	for _, field := range fields {
+		digest, ok := digests[field]
+		if !ok {
+			return nil, fmt.Errorf("field %s is not translatable", field)
+		}
+
+		value := t.Fields[field]
+		if v, ok := current[field]; ok && v == value && !outdated[field] {
+			continue
+		}
+
+		inputs = append(inputs, shopify.TranslationInput{
+			Locale:                    locale,
+			Key:                       field,
+			Value:                     value,
+			TranslatableContentDigest: digest,
+		})
+	}
+
+	return inputs, nil
+}
+
+// MissingTranslations reports the translatable fields with a value in the
+// primary locale but no up to date translation.
+func (es *MetaobjectEntryService) MissingTranslations(types []string, locales []string) ([]MissingTranslation, error) {
+	missing := make([]MissingTranslation, 0)
+
+	for _, defType := range types {
+		for _, locale := range locales {
+			resources, err := es.translatableResources(defType, locale)
+			if err != nil {
+				return nil, err
+			}
+
+			for handle, r := range resources {
+				translated := make(map[string]bool, len(r.Translations))
+				outdated := make(map[string]bool, len(r.Translations))
+				for _, tr := range r.Translations {
+					translated[tr.Key] = tr.Value != ""
+					outdated[tr.Key] = tr.Outdated
+				}
+
+				for _, c := range r.TranslatableContent {
+					if c.Value == "" || (translated[c.Key] && !outdated[c.Key]) {
+						continue
+					}
+
+					missing = append(missing, MissingTranslation{
+						Type:     defType,
+						Handle:   handle,
+						Locale:   locale,
+						Field:    c.Key,
+						Outdated: outdated[c.Key],
+					})
+				}
+			}
+		}
+	}
+
+	return missing, nil
+}
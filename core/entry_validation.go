@@ -0,0 +1,539 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+type EntryViolation struct {
+	Type    string
+	Handle  string
+	Field   string
+	Message string
+	// Warning marks a reference which can't be checked locally. Warnings
+	// don't make entries invalid.
+	Warning bool
+}
+
+// referenceGidTypes are the resources which the GIDs held by each type of
+// reference field can point at.
+var referenceGidTypes = map[string][]string{
+	"metaobject_reference": {"Metaobject"},
+	"mixed_reference":      {"Metaobject"},
+	"product_reference":    {"Product"},
+	"collection_reference": {"Collection"},
+	"variant_reference":    {"ProductVariant"},
+	"file_reference":       {"GenericFile", "MediaImage", "Video", "Model3d", "ExternalVideo"},
+}
+
+func (v EntryViolation) Error() string {
+	if v.Field == "" {
+		return fmt.Sprintf("%s: %s", EntryKey(v.Type, v.Handle), v.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", EntryKey(v.Type, v.Handle), v.Field, v.Message)
+}
+
+// ValidateEntries checks entries against their local definitions without
+// contacting the store. All entries are used to check metaobject references,
+// but only the given types are validated. When no types are given, every type
+// is validated.
+func ValidateEntries(definitions map[string]MetaobjectDefinition, entries map[string]map[string]MetaobjectEntry, types []string) []EntryViolation {
+	if len(types) == 0 {
+		for defType := range entries {
+			types = append(types, defType)
+		}
+	}
+
+	sort.Strings(types)
+	violations := make([]EntryViolation, 0)
+
+	for _, defType := range types {
+		definition, ok := definitions[defType]
+		if !ok {
+			violations = append(violations, EntryViolation{
+				Type:    defType,
+				Message: "metaobject definition not found",
+			})
+			continue
+		}
+
+		// The fields of standard definitions come from their template,
+		// which isn't part of the definitions file.
+		if definition.Standard != "" {
+			continue
+		}
+
+		handles := make([]string, 0, len(entries[defType]))
+		for handle := range entries[defType] {
+			handles = append(handles, handle)
+		}
+		sort.Strings(handles)
+
+		for _, handle := range handles {
+			for _, v := range validateEntry(definition, entries[defType][handle], entries) {
+				v.Type, v.Handle = defType, handle
+				violations = append(violations, v)
+			}
+		}
+	}
+
+	return violations
+}
+
+func validateEntry(definition MetaobjectDefinition, entry MetaobjectEntry, entries map[string]map[string]MetaobjectEntry) []EntryViolation {
+	violations := make([]EntryViolation, 0)
+
+	if entry.Status != "" {
+		switch {
+		case definition.Capabilities == nil || !definition.Capabilities.Publishable:
+			violations = append(violations, EntryViolation{Message: "status is set but the definition is not publishable"})
+		case entry.Status != shopify.MetaobjectStatusActive && entry.Status != shopify.MetaobjectStatusDraft:
+			violations = append(violations, EntryViolation{Message: fmt.Sprintf("status %s must be ACTIVE or DRAFT", entry.Status)})
+		}
+	}
+
+	keys := make([]string, 0, len(definition.FieldDefinitions)+len(entry.Fields))
+	for key := range definition.FieldDefinitions {
+		keys = append(keys, key)
+	}
+	for key := range entry.Fields {
+		if _, ok := definition.FieldDefinitions[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field, ok := definition.FieldDefinitions[key]
+		if !ok {
+			violations = append(violations, EntryViolation{Field: key, Message: "field has no field definition"})
+			continue
+		}
+
+		value, ok := entry.Fields[key]
+		if !ok || value == nil || value == "" {
+			if field.Required {
+				violations = append(violations, EntryViolation{Field: key, Message: "required field is missing"})
+			}
+			continue
+		}
+
+		for _, msg := range validateFieldValue(field, value, entries) {
+			violations = append(violations, EntryViolation{Field: key, Message: msg})
+		}
+
+		for _, ref := range uncheckedReferences(field.Type, value, entries) {
+			defType, _, _ := strings.Cut(ref, "/")
+			violations = append(violations, EntryViolation{
+				Field:   key,
+				Message: fmt.Sprintf("referenced entry %s can't be checked, type %s has no local entries", ref, defType),
+				Warning: true,
+			})
+		}
+	}
+
+	return violations
+}
+
+func validateFieldValue(field FieldDefinition, value any, entries map[string]map[string]MetaobjectEntry) []string {
+	if !isListFieldType(field.Type) {
+		return validateSingleValue(baseFieldType(field.Type), field.Validations, value, entries)
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return []string{fmt.Sprintf("%s value must be a list", field.Type)}
+	}
+
+	msgs := make([]string, 0)
+
+	if min, ok := toFloat(field.Validations["list.min"]); ok && float64(len(items)) < min {
+		msgs = append(msgs, fmt.Sprintf("list has %d items, minimum is %v", len(items), min))
+	}
+
+	if max, ok := toFloat(field.Validations["list.max"]); ok && float64(len(items)) > max {
+		msgs = append(msgs, fmt.Sprintf("list has %d items, maximum is %v", len(items), max))
+	}
+
+	for i, item := range items {
+		for _, msg := range validateSingleValue(baseFieldType(field.Type), field.Validations, item, entries) {
+			msgs = append(msgs, fmt.Sprintf("item %d: %s", i, msg))
+		}
+	}
+
+	return msgs
+}
+
+func validateSingleValue(fieldType string, validations map[string]any, value any, entries map[string]map[string]MetaobjectEntry) []string {
+	msgs := make([]string, 0)
+
+	switch fieldType {
+	case "single_line_text_field", "multi_line_text_field":
+		s, ok := value.(string)
+		if !ok {
+			return []string{"value must be a string"}
+		}
+
+		length := float64(utf8.RuneCountInString(s))
+		if min, ok := toFloat(validations["min"]); ok && length < min {
+			msgs = append(msgs, fmt.Sprintf("value is %v characters, minimum is %v", length, min))
+		}
+		if max, ok := toFloat(validations["max"]); ok && length > max {
+			msgs = append(msgs, fmt.Sprintf("value is %v characters, maximum is %v", length, max))
+		}
+
+		if pattern, ok := validations["regex"].(string); ok {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				msgs = append(msgs, fmt.Sprintf("invalid regex validation %q: %v", pattern, err))
+			} else if !re.MatchString(s) {
+				msgs = append(msgs, fmt.Sprintf("value %q does not match %q", s, pattern))
+			}
+		}
+
+		if choices, ok := validations["choices"].([]any); ok {
+			found := false
+			for _, c := range choices {
+				if c == s {
+					found = true
+					break
+				}
+			}
+			if !found {
+				msgs = append(msgs, fmt.Sprintf("value %q is not one of %v", s, choices))
+			}
+		}
+
+	case "number_integer", "number_decimal":
+		n, ok := toFloat(value)
+		if !ok {
+			return []string{fmt.Sprintf("value %v is not a number", value)}
+		}
+
+		if fieldType == "number_integer" && n != math.Trunc(n) {
+			msgs = append(msgs, fmt.Sprintf("value %v is not an integer", value))
+		}
+		if min, ok := toFloat(validations["min"]); ok && n < min {
+			msgs = append(msgs, fmt.Sprintf("value %v is less than minimum %v", value, min))
+		}
+		if max, ok := toFloat(validations["max"]); ok && n > max {
+			msgs = append(msgs, fmt.Sprintf("value %v is greater than maximum %v", value, max))
+		}
+
+		if precision, ok := toFloat(validations["max_precision"]); ok {
+			s := fmt.Sprint(value)
+			if _, decimals, found := strings.Cut(s, "."); found && float64(len(decimals)) > precision {
+				msgs = append(msgs, fmt.Sprintf("value %v has more than %v decimal places", value, precision))
+			}
+		}
+
+	case "date", "date_time":
+		s, ok := value.(string)
+		if !ok {
+			return []string{"value must be a string"}
+		}
+
+		t, err := parseDate(s)
+		if err != nil {
+			return []string{fmt.Sprintf("value %q is not a valid %s", s, fieldType)}
+		}
+
+		if min, ok := validations["min"].(string); ok {
+			if m, err := parseDate(min); err == nil && t.Before(m) {
+				msgs = append(msgs, fmt.Sprintf("value %s is before minimum %s", s, min))
+			}
+		}
+		if max, ok := validations["max"].(string); ok {
+			if m, err := parseDate(max); err == nil && t.After(m) {
+				msgs = append(msgs, fmt.Sprintf("value %s is after maximum %s", s, max))
+			}
+		}
+
+	case "rating":
+		rating, ok := value.(map[string]any)
+		if !ok {
+			return []string{"rating value must be an object"}
+		}
+
+		n, ok := toFloat(rating["value"])
+		if !ok {
+			return []string{"rating value is not a number"}
+		}
+		if min, ok := toFloat(validations["scale_min"]); ok && n < min {
+			msgs = append(msgs, fmt.Sprintf("rating %v is less than scale minimum %v", n, min))
+		}
+		if max, ok := toFloat(validations["scale_max"]); ok && n > max {
+			msgs = append(msgs, fmt.Sprintf("rating %v is greater than scale maximum %v", n, max))
+		}
+
+	case "dimension", "volume", "weight":
+		measurement, ok := value.(map[string]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s value must be an object with value and unit", fieldType)}
+		}
+
+		if min, ok := validations["min"].(map[string]any); ok && compareMeasurement(measurement, min) < 0 {
+			msgs = append(msgs, fmt.Sprintf("value %v %v is less than minimum %v %v", measurement["value"], measurement["unit"], min["value"], min["unit"]))
+		}
+		if max, ok := validations["max"].(map[string]any); ok && compareMeasurement(measurement, max) > 0 {
+			msgs = append(msgs, fmt.Sprintf("value %v %v is greater than maximum %v %v", measurement["value"], measurement["unit"], max["value"], max["unit"]))
+		}
+
+	case "url":
+		s, ok := value.(string)
+		if !ok {
+			return []string{"value must be a string"}
+		}
+
+		u, err := url.Parse(s)
+		if err != nil || u.Scheme == "" {
+			return []string{fmt.Sprintf("value %q is not a valid url", s)}
+		}
+
+		if domains, ok := validations["allowed_domains"].([]any); ok {
+			allowed := false
+			for _, d := range domains {
+				if d == u.Hostname() {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				msgs = append(msgs, fmt.Sprintf("domain %s is not one of %v", u.Hostname(), domains))
+			}
+		}
+
+	case "json":
+		if schema, ok := validations["schema"].(map[string]any); ok {
+			msgs = append(msgs, validateJsonSchema(schema, value, "$")...)
+		}
+
+	case "rich_text_field":
+		if s, ok := value.(string); ok && !isEncodedRichText(s) {
+			if _, err := markdownToRichText(s); err != nil {
+				msgs = append(msgs, err.Error())
+			}
+		}
+
+	case "metaobject_reference", "mixed_reference", "product_reference", "collection_reference", "variant_reference", "file_reference":
+		s, ok := value.(string)
+		if !ok {
+			return []string{"reference must be a string"}
+		}
+
+		if strings.HasPrefix(s, gidPrefix) {
+			resource, _, _ := strings.Cut(strings.TrimPrefix(s, gidPrefix), "/")
+			if allowed := referenceGidTypes[fieldType]; !contains(allowed, resource) {
+				msgs = append(msgs, fmt.Sprintf("reference %s is not a %s", s, strings.Join(allowed, ", ")))
+			}
+			break
+		}
+
+		if fieldType != "metaobject_reference" && fieldType != "mixed_reference" {
+			break
+		}
+
+		defType, handle, ok := strings.Cut(s, "/")
+		if !ok {
+			return []string{fmt.Sprintf("metaobject reference %q must be <type>/<handle>", s)}
+		}
+
+		allowed := referencedTypes(validations)
+		if len(allowed) > 0 && !contains(allowed, defType) {
+			msgs = append(msgs, fmt.Sprintf("reference %s is not of type %s", s, strings.Join(allowed, ", ")))
+		}
+
+		if typeEntries, ok := entries[defType]; ok {
+			if _, ok := typeEntries[handle]; !ok {
+				msgs = append(msgs, fmt.Sprintf("referenced entry %s does not exist", s))
+			}
+		}
+	}
+
+	return msgs
+}
+
+// uncheckedReferences returns the metaobject references of a field value to
+// types which have no local entries to check them against.
+func uncheckedReferences(fieldType string, value any, entries map[string]map[string]MetaobjectEntry) []string {
+	if kind := baseFieldType(fieldType); kind != "metaobject_reference" && kind != "mixed_reference" {
+		return nil
+	}
+
+	unchecked := make([]string, 0)
+	for _, ref := range referenceValues(value) {
+		defType, _, ok := strings.Cut(ref, "/")
+		if !ok || strings.HasPrefix(ref, gidPrefix) {
+			continue
+		}
+
+		if _, ok := entries[defType]; !ok {
+			unchecked = append(unchecked, ref)
+		}
+	}
+
+	return unchecked
+}
+
+// referenceValues returns the references of a single or list reference
+// value, leaving out values which aren't strings.
+func referenceValues(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		refs := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				refs = append(refs, s)
+			}
+		}
+		return refs
+	}
+
+	return nil
+}
+
+// ValidateReferences looks up the references which ValidateEntries can't
+// check locally in the store: product, collection, variant and file keys,
+// and metaobject references to types without local entries. GIDs and local
+// assets aren't looked up.
+func (es *MetaobjectEntryService) ValidateReferences(definitions map[string]MetaobjectDefinition, entries map[string]map[string]MetaobjectEntry, types []string) ([]EntryViolation, error) {
+	if len(types) == 0 {
+		for defType := range entries {
+			types = append(types, defType)
+		}
+	}
+
+	sort.Strings(types)
+	violations := make([]EntryViolation, 0)
+
+	for _, defType := range types {
+		definition, ok := definitions[defType]
+		if !ok || definition.Standard != "" {
+			continue
+		}
+
+		handles := make([]string, 0, len(entries[defType]))
+		for handle := range entries[defType] {
+			handles = append(handles, handle)
+		}
+		sort.Strings(handles)
+
+		for _, handle := range handles {
+			entry := entries[defType][handle]
+
+			keys := make([]string, 0, len(entry.Fields))
+			for key := range entry.Fields {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				fieldType := definition.FieldDefinitions[key].Type
+				if !isReferenceFieldType(fieldType) {
+					continue
+				}
+
+				for _, ref := range referenceValues(entry.Fields[key]) {
+					if ref == "" || strings.HasPrefix(ref, gidPrefix) || isLocalAssetPath(ref) {
+						continue
+					}
+
+					if kind := baseFieldType(fieldType); kind == "metaobject_reference" || kind == "mixed_reference" {
+						refType, _, ok := strings.Cut(ref, "/")
+						if _, local := entries[refType]; !ok || local {
+							continue
+						}
+					}
+
+					_, err := es.resolver().ResolveId(fieldType, ref)
+					if errors.Is(err, ErrReferenceNotFound) {
+						violations = append(violations, EntryViolation{
+							Type:    defType,
+							Handle:  handle,
+							Field:   key,
+							Message: fmt.Sprintf("reference %s does not exist in the store", ref),
+						})
+						continue
+					}
+					if err != nil {
+						return nil, fmt.Errorf("entry %s: field %s: %w", EntryKey(defType, handle), key, err)
+					}
+				}
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+func parseDate(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %s", s)
+}
+
+// compareMeasurement compares two measurements with the same unit. Values
+// in different units are not converted and compare as equal.
+func compareMeasurement(a map[string]any, b map[string]any) int {
+	if a["unit"] != b["unit"] {
+		return 0
+	}
+
+	av, aok := toFloat(a["value"])
+	bv, bok := toFloat(b["value"])
+	if !aok || !bok {
+		return 0
+	}
+
+	switch {
+	case av < bv:
+		return -1
+	case av > bv:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,218 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/Khan/genqlient/graphql"
+)
+
+func validationDefinitions() map[string]MetaobjectDefinition {
+	return map[string]MetaobjectDefinition{
+		"author": {
+			Capabilities: &Capabilities{Publishable: true},
+			FieldDefinitions: map[string]FieldDefinition{
+				"name":    {Type: "single_line_text_field", Required: true, Validations: map[string]any{"max": 10.0}},
+				"genre":   {Type: "single_line_text_field", Validations: map[string]any{"choices": []any{"fiction", "poetry"}}},
+				"born":    {Type: "date", Validations: map[string]any{"min": "1800-01-01"}},
+				"books":   {Type: "number_integer", Validations: map[string]any{"min": 0.0}},
+				"rating":  {Type: "rating", Validations: map[string]any{"scale_min": "1", "scale_max": "5"}},
+				"site":    {Type: "url", Validations: map[string]any{"allowed_domains": []any{"example.com"}}},
+				"tags":    {Type: "list.single_line_text_field", Validations: map[string]any{"list.max": 2.0}},
+				"bio":     {Type: "rich_text_field"},
+				"meta":    {Type: "json", Validations: map[string]any{"schema": map[string]any{"type": "object", "required": []any{"id"}}}},
+				"mentor":  {Type: "metaobject_reference", Validations: map[string]any{"metaobject_definition": "author"}},
+				"friends": {Type: "list.metaobject_reference", Validations: map[string]any{"metaobject_definitions": []string{"author"}}},
+				"agency":  {Type: "metaobject_reference", Validations: map[string]any{"metaobject_definition": "agency"}},
+				"product": {Type: "product_reference"},
+				"cover":   {Type: "file_reference"},
+			},
+		},
+		"review": {Standard: "product_review"},
+	}
+}
+
+func TestValidateEntriesValid(t *testing.T) {
+	entries := map[string]map[string]MetaobjectEntry{
+		"author": {
+			"ada": {Status: shopify.MetaobjectStatusActive, Fields: map[string]any{
+				"name":    "Ada",
+				"genre":   "poetry",
+				"born":    "1815-12-10",
+				"books":   2.0,
+				"rating":  map[string]any{"value": "4", "scale_min": "1", "scale_max": "5"},
+				"site":    "https://example.com/ada",
+				"tags":    []any{"math", "poetry"},
+				"bio":     "**Ada** wrote [notes](https://example.com/notes)",
+				"meta":    map[string]any{"id": 1.0},
+				"mentor":  "author/charles",
+				"friends": []any{"author/charles", "gid://shopify/Metaobject/1"},
+				"product": "gid://shopify/Product/1",
+				"cover":   "./images/ada.png",
+			}},
+			"charles": {Fields: map[string]any{"name": "Charles"}},
+		},
+		"review": {"first": {Fields: map[string]any{"anything": "goes"}}},
+	}
+
+	if violations := ValidateEntries(validationDefinitions(), entries, nil); len(violations) != 0 {
+		t.Errorf("ValidateEntries = %v, want no violations", violations)
+	}
+}
+
+func TestValidateEntriesViolations(t *testing.T) {
+	entries := map[string]map[string]MetaobjectEntry{
+		"author": {
+			"ada": {Status: "ARCHIVED", Fields: map[string]any{
+				"genre":   "prose",
+				"born":    "1700-01-01",
+				"books":   1.5,
+				"rating":  map[string]any{"value": "6"},
+				"site":    "https://other.com",
+				"tags":    []any{"a", "b", "c"},
+				"bio":     "> quoted",
+				"meta":    map[string]any{},
+				"mentor":  "book/emma",
+				"friends": []any{"author/nobody", "book/emma"},
+				"agency":  "agency/pan",
+				"product": "gid://shopify/Collection/1",
+				"unknown": "x",
+			}},
+		},
+		"book": {},
+	}
+
+	want := []EntryViolation{
+		{Type: "author", Handle: "ada", Message: "status ARCHIVED must be ACTIVE or DRAFT"},
+		{Type: "author", Handle: "ada", Field: "agency", Message: "referenced entry agency/pan can't be checked, type agency has no local entries", Warning: true},
+		{Type: "author", Handle: "ada", Field: "bio", Message: "line 1: blockquotes can't be expressed in rich text"},
+		{Type: "author", Handle: "ada", Field: "books", Message: "value 1.5 is not an integer"},
+		{Type: "author", Handle: "ada", Field: "born", Message: "value 1700-01-01 is before minimum 1800-01-01"},
+		{Type: "author", Handle: "ada", Field: "friends", Message: "item 0: referenced entry author/nobody does not exist"},
+		{Type: "author", Handle: "ada", Field: "friends", Message: "item 1: reference book/emma is not of type author"},
+		{Type: "author", Handle: "ada", Field: "friends", Message: "item 1: referenced entry book/emma does not exist"},
+		{Type: "author", Handle: "ada", Field: "genre", Message: `value "prose" is not one of [fiction poetry]`},
+		{Type: "author", Handle: "ada", Field: "mentor", Message: "reference book/emma is not of type author"},
+		{Type: "author", Handle: "ada", Field: "mentor", Message: "referenced entry book/emma does not exist"},
+		{Type: "author", Handle: "ada", Field: "meta", Message: "$: missing required property id"},
+		{Type: "author", Handle: "ada", Field: "name", Message: "required field is missing"},
+		{Type: "author", Handle: "ada", Field: "product", Message: "reference gid://shopify/Collection/1 is not a Product"},
+		{Type: "author", Handle: "ada", Field: "rating", Message: "rating 6 is greater than scale maximum 5"},
+		{Type: "author", Handle: "ada", Field: "site", Message: "domain other.com is not one of [example.com]"},
+		{Type: "author", Handle: "ada", Field: "tags", Message: "list has 3 items, maximum is 2"},
+		{Type: "author", Handle: "ada", Field: "unknown", Message: "field has no field definition"},
+		{Type: "book", Message: "metaobject definition not found"},
+	}
+
+	if got := ValidateEntries(validationDefinitions(), entries, nil); !reflect.DeepEqual(got, want) {
+		gotJson, _ := json.MarshalIndent(got, "", "  ")
+		t.Errorf("ValidateEntries = %s", gotJson)
+	}
+}
+
+func TestValidateEntriesOnlyGivenTypes(t *testing.T) {
+	entries := map[string]map[string]MetaobjectEntry{
+		"author": {"ada": {Fields: map[string]any{"name": "Ada", "mentor": "author/charles"}}},
+		"book":   {},
+	}
+
+	want := []EntryViolation{
+		{Type: "author", Handle: "ada", Field: "mentor", Message: "referenced entry author/charles does not exist"},
+	}
+
+	if got := ValidateEntries(validationDefinitions(), entries, []string{"author"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateEntries = %v, want %v", got, want)
+	}
+}
+
+// lookupClient answers reference lookups by handle from a set of existing
+// handles, and records the operations it was sent.
+type lookupClient struct {
+	existing   map[string]bool
+	operations *[]string
+}
+
+func (c lookupClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	*c.operations = append(*c.operations, req.OpName)
+
+	b, err := json.Marshal(req.Variables)
+	if err != nil {
+		return err
+	}
+
+	var vars struct {
+		Handle any `json:"handle"`
+	}
+	if err := json.Unmarshal(b, &vars); err != nil {
+		return err
+	}
+
+	handle := vars.Handle
+	if h, ok := vars.Handle.(map[string]any); ok {
+		handle = h["type"].(string) + "/" + h["handle"].(string)
+	}
+
+	node := `null`
+	if c.existing[handle.(string)] {
+		node = `{"id":"gid://shopify/Node/1"}`
+	}
+
+	switch req.OpName {
+	case "GetProductByHandle":
+		return json.Unmarshal([]byte(`{"productByIdentifier":`+node+`}`), resp.Data)
+	case "GetMetaobjectByHandle":
+		return json.Unmarshal([]byte(`{"metaobjectByHandle":`+node+`}`), resp.Data)
+	}
+
+	return nil
+}
+
+func TestValidateReferences(t *testing.T) {
+	var operations []string
+	var client graphql.Client = lookupClient{
+		existing:   map[string]bool{"shirt": true, "agency/pan": true},
+		operations: &operations,
+	}
+	es := &MetaobjectEntryService{ShopifyClient: &client}
+
+	entries := map[string]map[string]MetaobjectEntry{
+		"author": {
+			"ada": {Fields: map[string]any{
+				"name":    "Ada",
+				"mentor":  "author/charles",
+				"agency":  "agency/pan",
+				"product": "shirt",
+				"cover":   "./images/ada.png",
+			}},
+			"charles": {Fields: map[string]any{
+				"name":    "Charles",
+				"agency":  "agency/folded",
+				"product": "gid://shopify/Product/1",
+			}},
+			"emma": {Fields: map[string]any{
+				"name":    "Emma",
+				"product": "hat",
+			}},
+		},
+	}
+
+	got, err := es.ValidateReferences(validationDefinitions(), entries, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []EntryViolation{
+		{Type: "author", Handle: "charles", Field: "agency", Message: "reference agency/folded does not exist in the store"},
+		{Type: "author", Handle: "emma", Field: "product", Message: "reference hat does not exist in the store"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateReferences = %v, want %v", got, want)
+	}
+
+	if len(operations) != 4 {
+		t.Errorf("looked up %v, want only the product and agency references", operations)
+	}
+}
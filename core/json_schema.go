@@ -0,0 +1,169 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+)
+
+// validateJsonSchema checks a value against the subset of JSON Schema used by
+// json field validations: type, enum, const, properties, required,
+// additionalProperties, items, length and range keywords.
+func validateJsonSchema(schema map[string]any, value any, path string) []string {
+	msgs := make([]string, 0)
+
+	if t, ok := schema["type"]; ok && !matchesSchemaType(t, value) {
+		return []string{fmt.Sprintf("%s: expected %v", path, t)}
+	}
+
+	if enum, ok := schema["enum"].([]any); ok {
+		found := false
+		for _, e := range enum {
+			if reflect.DeepEqual(normalizeNumber(e), normalizeNumber(value)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			msgs = append(msgs, fmt.Sprintf("%s: value %v is not one of %v", path, value, enum))
+		}
+	}
+
+	if c, ok := schema["const"]; ok && !reflect.DeepEqual(normalizeNumber(c), normalizeNumber(value)) {
+		msgs = append(msgs, fmt.Sprintf("%s: value must be %v", path, c))
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		properties, _ := schema["properties"].(map[string]any)
+
+		if required, ok := schema["required"].([]any); ok {
+			for _, r := range required {
+				if _, ok := v[fmt.Sprint(r)]; !ok {
+					msgs = append(msgs, fmt.Sprintf("%s: missing required property %v", path, r))
+				}
+			}
+		}
+
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if propSchema, ok := properties[key].(map[string]any); ok {
+				msgs = append(msgs, validateJsonSchema(propSchema, v[key], path+"."+key)...)
+				continue
+			}
+
+			if _, ok := properties[key]; ok {
+				continue
+			}
+
+			switch additional := schema["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					msgs = append(msgs, fmt.Sprintf("%s: property %s is not allowed", path, key))
+				}
+			case map[string]any:
+				msgs = append(msgs, validateJsonSchema(additional, v[key], path+"."+key)...)
+			}
+		}
+
+	case []any:
+		if min, ok := toFloat(schema["minItems"]); ok && float64(len(v)) < min {
+			msgs = append(msgs, fmt.Sprintf("%s: expected at least %v items", path, min))
+		}
+		if max, ok := toFloat(schema["maxItems"]); ok && float64(len(v)) > max {
+			msgs = append(msgs, fmt.Sprintf("%s: expected at most %v items", path, max))
+		}
+
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, item := range v {
+				msgs = append(msgs, validateJsonSchema(items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+
+	case string:
+		length := float64(utf8.RuneCountInString(v))
+		if min, ok := toFloat(schema["minLength"]); ok && length < min {
+			msgs = append(msgs, fmt.Sprintf("%s: expected at least %v characters", path, min))
+		}
+		if max, ok := toFloat(schema["maxLength"]); ok && length > max {
+			msgs = append(msgs, fmt.Sprintf("%s: expected at most %v characters", path, max))
+		}
+
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(v) {
+				msgs = append(msgs, fmt.Sprintf("%s: value %q does not match %q", path, v, pattern))
+			}
+		}
+
+	case float64:
+		if min, ok := toFloat(schema["minimum"]); ok && v < min {
+			msgs = append(msgs, fmt.Sprintf("%s: value %v is less than %v", path, v, min))
+		}
+		if max, ok := toFloat(schema["maximum"]); ok && v > max {
+			msgs = append(msgs, fmt.Sprintf("%s: value %v is greater than %v", path, v, max))
+		}
+		if min, ok := toFloat(schema["exclusiveMinimum"]); ok && v <= min {
+			msgs = append(msgs, fmt.Sprintf("%s: value %v must be greater than %v", path, v, min))
+		}
+		if max, ok := toFloat(schema["exclusiveMaximum"]); ok && v >= max {
+			msgs = append(msgs, fmt.Sprintf("%s: value %v must be less than %v", path, v, max))
+		}
+	}
+
+	return msgs
+}
+
+func matchesSchemaType(schemaType any, value any) bool {
+	if types, ok := schemaType.([]any); ok {
+		for _, t := range types {
+			if matchesSchemaType(t, value) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		f, ok := value.(float64)
+		return ok && f == math.Trunc(f)
+	}
+
+	return true
+}
+
+func normalizeNumber(value any) any {
+	if f, ok := toFloat(value); ok {
+		if _, isString := value.(string); !isString {
+			return f
+		}
+	}
+
+	return value
+}
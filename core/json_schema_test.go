@@ -0,0 +1,73 @@
+package core
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestValidateJsonSchema(t *testing.T) {
+	tests := []struct {
+		schema string
+		value  string
+		want   []string
+	}{
+		{`{"type": "object"}`, `{}`, []string{}},
+		{`{"type": "object"}`, `[]`, []string{"$: expected object"}},
+		{`{"type": ["string", "null"]}`, `null`, []string{}},
+		{`{"type": "integer"}`, `1.5`, []string{"$: expected integer"}},
+		{`{"type": "integer"}`, `2`, []string{}},
+		{`{"enum": ["a", 1]}`, `1`, []string{}},
+		{`{"enum": ["a", 1]}`, `"b"`, []string{"$: value b is not one of [a 1]"}},
+		{`{"const": "fixed"}`, `"other"`, []string{"$: value must be fixed"}},
+		{
+			`{"type": "object", "required": ["id", "name"], "properties": {"id": {"type": "number"}}}`,
+			`{"id": "1"}`,
+			[]string{"$: missing required property name", "$.id: expected number"},
+		},
+		{
+			`{"properties": {"id": {}}, "additionalProperties": false}`,
+			`{"id": 1, "extra": true}`,
+			[]string{"$: property extra is not allowed"},
+		},
+		{
+			`{"additionalProperties": {"type": "string"}}`,
+			`{"a": "x", "b": 2}`,
+			[]string{"$.b: expected string"},
+		},
+		{
+			`{"type": "array", "minItems": 1, "maxItems": 2, "items": {"type": "string"}}`,
+			`["a", 2, "c"]`,
+			[]string{"$: expected at most 2 items", "$[1]: expected string"},
+		},
+		{`{"minItems": 1}`, `[]`, []string{"$: expected at least 1 items"}},
+		{`{"minLength": 2, "maxLength": 3}`, `"é"`, []string{"$: expected at least 2 characters"}},
+		{`{"maxLength": 3}`, `"long"`, []string{"$: expected at most 3 characters"}},
+		{`{"pattern": "^[a-z]+$"}`, `"A1"`, []string{`$: value "A1" does not match "^[a-z]+$"`}},
+		{`{"minimum": 1, "maximum": 3}`, `0`, []string{"$: value 0 is less than 1"}},
+		{`{"minimum": 1, "maximum": 3}`, `4`, []string{"$: value 4 is greater than 3"}},
+		{`{"exclusiveMinimum": 1}`, `1`, []string{"$: value 1 must be greater than 1"}},
+		{`{"exclusiveMaximum": 3}`, `3`, []string{"$: value 3 must be less than 3"}},
+		{
+			`{"properties": {"sizes": {"type": "array", "items": {"type": "object", "required": ["label"]}}}}`,
+			`{"sizes": [{"label": "S"}, {}]}`,
+			[]string{"$.sizes[1]: missing required property label"},
+		},
+	}
+
+	for _, tt := range tests {
+		var schema map[string]any
+		if err := json.Unmarshal([]byte(tt.schema), &schema); err != nil {
+			t.Fatalf("schema %s: %v", tt.schema, err)
+		}
+
+		var value any
+		if err := json.Unmarshal([]byte(tt.value), &value); err != nil {
+			t.Fatalf("value %s: %v", tt.value, err)
+		}
+
+		if got := validateJsonSchema(schema, value, "$"); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("validateJsonSchema(%s, %s) = %q, want %q", tt.schema, tt.value, got, tt.want)
+		}
+	}
+}
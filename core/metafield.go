@@ -0,0 +1,112 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+// MetafieldValueOwnerTypes are the owner types whose metafield values can be
+// managed from files. Their resources are identified by handle.
+var MetafieldValueOwnerTypes = []string{"PRODUCT", "COLLECTION"}
+
+// MetafieldValues are the metafield values of a resource, keyed by
+// <namespace>.<key>. Values are decoded by metafield type, like the fields of
+// metaobject entries.
+type MetafieldValues map[string]any
+
+func MetafieldResourceKey(ownerType string, handle string) string {
+	return ownerType + "/" + handle
+}
+
+// parseMetafieldValueOwnerType checks that the metafield values of an owner
+// type can be managed from files.
+func parseMetafieldValueOwnerType(ownerType string) (shopify.MetafieldOwnerType, error) {
+	t, err := ParseMetafieldOwnerType(ownerType)
+	if err != nil {
+		return "", err
+	}
+
+	switch t {
+	case shopify.MetafieldOwnerTypeProduct, shopify.MetafieldOwnerTypeCollection:
+		return t, nil
+	}
+
+	return "", fmt.Errorf("metafield values of %s resources are not supported, use one of %s", t, strings.Join(MetafieldValueOwnerTypes, ", "))
+}
+
+// convertMetafields decodes the metafields of a resource, leaving out those in
+// app namespaces. It returns the type of each metafield next to its value.
+func convertMetafields(metafields []shopify.Cli_Metafield) (MetafieldValues, map[string]string) {
+	values := make(MetafieldValues, len(metafields))
+	types := make(map[string]string, len(metafields))
+
+	for _, m := range metafields {
+		if strings.HasPrefix(m.Namespace, appNamespacePrefix) || m.Value == "" {
+			continue
+		}
+
+		key := m.Namespace + "." + m.Key
+		values[key] = decodeFieldValue(m.Type, m.Value)
+		types[key] = m.Type
+	}
+
+	return values, types
+}
+
+// normalizeMetafieldValues round trips values through the Shopify encoding so
+// local and remote values can be compared.
+func normalizeMetafieldValues(values MetafieldValues, types map[string]string) (MetafieldValues, error) {
+	normalized := make(MetafieldValues, len(values))
+
+	for key, value := range values {
+		if _, _, err := splitMetafieldKey(key); err != nil {
+			return nil, err
+		}
+
+		// null removes a metafield.
+		if value == nil {
+			continue
+		}
+
+		fieldType, ok := types[key]
+		if !ok {
+			return nil, fmt.Errorf("metafield %s has no definition", key)
+		}
+
+		encoded, err := encodeFieldValue(fieldType, value)
+		if err != nil {
+			return nil, fmt.Errorf("metafield %s: %w", key, err)
+		}
+
+		if encoded == "" {
+			continue
+		}
+
+		normalized[key] = decodeFieldValue(fieldType, encoded)
+	}
+
+	return normalized, nil
+}
+
+// managedMetafields keeps the remote values in the namespaces of the local
+// values of a resource. Metafields in other namespaces, such as those of
+// other apps or Shopify's own, are left alone.
+func managedMetafields(remote MetafieldValues, local MetafieldValues) MetafieldValues {
+	namespaces := make(map[string]bool)
+	for key := range local {
+		if namespace, _, err := splitMetafieldKey(key); err == nil {
+			namespaces[namespace] = true
+		}
+	}
+
+	managed := make(MetafieldValues, len(remote))
+	for key, value := range remote {
+		if namespace, _, err := splitMetafieldKey(key); err == nil && namespaces[namespace] {
+			managed[key] = value
+		}
+	}
+
+	return managed
+}
@@ -0,0 +1,212 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+// categoryConstraintKey constrains product metafield definitions to product
+// taxonomy categories.
+const categoryConstraintKey = "category"
+
+const taxonomyCategoryPrefix = gidPrefix + "TaxonomyCategory/"
+
+// MetafieldConstraints limit a definition to subtypes of its owner type, such
+// as the products of some taxonomy categories. Categories are listed by
+// taxonomy ID, e.g. aa-1-13, or by name.
+type MetafieldConstraints struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+func convertMetafieldConstraints(constraints *shopify.Cli_MetafieldDefinitionConstraints) *MetafieldConstraints {
+	if constraints == nil || constraints.Key == "" {
+		return nil
+	}
+
+	c := &MetafieldConstraints{
+		Key:    constraints.Key,
+		Values: make([]string, 0, len(constraints.Values.Nodes)),
+	}
+
+	for _, v := range constraints.Values.Nodes {
+		c.Values = append(c.Values, strings.TrimPrefix(v.Value, taxonomyCategoryPrefix))
+	}
+	sort.Strings(c.Values)
+
+	return c
+}
+
+func newMetafieldConstraintsInput(constraints *MetafieldConstraints) *shopify.MetafieldDefinitionConstraintsInput {
+	if constraints == nil {
+		return nil
+	}
+
+	return &shopify.MetafieldDefinitionConstraintsInput{
+		Key:    constraints.Key,
+		Values: constraintValueIds(constraints),
+	}
+}
+
+// newMetafieldConstraintsUpdatesInput returns the constraint values to add
+// and remove to get from the previous constraints to the new ones.
+func newMetafieldConstraintsUpdatesInput(constraints *MetafieldConstraints, prevConstraints *MetafieldConstraints) *shopify.MetafieldDefinitionConstraintsUpdatesInput {
+	if constraints == nil && prevConstraints == nil {
+		return nil
+	}
+
+	input := &shopify.MetafieldDefinitionConstraintsUpdatesInput{}
+
+	values := make(map[string]bool)
+	if constraints != nil {
+		input.Key = constraints.Key
+		for _, v := range constraintValueIds(constraints) {
+			values[v] = true
+		}
+	}
+
+	prevValues := make(map[string]bool)
+	if prevConstraints != nil {
+		if input.Key == "" {
+			input.Key = prevConstraints.Key
+		}
+		for _, v := range constraintValueIds(prevConstraints) {
+			prevValues[v] = true
+		}
+	}
+
+	for _, v := range constraintValueIds(prevConstraints) {
+		if !values[v] {
+			input.Values = append(input.Values, shopify.MetafieldDefinitionConstraintValueUpdateInput{Delete: v})
+		}
+	}
+
+	for _, v := range constraintValueIds(constraints) {
+		if !prevValues[v] {
+			input.Values = append(input.Values, shopify.MetafieldDefinitionConstraintValueUpdateInput{Create: v})
+		}
+	}
+
+	if len(input.Values) == 0 {
+		return nil
+	}
+
+	return input
+}
+
+// constraintValueIds returns the values as the API expects them, with
+// category IDs written as GIDs.
+func constraintValueIds(constraints *MetafieldConstraints) []string {
+	if constraints == nil {
+		return nil
+	}
+
+	if constraints.Key != categoryConstraintKey {
+		return constraints.Values
+	}
+
+	ids := make([]string, len(constraints.Values))
+	for i, v := range constraints.Values {
+		if strings.HasPrefix(v, gidPrefix) {
+			ids[i] = v
+		} else {
+			ids[i] = taxonomyCategoryPrefix + v
+		}
+	}
+
+	return ids
+}
+
+var taxonomyCategoryId = regexp.MustCompile(`^[a-z]{2}(-[0-9]+)*$`)
+
+// isTaxonomyCategoryId reports whether a category is given by its taxonomy
+// ID, e.g. aa-1-13, rather than its name.
+func isTaxonomyCategoryId(category string) bool {
+	return strings.HasPrefix(category, taxonomyCategoryPrefix) || taxonomyCategoryId.MatchString(category)
+}
+
+// categoryId looks up the taxonomy ID of a category by its name, or by its
+// full name, e.g. Apparel & Accessories > Clothing > Dresses.
+func (ms *MetafieldDefinitionService) categoryId(name string) (string, error) {
+	if id, ok := ms.categories[name]; ok {
+		return id, nil
+	}
+
+	data, err := shopify.SearchTaxonomyCategories(context.Background(), *ms.ShopifyClient, name)
+	if err != nil {
+		return "", fmt.Errorf("looking up category %s: %w", name, err)
+	}
+
+	matches := make([]string, 0)
+	for _, c := range data.Taxonomy.Categories.Nodes {
+		if c.FullName == name {
+			matches = []string{c.Id}
+			break
+		}
+
+		if c.Name == name {
+			matches = append(matches, c.Id)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("category %s not found", name)
+	case 1:
+	default:
+		return "", fmt.Errorf("more than one category is named %s, use its full name or taxonomy ID", name)
+	}
+
+	if ms.categories == nil {
+		ms.categories = make(map[string]string)
+	}
+
+	id := strings.TrimPrefix(matches[0], taxonomyCategoryPrefix)
+	ms.categories[name] = id
+
+	return id, nil
+}
+
+// resolveConstraints returns a copy of the owners with the categories given
+// by name replaced by their taxonomy IDs, so they compare with the store.
+func (ms *MetafieldDefinitionService) resolveConstraints(owners map[string]MetafieldOwnerDefinitions) (map[string]MetafieldOwnerDefinitions, error) {
+	resolved := make(map[string]MetafieldOwnerDefinitions, len(owners))
+
+	for ownerType, owner := range owners {
+		definitions := make(map[string]MetafieldDefinition, len(owner.Definitions))
+
+		for key, d := range owner.Definitions {
+			if d.Constraints != nil {
+				c := &MetafieldConstraints{Key: d.Constraints.Key, Values: make([]string, len(d.Constraints.Values))}
+
+				for i, v := range d.Constraints.Values {
+					if c.Key != categoryConstraintKey || isTaxonomyCategoryId(v) {
+						c.Values[i] = strings.TrimPrefix(v, taxonomyCategoryPrefix)
+						continue
+					}
+
+					id, err := ms.categoryId(v)
+					if err != nil {
+						return nil, fmt.Errorf("metafield definition %s: %w", MetafieldDefinitionKey(ownerType, key), err)
+					}
+
+					c.Values[i] = id
+				}
+				sort.Strings(c.Values)
+
+				d.Constraints = c
+			}
+
+			definitions[key] = d
+		}
+
+		resolved[ownerType] = MetafieldOwnerDefinitions{Pin: owner.Pin, Definitions: definitions}
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,368 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+// MetafieldAccess holds the access settings of a metafield definition which
+// differ from the defaults: read and write access in the admin, read access
+// on the storefront and no access from customer accounts.
+type MetafieldAccess struct {
+	Admin           shopify.MetafieldAdminAccess           `json:"admin,omitempty"`
+	Storefront      shopify.MetafieldStorefrontAccess      `json:"storefront,omitempty"`
+	CustomerAccount shopify.MetafieldCustomerAccountAccess `json:"customerAccount,omitempty"`
+}
+
+// MetafieldCapabilities are the capabilities enabled on a metafield
+// definition.
+type MetafieldCapabilities struct {
+	AdminFilterable          bool `json:"adminFilterable,omitempty"`
+	SmartCollectionCondition bool `json:"smartCollectionCondition,omitempty"`
+	UniqueValues             bool `json:"uniqueValues,omitempty"`
+}
+
+// MetafieldDefinition is a custom metafield definition, or one enabled from
+// a Shopify standard template when Standard holds the template's ID. The
+// type, validations and access of a standard definition come from its
+// template.
+type MetafieldDefinition struct {
+	Standard     string                 `json:"standard,omitempty"`
+	Type         string                 `json:"type,omitempty"`
+	Name         string                 `json:"name,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Validations  map[string]any         `json:"validations,omitempty"`
+	Access       *MetafieldAccess       `json:"access,omitempty"`
+	Capabilities *MetafieldCapabilities `json:"capabilities,omitempty"`
+	Constraints  *MetafieldConstraints  `json:"constraints,omitempty"`
+}
+
+// MetafieldOwnerDefinitions holds the metafield definitions of one owner
+// type, keyed by <namespace>.<key>. Pin lists the pinned definitions in the
+// order the admin shows them. Pins are left alone when Pin is nil.
+type MetafieldOwnerDefinitions struct {
+	Pin         []string                       `json:"pin,omitempty"`
+	Definitions map[string]MetafieldDefinition `json:"definitions"`
+}
+
+// StandardMetafieldTemplate is a Shopify standard metafield definition which
+// can be enabled on the listed owner types.
+type StandardMetafieldTemplate struct {
+	Id          string
+	Key         string
+	Name        string
+	Description string
+	Type        string
+	OwnerTypes  []string
+}
+
+const standardMetafieldTemplatePrefix = gidPrefix + "StandardMetafieldDefinitionTemplate/"
+
+// standardTemplateId shortens the GID of a standard metafield template to
+// the ID used in definition files.
+func standardTemplateId(gid string) string {
+	return strings.TrimPrefix(gid, standardMetafieldTemplatePrefix)
+}
+
+// Capabilities are only available for some field types, and smart collection
+// conditions only for products and variants.
+var (
+	adminFilterableFieldTypes = map[string]bool{
+		"boolean":                     true,
+		"id":                          true,
+		"single_line_text_field":      true,
+		"list.single_line_text_field": true,
+		"collection_reference":        true,
+		"list.collection_reference":   true,
+		"company_reference":           true,
+		"list.company_reference":      true,
+		"metaobject_reference":        true,
+		"list.metaobject_reference":   true,
+		"page_reference":              true,
+		"list.page_reference":         true,
+		"product_reference":           true,
+		"list.product_reference":      true,
+		"variant_reference":           true,
+		"list.variant_reference":      true,
+	}
+	smartCollectionConditionFieldTypes = map[string]bool{
+		"boolean":                   true,
+		"number_decimal":            true,
+		"number_integer":            true,
+		"rating":                    true,
+		"single_line_text_field":    true,
+		"metaobject_reference":      true,
+		"list.metaobject_reference": true,
+	}
+	smartCollectionConditionOwnerTypes = map[shopify.MetafieldOwnerType]bool{
+		shopify.MetafieldOwnerTypeProduct:        true,
+		shopify.MetafieldOwnerTypeProductvariant: true,
+	}
+	uniqueValuesFieldTypes = map[string]bool{
+		"id":                     true,
+		"number_integer":         true,
+		"single_line_text_field": true,
+		"url":                    true,
+	}
+)
+
+// validateMetafieldCapabilities checks that the capabilities of a definition
+// are available for its field type and owner type.
+func validateMetafieldCapabilities(ownerType shopify.MetafieldOwnerType, definition MetafieldDefinition) error {
+	c := definition.Capabilities
+	if c == nil {
+		return nil
+	}
+
+	switch {
+	case c.AdminFilterable && !adminFilterableFieldTypes[definition.Type]:
+		return fmt.Errorf("adminFilterable isn't available for %s fields", definition.Type)
+	case c.SmartCollectionCondition && !smartCollectionConditionOwnerTypes[ownerType]:
+		return fmt.Errorf("smartCollectionCondition is only available on products and variants")
+	case c.SmartCollectionCondition && !smartCollectionConditionFieldTypes[definition.Type]:
+		return fmt.Errorf("smartCollectionCondition isn't available for %s fields", definition.Type)
+	case c.UniqueValues && !uniqueValuesFieldTypes[definition.Type]:
+		return fmt.Errorf("uniqueValues isn't available for %s fields", definition.Type)
+	}
+
+	return nil
+}
+
+// MetafieldDefinitionKey identifies a metafield definition across owner
+// types, e.g. PRODUCT/custom.care_guide.
+func MetafieldDefinitionKey(ownerType string, key string) string {
+	return ownerType + "/" + key
+}
+
+// splitMetafieldKey splits a <namespace>.<key> metafield key.
+func splitMetafieldKey(key string) (namespace string, k string, err error) {
+	namespace, k, ok := strings.Cut(key, ".")
+	if !ok || namespace == "" || k == "" {
+		return "", "", fmt.Errorf("metafield key %s must be <namespace>.<key>", key)
+	}
+
+	return namespace, k, nil
+}
+
+// ParseMetafieldOwnerType checks an owner type name, e.g. PRODUCT or
+// PRODUCTVARIANT, against the owner types of the API.
+func ParseMetafieldOwnerType(ownerType string) (shopify.MetafieldOwnerType, error) {
+	for _, t := range shopify.AllMetafieldOwnerType {
+		if string(t) == strings.ToUpper(ownerType) {
+			return t, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown metafield owner type %s", ownerType)
+}
+
+func convertMetafieldAccess(access shopify.Cli_MetafieldDefinitionAccessMetafieldAccess) (a *MetafieldAccess, empty bool) {
+	a = &MetafieldAccess{}
+	empty = true
+
+	// The admin access input can't set PUBLIC_READ_WRITE, so reverting to
+	// the default sets MERCHANT_READ_WRITE, which is read and write access in
+	// the admin as well.
+	if access.Admin != "" && access.Admin != shopify.MetafieldAdminAccessPublicReadWrite && access.Admin != shopify.MetafieldAdminAccessMerchantReadWrite {
+		a.Admin, empty = access.Admin, false
+	}
+
+	if access.Storefront != "" && access.Storefront != shopify.MetafieldStorefrontAccessPublicRead {
+		a.Storefront, empty = access.Storefront, false
+	}
+
+	if access.CustomerAccount != "" && access.CustomerAccount != shopify.MetafieldCustomerAccountAccessNone {
+		a.CustomerAccount, empty = access.CustomerAccount, false
+	}
+
+	return a, empty
+}
+
+// ConvertMetafieldDefinition converts a store metafield definition, replacing
+// metaobject definition IDs in its validations with their types.
+func ConvertMetafieldDefinition(definition shopify.Cli_MetafieldDefinition, referenceTypes map[string]string) MetafieldDefinition {
+	if definition.StandardTemplate != nil {
+		return MetafieldDefinition{Standard: standardTemplateId(definition.StandardTemplate.Id)}
+	}
+
+	d := MetafieldDefinition{
+		Type:        definition.Type.Name,
+		Name:        definition.Name,
+		Description: definition.Description,
+	}
+
+	if definition.Name == titleCase(definition.Key) {
+		d.Name = ""
+	}
+
+	if len(definition.Validations) > 0 {
+		d.Validations = make(map[string]any, len(definition.Validations))
+
+		for _, v := range definition.Validations {
+			d.Validations[v.Name] = parseValidationValue(v.Value)
+		}
+
+		normalizeReferenceValidations(d.Validations, referenceTypes)
+	}
+
+	if access, empty := convertMetafieldAccess(definition.Access); !empty {
+		d.Access = access
+	}
+
+	capabilities := MetafieldCapabilities{
+		AdminFilterable:          definition.Capabilities.AdminFilterable.Enabled,
+		SmartCollectionCondition: definition.Capabilities.SmartCollectionCondition.Enabled,
+		UniqueValues:             definition.Capabilities.UniqueValues.Enabled,
+	}
+
+	if capabilities != (MetafieldCapabilities{}) {
+		d.Capabilities = &capabilities
+	}
+
+	d.Constraints = convertMetafieldConstraints(definition.Constraints)
+
+	return d
+}
+
+// newMetafieldCapabilityInput returns the input for the capabilities of a
+// definition. Capabilities which are enabled on the previous definition are
+// included, so they are disabled when they are no longer declared.
+func newMetafieldCapabilityInput(capabilities *MetafieldCapabilities, prevCapabilities *MetafieldCapabilities) *shopify.MetafieldCapabilityCreateInput {
+	var c, prev MetafieldCapabilities
+	if capabilities != nil {
+		c = *capabilities
+	}
+	if prevCapabilities != nil {
+		prev = *prevCapabilities
+	}
+
+	input := &shopify.MetafieldCapabilityCreateInput{}
+
+	if c.AdminFilterable || prev.AdminFilterable {
+		input.AdminFilterable = &shopify.MetafieldCapabilityAdminFilterableInput{Enabled: c.AdminFilterable}
+	}
+
+	if c.SmartCollectionCondition || prev.SmartCollectionCondition {
+		input.SmartCollectionCondition = &shopify.MetafieldCapabilitySmartCollectionConditionInput{Enabled: c.SmartCollectionCondition}
+	}
+
+	if c.UniqueValues || prev.UniqueValues {
+		input.UniqueValues = &shopify.MetafieldCapabilityUniqueValuesInput{Enabled: c.UniqueValues}
+	}
+
+	if *input == (shopify.MetafieldCapabilityCreateInput{}) {
+		return nil
+	}
+
+	return input
+}
+
+func newMetafieldAccessInput(access *MetafieldAccess) *shopify.MetafieldAccessInput {
+	input := &shopify.MetafieldAccessInput{
+		Storefront:      shopify.MetafieldStorefrontAccessInputPublicRead,
+		CustomerAccount: shopify.MetafieldCustomerAccountAccessInputNone,
+	}
+
+	if access == nil {
+		return input
+	}
+
+	switch access.Admin {
+	case shopify.MetafieldAdminAccessMerchantRead:
+		input.Admin = shopify.MetafieldAdminAccessInputMerchantRead
+	case shopify.MetafieldAdminAccessMerchantReadWrite:
+		input.Admin = shopify.MetafieldAdminAccessInputMerchantReadWrite
+	}
+
+	if access.Storefront != "" {
+		input.Storefront = shopify.MetafieldStorefrontAccessInput(access.Storefront)
+	}
+
+	if access.CustomerAccount != "" {
+		input.CustomerAccount = shopify.MetafieldCustomerAccountAccessInput(access.CustomerAccount)
+	}
+
+	return input
+}
+
+func NewMetafieldDefinitionCreateInput(ownerType shopify.MetafieldOwnerType, key string, definition MetafieldDefinition, referenceIds map[string]string) (shopify.MetafieldDefinitionInput, error) {
+	namespace, k, err := splitMetafieldKey(key)
+	if err != nil {
+		return shopify.MetafieldDefinitionInput{}, err
+	}
+
+	validations, err := NewMetaobjectFieldValidations(definition.Validations, referenceIds)
+	if err != nil {
+		return shopify.MetafieldDefinitionInput{}, fmt.Errorf("validations of %s: %w", key, err)
+	}
+
+	input := shopify.MetafieldDefinitionInput{
+		Namespace:    namespace,
+		Key:          k,
+		Name:         definition.Name,
+		Description:  definition.Description,
+		OwnerType:    ownerType,
+		Type:         definition.Type,
+		Validations:  validations,
+		Access:       newMetafieldAccessInput(definition.Access),
+		Capabilities: newMetafieldCapabilityInput(definition.Capabilities, nil),
+		Constraints:  newMetafieldConstraintsInput(definition.Constraints),
+	}
+
+	if input.Name == "" {
+		input.Name = titleCase(k)
+	}
+
+	return input, nil
+}
+
+func NewMetafieldDefinitionUpdateInput(ownerType shopify.MetafieldOwnerType, key string, definition MetafieldDefinition, prevDefinition MetafieldDefinition, referenceIds map[string]string) (shopify.MetafieldDefinitionUpdateInput, error) {
+	namespace, k, err := splitMetafieldKey(key)
+	if err != nil {
+		return shopify.MetafieldDefinitionUpdateInput{}, err
+	}
+
+	validations, err := NewMetaobjectFieldValidations(definition.Validations, referenceIds)
+	if err != nil {
+		return shopify.MetafieldDefinitionUpdateInput{}, fmt.Errorf("validations of %s: %w", key, err)
+	}
+
+	access := newMetafieldAccessInput(definition.Access)
+
+	// The admin access is left out of the input by default, which would keep
+	// the access of the previous definition.
+	if access.Admin == "" && prevDefinition.Access != nil && prevDefinition.Access.Admin != "" {
+		access.Admin = shopify.MetafieldAdminAccessInputMerchantReadWrite
+	}
+
+	input := shopify.MetafieldDefinitionUpdateInput{
+		Namespace:   namespace,
+		Key:         k,
+		Name:        definition.Name,
+		Description: definition.Description,
+		OwnerType:   ownerType,
+		Validations: validations,
+		Access: &shopify.MetafieldAccessUpdateInput{
+			Admin:           access.Admin,
+			Storefront:      access.Storefront,
+			CustomerAccount: access.CustomerAccount,
+		},
+		ConstraintsUpdates: newMetafieldConstraintsUpdatesInput(definition.Constraints, prevDefinition.Constraints),
+	}
+
+	if capabilities := newMetafieldCapabilityInput(definition.Capabilities, prevDefinition.Capabilities); capabilities != nil {
+		input.Capabilities = &shopify.MetafieldCapabilityUpdateInput{
+			AdminFilterable:          capabilities.AdminFilterable,
+			SmartCollectionCondition: capabilities.SmartCollectionCondition,
+			UniqueValues:             capabilities.UniqueValues,
+		}
+	}
+
+	if input.Name == "" {
+		input.Name = titleCase(k)
+	}
+
+	return input, nil
+}
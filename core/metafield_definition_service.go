@@ -0,0 +1,553 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hjson/hjson-go/v4"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// appNamespacePrefix starts the namespaces reserved by apps, whose
+// definitions are managed by the app which owns them.
+const appNamespacePrefix = "app--"
+
+// DefaultMetafieldOwnerTypes are the owner types pulled when none are given.
+var DefaultMetafieldOwnerTypes = []string{"PRODUCT", "PRODUCTVARIANT", "COLLECTION", "CUSTOMER"}
+
+type MetafieldDefinitionService struct {
+	ShopifyClient *graphql.Client
+	// AppId resolves the $app: metaobject types referenced by definitions.
+	AppId      string
+	templates  []StandardMetafieldTemplate
+	categories map[string]string
+}
+
+func (ms *MetafieldDefinitionService) listDefinitions(ownerType shopify.MetafieldOwnerType) ([]shopify.Cli_MetafieldDefinition, error) {
+	var definitions []shopify.Cli_MetafieldDefinition
+	cursor := ""
+
+	for {
+		data, err := shopify.ListMetafieldDefinitions(context.Background(), *ms.ShopifyClient, ownerType, 250, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s metafield definitions: %w", ownerType, err)
+		}
+
+		for _, d := range data.MetafieldDefinitions.Nodes {
+			if !strings.HasPrefix(d.Namespace, appNamespacePrefix) {
+				definitions = append(definitions, d)
+			}
+		}
+
+		if !data.MetafieldDefinitions.PageInfo.HasNextPage {
+			return definitions, nil
+		}
+
+		cursor = data.MetafieldDefinitions.PageInfo.EndCursor
+	}
+}
+
+// metaobjectReferenceIds returns the IDs of the store's metaobject
+// definitions by type, and their types by ID.
+func (ms *MetafieldDefinitionService) metaobjectReferenceIds() (ids map[string]string, types map[string]string, err error) {
+	data, err := shopify.ListMetaobjectDefinitions(context.Background(), *ms.ShopifyClient, 250)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing metaobject definitions: %w", err)
+	}
+
+	ids = make(map[string]string, len(data.MetaobjectDefinitions.Nodes))
+	types = make(map[string]string, len(data.MetaobjectDefinitions.Nodes))
+
+	for _, d := range data.MetaobjectDefinitions.Nodes {
+		defType := appLocalType(d.Type, ms.AppId)
+		ids[defType] = d.Id
+		types[d.Id] = defType
+	}
+
+	return ids, types, nil
+}
+
+// remoteDefinitions returns the store definitions of the owner types.
+func (ms *MetafieldDefinitionService) remoteDefinitions(ownerTypes []string) (map[string]MetafieldOwnerDefinitions, error) {
+	_, referenceTypes, err := ms.metaobjectReferenceIds()
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]MetafieldOwnerDefinitions, len(ownerTypes))
+
+	for _, o := range ownerTypes {
+		ownerType, err := ParseMetafieldOwnerType(o)
+		if err != nil {
+			return nil, err
+		}
+
+		definitions, err := ms.listDefinitions(ownerType)
+		if err != nil {
+			return nil, err
+		}
+
+		owner := MetafieldOwnerDefinitions{
+			Definitions: make(map[string]MetafieldDefinition, len(definitions)),
+		}
+
+		pinned := make([]shopify.Cli_MetafieldDefinition, 0)
+
+		for _, d := range definitions {
+			owner.Definitions[d.Namespace+"."+d.Key] = ConvertMetafieldDefinition(d, referenceTypes)
+
+			if d.PinnedPosition != nil {
+				pinned = append(pinned, d)
+			}
+		}
+
+		sort.Slice(pinned, func(i, j int) bool {
+			return *pinned[i].PinnedPosition < *pinned[j].PinnedPosition
+		})
+
+		for _, d := range pinned {
+			owner.Pin = append(owner.Pin, d.Namespace+"."+d.Key)
+		}
+
+		owners[string(ownerType)] = owner
+	}
+
+	return owners, nil
+}
+
+// Pull returns the metafield definitions of the owner types, leaving out
+// owner types without definitions.
+func (ms *MetafieldDefinitionService) Pull(ownerTypes []string) (map[string]MetafieldOwnerDefinitions, error) {
+	owners, err := ms.remoteDefinitions(ownerTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	for ownerType, owner := range owners {
+		if len(owner.Definitions) == 0 {
+			delete(owners, ownerType)
+		}
+	}
+
+	return owners, nil
+}
+
+// ValidateMetafieldDefinitions checks the owner types of the local
+// definitions, and that their capabilities are available for their field
+// and owner types.
+func ValidateMetafieldDefinitions(owners map[string]MetafieldOwnerDefinitions) error {
+	for _, o := range ownerTypes(owners) {
+		ownerType, err := ParseMetafieldOwnerType(o)
+		if err != nil {
+			return err
+		}
+
+		for key, d := range owners[o].Definitions {
+			if err := validateMetafieldCapabilities(ownerType, d); err != nil {
+				return fmt.Errorf("metafield definition %s: %w", MetafieldDefinitionKey(o, key), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ownerTypes(owners map[string]MetafieldOwnerDefinitions) []string {
+	types := make([]string, 0, len(owners))
+	for ownerType := range owners {
+		types = append(types, ownerType)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+func diffMetafieldDefinition(local MetafieldDefinition, remote MetafieldDefinition, exists bool) ([]diffmatchpatch.Diff, error) {
+	localJson, err := hjson.Marshal(local)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteJson := []byte{}
+	if exists {
+		remoteJson, err = hjson.Marshal(remote)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if string(localJson) == string(remoteJson) {
+		return nil, nil
+	}
+
+	dmp := diffmatchpatch.New()
+	return dmp.DiffMain(string(remoteJson), string(localJson), false), nil
+}
+
+// Diff compares the local metafield definitions with the store, keyed by
+// MetafieldDefinitionKey.
+func (ms *MetafieldDefinitionService) Diff(owners map[string]MetafieldOwnerDefinitions) (map[string][]diffmatchpatch.Diff, error) {
+	owners, err := ms.resolveConstraints(owners)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteOwners, err := ms.remoteDefinitions(ownerTypes(owners))
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make(map[string][]diffmatchpatch.Diff)
+
+	for ownerType, owner := range owners {
+		remoteOwner := remoteOwners[strings.ToUpper(ownerType)]
+
+		for key, local := range owner.Definitions {
+			remote, exists := remoteOwner.Definitions[key]
+
+			d, err := diffMetafieldDefinition(local, remote, exists)
+			if err != nil {
+				return nil, fmt.Errorf("marshalling metafield definition %s: %w", MetafieldDefinitionKey(ownerType, key), err)
+			}
+
+			if d != nil {
+				diffs[MetafieldDefinitionKey(ownerType, key)] = d
+			}
+		}
+
+		if owner.Pin != nil {
+			d, err := diffPins(owner.Pin, remoteOwner.Pin)
+			if err != nil {
+				return nil, fmt.Errorf("marshalling pins of %s: %w", ownerType, err)
+			}
+
+			if d != nil {
+				diffs[MetafieldDefinitionKey(ownerType, "pin")] = d
+			}
+		}
+	}
+
+	return diffs, nil
+}
+
+func diffPins(local []string, remote []string) ([]diffmatchpatch.Diff, error) {
+	localJson, err := hjson.Marshal(local)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteJson, err := hjson.Marshal(remote)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(localJson) == string(remoteJson) {
+		return nil, nil
+	}
+
+	dmp := diffmatchpatch.New()
+	return dmp.DiffMain(string(remoteJson), string(localJson), false), nil
+}
+
+// Push creates the local metafield definitions missing from the store and
+// updates the ones which differ. Store definitions without a local
+// counterpart are left alone.
+func (ms *MetafieldDefinitionService) Push(owners map[string]MetafieldOwnerDefinitions) error {
+	if err := ValidateMetafieldDefinitions(owners); err != nil {
+		return err
+	}
+
+	owners, err := ms.resolveConstraints(owners)
+	if err != nil {
+		return err
+	}
+
+	referenceIds, _, err := ms.metaobjectReferenceIds()
+	if err != nil {
+		return err
+	}
+
+	remoteOwners, err := ms.remoteDefinitions(ownerTypes(owners))
+	if err != nil {
+		return err
+	}
+
+	for _, o := range ownerTypes(owners) {
+		ownerType, err := ParseMetafieldOwnerType(o)
+		if err != nil {
+			return err
+		}
+
+		remoteOwner := remoteOwners[string(ownerType)]
+
+		keys := make([]string, 0, len(owners[o].Definitions))
+		for key := range owners[o].Definitions {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			local := owners[o].Definitions[key]
+			name := MetafieldDefinitionKey(string(ownerType), key)
+			remote, exists := remoteOwner.Definitions[key]
+
+			if !exists && local.Standard != "" {
+				if err := ms.enableStandard(ownerType, key, local.Standard); err != nil {
+					return err
+				}
+
+				log.Printf("Enabled standard metafield definition: %s\n", name)
+				continue
+			}
+
+			if !exists {
+				if err := ms.create(ownerType, key, local, referenceIds); err != nil {
+					return err
+				}
+
+				log.Printf("Created metafield definition: %s\n", name)
+				continue
+			}
+
+			if local.Standard != "" {
+				if remote.Standard != local.Standard {
+					return fmt.Errorf("metafield definition %s already exists and wasn't enabled from the standard template %s", name, local.Standard)
+				}
+
+				continue
+			}
+
+			d, err := diffMetafieldDefinition(local, remote, exists)
+			if err != nil {
+				return fmt.Errorf("marshalling metafield definition %s: %w", name, err)
+			}
+
+			if d == nil {
+				continue
+			}
+
+			if remote.Standard != "" {
+				return fmt.Errorf("metafield definition %s was enabled from the standard template %s, declare it with standard: %s", name, remote.Standard, remote.Standard)
+			}
+
+			if local.Type != remote.Type {
+				return fmt.Errorf("metafield definition %s: changing the type from %s to %s isn't supported", name, remote.Type, local.Type)
+			}
+
+			if err := ms.update(ownerType, key, local, remote, referenceIds); err != nil {
+				return err
+			}
+
+			log.Printf("Updated metafield definition: %s\n", name)
+		}
+
+		if owners[o].Pin != nil {
+			if err := ms.pin(ownerType, owners[o], remoteOwner); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// pin converges the pinned definitions of an owner type on the local pin
+// list. Pinning appends a definition to the end of the list, so pins which
+// are in place at the start of the list are kept, the ones after them are
+// unpinned, and the rest of the list is pinned in order.
+func (ms *MetafieldDefinitionService) pin(ownerType shopify.MetafieldOwnerType, local MetafieldOwnerDefinitions, remote MetafieldOwnerDefinitions) error {
+	for _, key := range local.Pin {
+		_, isLocal := local.Definitions[key]
+		_, isRemote := remote.Definitions[key]
+
+		if !isLocal && !isRemote {
+			return fmt.Errorf("pinning %s: no such metafield definition", MetafieldDefinitionKey(string(ownerType), key))
+		}
+	}
+
+	kept := 0
+	for kept < len(local.Pin) && kept < len(remote.Pin) && local.Pin[kept] == remote.Pin[kept] {
+		kept++
+	}
+
+	for _, key := range remote.Pin[kept:] {
+		if err := ms.setPinned(ownerType, key, false); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range local.Pin[kept:] {
+		if err := ms.setPinned(ownerType, key, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ms *MetafieldDefinitionService) setPinned(ownerType shopify.MetafieldOwnerType, key string, pinned bool) error {
+	name := MetafieldDefinitionKey(string(ownerType), key)
+
+	namespace, k, err := splitMetafieldKey(key)
+	if err != nil {
+		return err
+	}
+
+	identifier := shopify.MetafieldDefinitionIdentifierInput{
+		OwnerType: ownerType,
+		Namespace: namespace,
+		Key:       k,
+	}
+
+	if pinned {
+		res, err := shopify.PinMetafieldDefinition(context.Background(), *ms.ShopifyClient, identifier)
+		if err != nil {
+			return fmt.Errorf("pinning metafield definition %s: %w", name, err)
+		}
+
+		if len(res.MetafieldDefinitionPin.UserErrors) > 0 {
+			return fmt.Errorf("pinning metafield definition %s: %v", name, res.MetafieldDefinitionPin.UserErrors)
+		}
+
+		log.Printf("Pinned metafield definition: %s\n", name)
+
+		return nil
+	}
+
+	res, err := shopify.UnpinMetafieldDefinition(context.Background(), *ms.ShopifyClient, identifier)
+	if err != nil {
+		return fmt.Errorf("unpinning metafield definition %s: %w", name, err)
+	}
+
+	if len(res.MetafieldDefinitionUnpin.UserErrors) > 0 {
+		return fmt.Errorf("unpinning metafield definition %s: %v", name, res.MetafieldDefinitionUnpin.UserErrors)
+	}
+
+	log.Printf("Unpinned metafield definition: %s\n", name)
+
+	return nil
+}
+
+// StandardTemplates lists the standard metafield definitions Shopify offers.
+func (ms *MetafieldDefinitionService) StandardTemplates() ([]StandardMetafieldTemplate, error) {
+	templates := make([]StandardMetafieldTemplate, 0)
+	cursor := ""
+
+	for {
+		data, err := shopify.ListStandardMetafieldDefinitionTemplates(context.Background(), *ms.ShopifyClient, 250, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("listing standard metafield definitions: %w", err)
+		}
+
+		for _, t := range data.StandardMetafieldDefinitionTemplates.Nodes {
+			ownerTypes := make([]string, len(t.OwnerTypes))
+			for i, o := range t.OwnerTypes {
+				ownerTypes[i] = string(o)
+			}
+
+			templates = append(templates, StandardMetafieldTemplate{
+				Id:          standardTemplateId(t.Id),
+				Key:         t.Namespace + "." + t.Key,
+				Name:        t.Name,
+				Description: t.Description,
+				Type:        t.Type.Name,
+				OwnerTypes:  ownerTypes,
+			})
+		}
+
+		if !data.StandardMetafieldDefinitionTemplates.PageInfo.HasNextPage {
+			return templates, nil
+		}
+
+		cursor = data.StandardMetafieldDefinitionTemplates.PageInfo.EndCursor
+	}
+}
+
+// enableStandard enables a standard metafield definition on an owner type.
+// The definition takes the namespace and key of its template, so the local
+// definition must be keyed by them.
+func (ms *MetafieldDefinitionService) enableStandard(ownerType shopify.MetafieldOwnerType, key string, templateId string) error {
+	name := MetafieldDefinitionKey(string(ownerType), key)
+
+	if ms.templates == nil {
+		templates, err := ms.StandardTemplates()
+		if err != nil {
+			return err
+		}
+
+		ms.templates = templates
+	}
+
+	var template *StandardMetafieldTemplate
+	for i, t := range ms.templates {
+		if t.Id == templateId {
+			template = &ms.templates[i]
+			break
+		}
+	}
+
+	switch {
+	case template == nil:
+		return fmt.Errorf("enabling metafield definition %s: standard template %s not found", name, templateId)
+	case template.Key != key:
+		return fmt.Errorf("enabling metafield definition %s: the standard template %s enables %s", name, templateId, template.Key)
+	case !contains(template.OwnerTypes, string(ownerType)):
+		return fmt.Errorf("enabling metafield definition %s: the standard template %s can't be enabled on %s", name, templateId, ownerType)
+	}
+
+	res, err := shopify.EnableStandardMetafieldDefinition(context.Background(), *ms.ShopifyClient, ownerType, standardMetafieldTemplatePrefix+templateId)
+	if err != nil {
+		return fmt.Errorf("enabling metafield definition %s: %w", name, err)
+	}
+
+	if len(res.StandardMetafieldDefinitionEnable.UserErrors) > 0 {
+		return fmt.Errorf("enabling metafield definition %s: %v", name, res.StandardMetafieldDefinitionEnable.UserErrors)
+	}
+
+	return nil
+}
+
+func (ms *MetafieldDefinitionService) create(ownerType shopify.MetafieldOwnerType, key string, definition MetafieldDefinition, referenceIds map[string]string) error {
+	name := MetafieldDefinitionKey(string(ownerType), key)
+
+	input, err := NewMetafieldDefinitionCreateInput(ownerType, key, definition, referenceIds)
+	if err != nil {
+		return fmt.Errorf("creating metafield definition %s: %w", name, err)
+	}
+
+	res, err := shopify.CreateMetafieldDefinition(context.Background(), *ms.ShopifyClient, input)
+	if err != nil {
+		return fmt.Errorf("creating metafield definition %s: %w", name, err)
+	}
+
+	if len(res.MetafieldDefinitionCreate.UserErrors) > 0 {
+		return fmt.Errorf("creating metafield definition %s: %v", name, res.MetafieldDefinitionCreate.UserErrors)
+	}
+
+	return nil
+}
+
+func (ms *MetafieldDefinitionService) update(ownerType shopify.MetafieldOwnerType, key string, definition MetafieldDefinition, prevDefinition MetafieldDefinition, referenceIds map[string]string) error {
+	name := MetafieldDefinitionKey(string(ownerType), key)
+
+	input, err := NewMetafieldDefinitionUpdateInput(ownerType, key, definition, prevDefinition, referenceIds)
+	if err != nil {
+		return fmt.Errorf("updating metafield definition %s: %w", name, err)
+	}
+
+	res, err := shopify.UpdateMetafieldDefinition(context.Background(), *ms.ShopifyClient, input)
+	if err != nil {
+		return fmt.Errorf("updating metafield definition %s: %w", name, err)
+	}
+
+	if len(res.MetafieldDefinitionUpdate.UserErrors) > 0 {
+		return fmt.Errorf("updating metafield definition %s: %v", name, res.MetafieldDefinitionUpdate.UserErrors)
+	}
+
+	return nil
+}
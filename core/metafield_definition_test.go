@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+func TestMetafieldDefinitionUpdateRevertsAdminAccess(t *testing.T) {
+	definition := MetafieldDefinition{Type: "single_line_text_field"}
+	prev := MetafieldDefinition{Type: "single_line_text_field", Access: &MetafieldAccess{Admin: shopify.MetafieldAdminAccessMerchantRead}}
+
+	input, err := NewMetafieldDefinitionUpdateInput(shopify.MetafieldOwnerTypeProduct, "custom.care_guide", definition, prev, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input.Access.Admin != shopify.MetafieldAdminAccessInputMerchantReadWrite {
+		t.Errorf("admin access = %q, want the default to be sent", input.Access.Admin)
+	}
+
+	input, err = NewMetafieldDefinitionUpdateInput(shopify.MetafieldOwnerTypeProduct, "custom.care_guide", definition, definition, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input.Access.Admin != "" {
+		t.Errorf("admin access = %q, want it left out", input.Access.Admin)
+	}
+}
+
+func TestConvertMetafieldAccessDefaults(t *testing.T) {
+	for _, admin := range []shopify.MetafieldAdminAccess{shopify.MetafieldAdminAccessPublicReadWrite, shopify.MetafieldAdminAccessMerchantReadWrite} {
+		_, empty := convertMetafieldAccess(shopify.Cli_MetafieldDefinitionAccessMetafieldAccess{
+			Admin:           admin,
+			Storefront:      shopify.MetafieldStorefrontAccessPublicRead,
+			CustomerAccount: shopify.MetafieldCustomerAccountAccessNone,
+		})
+		if !empty {
+			t.Errorf("admin access %s isn't the default", admin)
+		}
+	}
+
+	a, empty := convertMetafieldAccess(shopify.Cli_MetafieldDefinitionAccessMetafieldAccess{Admin: shopify.MetafieldAdminAccessMerchantRead})
+	if empty || a.Admin != shopify.MetafieldAdminAccessMerchantRead {
+		t.Errorf("access = %+v", a)
+	}
+}
@@ -0,0 +1,492 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hjson/hjson-go/v4"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// metafieldsSetLimit is the most metafields metafieldsSet accepts per call.
+const metafieldsSetLimit = 25
+
+type MetafieldService struct {
+	ShopifyClient *graphql.Client
+	references    *ReferenceResolver
+}
+
+// metafieldResource is a product or collection with its metafields.
+type metafieldResource struct {
+	Id         string
+	Handle     string
+	Metafields []shopify.Cli_Metafield
+}
+
+// MetafieldChange holds the local metafield values of a resource next to its
+// remote values. Local and Remote are normalized so they can be marshalled and
+// compared, Values are the values as read from the file. Remote only holds the
+// namespaces which are in the file for the resource.
+type MetafieldChange struct {
+	OwnerType string
+	Handle    string
+	OwnerId   string
+	Values    MetafieldValues
+	Local     MetafieldValues
+	Remote    MetafieldValues
+	Types     map[string]string
+}
+
+func (ms *MetafieldService) resolver() *ReferenceResolver {
+	if ms.references == nil {
+		ms.references = &ReferenceResolver{ShopifyClient: ms.ShopifyClient}
+	}
+
+	return ms.references
+}
+
+// listResources lists the resources of an owner type with all of their
+// metafields.
+func (ms *MetafieldService) listResources(ownerType shopify.MetafieldOwnerType) ([]metafieldResource, error) {
+	var resources []metafieldResource
+	ctx := context.Background()
+	cursor := ""
+
+	for {
+		var page []metafieldResource
+		var pageInfo shopify.Cli_MetafieldPagePageInfo
+
+		switch ownerType {
+		case shopify.MetafieldOwnerTypeProduct:
+			data, err := shopify.ListProductMetafields(ctx, *ms.ShopifyClient, 25, cursor)
+			if err != nil {
+				return nil, fmt.Errorf("listing product metafields: %w", err)
+			}
+
+			for _, p := range data.Products.Nodes {
+				r := metafieldResource{Id: p.Id, Handle: p.Handle, Metafields: p.Metafields.Nodes}
+				if err := ms.listRemainingMetafields(ownerType, &r, p.Metafields.PageInfo); err != nil {
+					return nil, err
+				}
+				page = append(page, r)
+			}
+			pageInfo = shopify.Cli_MetafieldPagePageInfo(data.Products.PageInfo)
+
+		case shopify.MetafieldOwnerTypeCollection:
+			data, err := shopify.ListCollectionMetafields(ctx, *ms.ShopifyClient, 25, cursor)
+			if err != nil {
+				return nil, fmt.Errorf("listing collection metafields: %w", err)
+			}
+
+			for _, c := range data.Collections.Nodes {
+				r := metafieldResource{Id: c.Id, Handle: c.Handle, Metafields: c.Metafields.Nodes}
+				if err := ms.listRemainingMetafields(ownerType, &r, c.Metafields.PageInfo); err != nil {
+					return nil, err
+				}
+				page = append(page, r)
+			}
+			pageInfo = shopify.Cli_MetafieldPagePageInfo(data.Collections.PageInfo)
+		}
+
+		resources = append(resources, page...)
+
+		if !pageInfo.HasNextPage {
+			return resources, nil
+		}
+
+		cursor = pageInfo.EndCursor
+	}
+}
+
+// listRemainingMetafields adds the metafields of a resource which didn't fit
+// on the first page.
+func (ms *MetafieldService) listRemainingMetafields(ownerType shopify.MetafieldOwnerType, r *metafieldResource, pageInfo shopify.Cli_MetafieldPagePageInfo) error {
+	ctx := context.Background()
+
+	for pageInfo.HasNextPage {
+		var page shopify.Cli_MetafieldPage
+
+		switch ownerType {
+		case shopify.MetafieldOwnerTypeProduct:
+			data, err := shopify.GetProductMetafields(ctx, *ms.ShopifyClient, r.Id, pageInfo.EndCursor)
+			if err != nil {
+				return fmt.Errorf("listing metafields of product %s: %w", r.Handle, err)
+			}
+			page = data.Product.Metafields
+
+		case shopify.MetafieldOwnerTypeCollection:
+			data, err := shopify.GetCollectionMetafields(ctx, *ms.ShopifyClient, r.Id, pageInfo.EndCursor)
+			if err != nil {
+				return fmt.Errorf("listing metafields of collection %s: %w", r.Handle, err)
+			}
+			page = data.Collection.Metafields
+		}
+
+		r.Metafields = append(r.Metafields, page.Nodes...)
+		pageInfo = page.PageInfo
+	}
+
+	return nil
+}
+
+// metafieldReferences looks up the resources referenced by the reference
+// metafields of the resources, see lookupReferences.
+func metafieldReferences(client graphql.Client, resources []metafieldResource) (map[string]string, error) {
+	seen := make(map[string]bool)
+	ids := make([]string, 0)
+
+	for _, r := range resources {
+		for _, m := range r.Metafields {
+			if !isReferenceFieldType(m.Type) {
+				continue
+			}
+
+			for _, id := range referenceIds(m.Type, m.Value) {
+				if !seen[id] {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+
+	return lookupReferences(client, ids)
+}
+
+// convertResource decodes the metafields of a resource, replacing referenced
+// GIDs with the stable keys of the looked up references. References which
+// can't be resolved keep their GID.
+func (ms *MetafieldService) convertResource(r metafieldResource, references map[string]string) (MetafieldValues, map[string]string) {
+	values, types := convertMetafields(r.Metafields)
+
+	for key, value := range values {
+		if !isReferenceFieldType(types[key]) {
+			continue
+		}
+
+		keys := make(map[string]string)
+		mapped, err := mapReferences(value, func(id string) (string, error) {
+			if k := references[id]; k != "" {
+				keys[id] = k
+				return k, nil
+			}
+			return id, nil
+		})
+		if err != nil {
+			continue
+		}
+
+		ms.resolver().learnKeys(types[key], keys)
+		values[key] = mapped
+	}
+
+	return values, types
+}
+
+func (ms *MetafieldService) Pull(ownerTypes []string) (map[string]map[string]MetafieldValues, error) {
+	owners := make(map[string]map[string]MetafieldValues, len(ownerTypes))
+
+	for _, o := range ownerTypes {
+		ownerType, err := parseMetafieldValueOwnerType(o)
+		if err != nil {
+			return nil, err
+		}
+
+		resources, err := ms.listResources(ownerType)
+		if err != nil {
+			return nil, err
+		}
+
+		references, err := metafieldReferences(*ms.ShopifyClient, resources)
+		if err != nil {
+			return nil, err
+		}
+
+		owner := make(map[string]MetafieldValues)
+		for _, r := range resources {
+			values, _ := ms.convertResource(r, references)
+			if len(values) > 0 {
+				owner[r.Handle] = values
+			}
+		}
+
+		owners[string(ownerType)] = owner
+	}
+
+	return owners, nil
+}
+
+// definitionTypes returns the types of the metafield definitions of an owner
+// type by <namespace>.<key>.
+func (ms *MetafieldService) definitionTypes(ownerType shopify.MetafieldOwnerType) (map[string]string, error) {
+	ds := &MetafieldDefinitionService{ShopifyClient: ms.ShopifyClient}
+
+	definitions, err := ds.listDefinitions(ownerType)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string]string, len(definitions))
+	for _, d := range definitions {
+		types[d.Namespace+"."+d.Key] = d.Type.Name
+	}
+
+	return types, nil
+}
+
+func (ms *MetafieldService) compare(owners map[string]map[string]MetafieldValues) ([]MetafieldChange, error) {
+	comparisons := make([]MetafieldChange, 0)
+
+	for o, resources := range owners {
+		ownerType, err := parseMetafieldValueOwnerType(o)
+		if err != nil {
+			return nil, err
+		}
+
+		definitionTypes, err := ms.definitionTypes(ownerType)
+		if err != nil {
+			return nil, err
+		}
+
+		remoteResources, err := ms.listResources(ownerType)
+		if err != nil {
+			return nil, err
+		}
+
+		references, err := metafieldReferences(*ms.ShopifyClient, remoteResources)
+		if err != nil {
+			return nil, err
+		}
+
+		byHandle := make(map[string]metafieldResource, len(remoteResources))
+		for _, r := range remoteResources {
+			byHandle[r.Handle] = r
+		}
+
+		for handle, values := range resources {
+			key := MetafieldResourceKey(string(ownerType), handle)
+
+			r, ok := byHandle[handle]
+			if !ok {
+				return nil, fmt.Errorf("%s not found", key)
+			}
+
+			remote, types := ms.convertResource(r, references)
+
+			// Metafields without a definition keep the type they were
+			// created with.
+			for k, t := range definitionTypes {
+				types[k] = t
+			}
+
+			local, err := normalizeMetafieldValues(values, types)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+
+			comparisons = append(comparisons, MetafieldChange{
+				OwnerType: string(ownerType),
+				Handle:    handle,
+				OwnerId:   r.Id,
+				Values:    values,
+				Local:     local,
+				Remote:    managedMetafields(remote, values),
+				Types:     types,
+			})
+		}
+	}
+
+	sort.Slice(comparisons, func(i, j int) bool {
+		return MetafieldResourceKey(comparisons[i].OwnerType, comparisons[i].Handle) < MetafieldResourceKey(comparisons[j].OwnerType, comparisons[j].Handle)
+	})
+
+	return comparisons, nil
+}
+
+func (c MetafieldChange) diff() ([]diffmatchpatch.Diff, error) {
+	localJson, err := hjson.Marshal(c.Local)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling local metafields of %s: %w", MetafieldResourceKey(c.OwnerType, c.Handle), err)
+	}
+
+	remoteJson, err := hjson.Marshal(c.Remote)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling remote metafields of %s: %w", MetafieldResourceKey(c.OwnerType, c.Handle), err)
+	}
+
+	if string(localJson) == string(remoteJson) {
+		return nil, nil
+	}
+
+	dmp := diffmatchpatch.New()
+	return dmp.DiffMain(string(remoteJson), string(localJson), false), nil
+}
+
+func (ms *MetafieldService) Diff(owners map[string]map[string]MetafieldValues) (map[string][]diffmatchpatch.Diff, error) {
+	comparisons, err := ms.compare(owners)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make(map[string][]diffmatchpatch.Diff)
+
+	for _, c := range comparisons {
+		d, err := c.diff()
+		if err != nil {
+			return nil, err
+		}
+
+		if d != nil {
+			diffs[MetafieldResourceKey(c.OwnerType, c.Handle)] = d
+		}
+	}
+
+	return diffs, nil
+}
+
+// sameValue reports whether two decoded values are equal.
+func sameValue(a any, b any) bool {
+	aJson, errA := json.Marshal(a)
+	bJson, errB := json.Marshal(b)
+
+	return errA == nil && errB == nil && string(aJson) == string(bJson)
+}
+
+// inputs returns the metafields to set and delete to bring a resource in line
+// with its local values. Metafields missing locally are deleted from the
+// namespaces the file manages.
+func (ms *MetafieldService) inputs(c MetafieldChange) ([]shopify.MetafieldsSetInput, []shopify.MetafieldIdentifierInput, error) {
+	key := MetafieldResourceKey(c.OwnerType, c.Handle)
+	sets := make([]shopify.MetafieldsSetInput, 0)
+	deletes := make([]shopify.MetafieldIdentifierInput, 0)
+
+	keys := make([]string, 0, len(c.Values))
+	for k := range c.Values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		local, ok := c.Local[k]
+		if !ok || sameValue(local, c.Remote[k]) {
+			continue
+		}
+
+		fieldType := c.Types[k]
+		value := c.Values[k]
+
+		if isReferenceFieldType(fieldType) {
+			resolved, err := mapReferences(value, func(ref string) (string, error) {
+				return ms.resolver().ResolveId(fieldType, ref)
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s metafield %s: %w", key, k, err)
+			}
+			value = resolved
+		}
+
+		encoded, err := encodeFieldValue(fieldType, value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s metafield %s: %w", key, k, err)
+		}
+
+		namespace, mk, _ := splitMetafieldKey(k)
+		sets = append(sets, shopify.MetafieldsSetInput{
+			OwnerId:   c.OwnerId,
+			Namespace: namespace,
+			Key:       mk,
+			Value:     encoded,
+			Type:      fieldType,
+		})
+	}
+
+	for k := range c.Remote {
+		if _, ok := c.Local[k]; ok {
+			continue
+		}
+
+		namespace, mk, _ := splitMetafieldKey(k)
+		deletes = append(deletes, shopify.MetafieldIdentifierInput{
+			OwnerId:   c.OwnerId,
+			Namespace: namespace,
+			Key:       mk,
+		})
+	}
+
+	return sets, deletes, nil
+}
+
+func (ms *MetafieldService) Push(owners map[string]map[string]MetafieldValues) error {
+	comparisons, err := ms.compare(owners)
+	if err != nil {
+		return err
+	}
+
+	sets := make([]shopify.MetafieldsSetInput, 0)
+	deletes := make([]shopify.MetafieldIdentifierInput, 0)
+	resourceKeys := make(map[string]string, len(comparisons))
+
+	for _, c := range comparisons {
+		resourceKeys[c.OwnerId] = MetafieldResourceKey(c.OwnerType, c.Handle)
+
+		s, d, err := ms.inputs(c)
+		if err != nil {
+			return err
+		}
+
+		sets = append(sets, s...)
+		deletes = append(deletes, d...)
+	}
+
+	failed := 0
+
+	for start := 0; start < len(sets); start += metafieldsSetLimit {
+		batch := sets[start:min(start+metafieldsSetLimit, len(sets))]
+
+		res, err := shopify.SetMetafields(context.Background(), *ms.ShopifyClient, batch)
+		if err != nil {
+			return fmt.Errorf("setting metafields: %w", err)
+		}
+
+		for _, e := range res.MetafieldsSet.UserErrors {
+			m := batch[e.ElementIndex]
+			log.Printf("Error setting metafield %s.%s of %s: %s\n", m.Namespace, m.Key, resourceKeys[m.OwnerId], e.Message)
+		}
+
+		// metafieldsSet is atomic, so an error fails the whole batch.
+		if len(res.MetafieldsSet.UserErrors) > 0 {
+			failed += len(batch)
+			continue
+		}
+
+		log.Printf("Set %d metafields\n", len(batch))
+	}
+
+	for start := 0; start < len(deletes); start += metafieldsSetLimit {
+		batch := deletes[start:min(start+metafieldsSetLimit, len(deletes))]
+
+		res, err := shopify.DeleteMetafields(context.Background(), *ms.ShopifyClient, batch)
+		if err != nil {
+			return fmt.Errorf("deleting metafields: %w", err)
+		}
+
+		if len(res.MetafieldsDelete.UserErrors) > 0 {
+			log.Printf("Error deleting metafields: %v\n", res.MetafieldsDelete.UserErrors)
+			failed += len(batch)
+			continue
+		}
+
+		log.Printf("Deleted %d metafields\n", len(batch))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d metafield changes failed", failed, len(sets)+len(deletes))
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestManagedMetafields(t *testing.T) {
+	remote := MetafieldValues{
+		"custom.care_guide":         "Machine wash cold",
+		"custom.material":           "Cotton",
+		"shopify.color-pattern":     []any{"gid://shopify/Metaobject/1"},
+		"reviews.rating":            4.5,
+		"descriptors.subtitle":      "Soft",
+		"custom.removed_explicitly": "x",
+	}
+	local := MetafieldValues{
+		"custom.care_guide":         "Hand wash",
+		"custom.removed_explicitly": nil,
+		"descriptors.subtitle":      "Softer",
+	}
+
+	want := MetafieldValues{
+		"custom.care_guide":         "Machine wash cold",
+		"custom.material":           "Cotton",
+		"descriptors.subtitle":      "Soft",
+		"custom.removed_explicitly": "x",
+	}
+
+	if got := managedMetafields(remote, local); !reflect.DeepEqual(got, want) {
+		t.Errorf("managedMetafields = %v, want %v", got, want)
+	}
+}
+
+func TestNormalizeMetafieldValuesSkipsNull(t *testing.T) {
+	values := MetafieldValues{"custom.care_guide": nil, "custom.material": "Cotton"}
+	types := map[string]string{"custom.material": "single_line_text_field"}
+
+	got, err := normalizeMetafieldValues(values, types)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (MetafieldValues{"custom.material": "Cotton"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("normalizeMetafieldValues = %v, want %v", got, want)
+	}
+}
@@ -2,8 +2,7 @@ package core
 
 import (
 	"encoding/json"
-	"errors"
-	"log"
+	"fmt"
 	"strings"
 
 	"github.com/JohnnyMcGee/metadef/shopify"
@@ -41,30 +40,84 @@ type Access struct {
 	Storefront shopify.MetaobjectStorefrontAccess `json:"storefront,omitempty"`
 }
 
+// MetaobjectDefinition is a custom metaobject type, or one enabled from a
+// Shopify standard template when Standard names the template's type. The
+// fields of a standard definition come from its template.
 type MetaobjectDefinition struct {
+	Standard         string                     `json:"standard,omitempty"`
 	Name             string                     `json:"name,omitempty"`
 	Description      string                     `json:"description,omitempty"`
 	Access           *Access                    `json:"access,omitempty"`
 	Capabilities     *Capabilities              `json:"capabilities,omitempty"`
 	DisplayNameKey   string                     `json:"displayNameKey,omitempty"`
-	FieldDefinitions map[string]FieldDefinition `json:"fieldDefinitions"`
+	FieldDefinitions map[string]FieldDefinition `json:"fieldDefinitions,omitempty"`
 }
 
-func convertAccess(access shopify.Cli_MetaobjectDefinitionAccessMetaobjectAccess) (a *Access, empty bool) {
+// defaultAccess returns the access of a type which isn't written to files.
+// Merchants have full access to their own types, which are readable on the
+// storefront. Types owned by an app are only writable by merchants, and
+// hidden from the storefront.
+func defaultAccess(defType string) (shopify.MetaobjectAdminAccess, shopify.MetaobjectStorefrontAccess) {
+	if isAppType(defType) {
+		return shopify.MetaobjectAdminAccessMerchantReadWrite, shopify.MetaobjectStorefrontAccessNone
+	}
+
+	return shopify.MetaobjectAdminAccessPublicReadWrite, shopify.MetaobjectStorefrontAccessPublicRead
+}
+
+func convertAccess(defType string, access shopify.Cli_MetaobjectDefinitionAccessMetaobjectAccess) (a *Access, empty bool) {
 	a = &Access{}
 	empty = true
 
-	if access.Admin != shopify.MetaobjectAdminAccessPublicReadWrite {
+	admin, storefront := defaultAccess(defType)
+
+	// The API still reports the legacy admin access of app types, which the
+	// app manages and the merchant access input can't set.
+	if isAppType(defType) && !isMerchantAdminAccess(access.Admin) {
+		access.Admin = admin
+	}
+
+	if access.Admin != admin {
 		a.Admin, empty = access.Admin, false
 	}
 
-	if access.Storefront != shopify.MetaobjectStorefrontAccessPublicRead {
+	if access.Storefront != storefront {
 		a.Storefront, empty = access.Storefront, false
 	}
 
 	return a, empty
 }
 
+func isMerchantAdminAccess(access shopify.MetaobjectAdminAccess) bool {
+	return access == shopify.MetaobjectAdminAccessMerchantRead || access == shopify.MetaobjectAdminAccessMerchantReadWrite
+}
+
+// newAccessInput returns the access input of a definition, filling in the
+// defaults of its type.
+func newAccessInput(defType string, access *Access) shopify.CustomMetaobjectAccessInput {
+	admin, storefront := defaultAccess(defType)
+	if access != nil {
+		if access.Admin != "" {
+			admin = access.Admin
+		}
+
+		if access.Storefront != "" {
+			storefront = access.Storefront
+		}
+	}
+
+	input := shopify.CustomMetaobjectAccessInput{Storefront: storefront}
+
+	switch admin {
+	case shopify.MetaobjectAdminAccessMerchantRead:
+		input.Admin = shopify.MetaobjectAdminAccessInputMerchantRead
+	case shopify.MetaobjectAdminAccessMerchantReadWrite:
+		input.Admin = shopify.MetaobjectAdminAccessInputMerchantReadWrite
+	}
+
+	return input
+}
+
 func convertFieldDefinition(definition shopify.Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) FieldDefinition {
 	f := FieldDefinition{
 		Type:        definition.Type.Name,
@@ -81,14 +134,7 @@ func convertFieldDefinition(definition shopify.Cli_MetaobjectDefinitionFieldDefi
 		validations := make(map[string]any, len(definition.Validations))
 
 		for _, v := range definition.Validations {
-			b := []byte(v.Value)
-			var value any
-
-			if err := json.Unmarshal(b, &value); err != nil {
-				value = v.Value
-			}
-
-			validations[v.Name] = value
+			validations[v.Name] = parseValidationValue(v.Value)
 		}
 
 		f.Validations = validations
@@ -97,6 +143,18 @@ func convertFieldDefinition(definition shopify.Cli_MetaobjectDefinitionFieldDefi
 	return f
 }
 
+// parseValidationValue decodes a JSON encoded validation value, keeping
+// values which aren't JSON as plain strings.
+func parseValidationValue(s string) any {
+	var value any
+
+	if err := json.Unmarshal([]byte(s), &value); err != nil {
+		return s
+	}
+
+	return value
+}
+
 func convertCapabilities(capabilities shopify.Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities) (cap *Capabilities, empty bool) {
 	cap = &Capabilities{}
 	empty = true
@@ -132,6 +190,22 @@ func titleCase(s string) string {
 }
 
 func ConvertMetaobjectDefinition(definition shopify.Cli_MetaobjectDefinition) MetaobjectDefinition {
+	// The name, description and fields of a standard definition come from
+	// its template, so the marker stands in for them.
+	if definition.StandardTemplate != nil {
+		d := MetaobjectDefinition{Standard: definition.StandardTemplate.Type}
+
+		if cap, empty := convertCapabilities(definition.Capabilities); !empty {
+			d.Capabilities = cap
+		}
+
+		if access, empty := convertAccess(definition.Type, definition.Access); !empty {
+			d.Access = access
+		}
+
+		return d
+	}
+
 	d := MetaobjectDefinition{
 		Name:             definition.Name,
 		Description:      definition.Description,
@@ -139,7 +213,7 @@ func ConvertMetaobjectDefinition(definition shopify.Cli_MetaobjectDefinition) Me
 		FieldDefinitions: make(map[string]FieldDefinition, len(definition.FieldDefinitions)),
 	}
 
-	if definition.Name == titleCase(definition.Type) {
+	if definition.Name == titleCase(strings.TrimPrefix(definition.Type, appTypePrefix)) {
 		d.Name = ""
 	}
 
@@ -162,7 +236,7 @@ func ConvertMetaobjectDefinition(definition shopify.Cli_MetaobjectDefinition) Me
 		d.Capabilities = cap
 	}
 
-	if access, empty := convertAccess(definition.Access); !empty {
+	if access, empty := convertAccess(definition.Type, definition.Access); !empty {
 		d.Access = access
 	}
 
@@ -184,30 +258,74 @@ func CreateMetaobjectDefinitionMap(definitions []shopify.Cli_MetaobjectDefinitio
 	// across different stores and environments.
 	for _, d := range definitionMap {
 		for _, f := range d.FieldDefinitions {
-			if id, ok := f.Validations["metaobject_definition_id"]; ok {
-				if defType, ok := referenceTypes[id.(string)]; ok {
-					f.Validations["metaobject_definition"] = defType
-					delete(f.Validations, "metaobject_definition_id")
-				}
-			}
+			normalizeReferenceValidations(f.Validations, referenceTypes)
+		}
+	}
+
+	return definitionMap
+}
 
-			if idsValue, ok := f.Validations["metaobject_definition_ids"]; ok {
-				ids := idsValue.([]any)
+// normalizeReferenceValidations replaces the metaobject definition IDs in
+// reference validations with the types they belong to.
+func normalizeReferenceValidations(validations map[string]any, referenceTypes map[string]string) {
+	if id, ok := validations["metaobject_definition_id"]; ok {
+		if defType, ok := referenceTypes[id.(string)]; ok {
+			validations["metaobject_definition"] = defType
+			delete(validations, "metaobject_definition_id")
+		}
+	}
 
-				defTypes := make([]string, len(ids))
-				for i, id := range ids {
-					if defType, ok := referenceTypes[id.(string)]; ok {
-						defTypes[i] = defType
-					}
-				}
+	if idsValue, ok := validations["metaobject_definition_ids"]; ok {
+		ids := idsValue.([]any)
 
-				f.Validations["metaobject_definitions"] = defTypes
-				delete(f.Validations, "metaobject_definition_ids")
+		// Lists are stored as []any, like lists read from definition files.
+		defTypes := make([]any, len(ids))
+		for i, id := range ids {
+			defTypes[i] = id
+			if defType, ok := referenceTypes[id.(string)]; ok {
+				defTypes[i] = defType
 			}
 		}
+
+		validations["metaobject_definitions"] = defTypes
+		delete(validations, "metaobject_definition_ids")
 	}
+}
 
-	return definitionMap
+// typeList reads a list of types, as read from a file or pulled.
+func typeList(v any) ([]string, bool) {
+	switch list := v.(type) {
+	case []string:
+		return list, true
+	case []any:
+		types := make([]string, len(list))
+		for i, item := range list {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			types[i] = s
+		}
+		return types, true
+	}
+
+	return nil, false
+}
+
+// referencedTypes returns the metaobject types the reference validations of a
+// field point at.
+func referencedTypes(validations map[string]any) []string {
+	types := make([]string, 0)
+
+	if defType, ok := validations["metaobject_definition"].(string); ok {
+		types = append(types, defType)
+	}
+
+	if defTypes, ok := typeList(validations["metaobject_definitions"]); ok {
+		types = append(types, defTypes...)
+	}
+
+	return types
 }
 
 func NewMetaobjectFieldValidations(validations map[string]any, referenceIds map[string]string) ([]shopify.MetafieldDefinitionValidationInput, error) {
@@ -222,8 +340,7 @@ func NewMetaobjectFieldValidations(validations map[string]any, referenceIds map[
 		if k == "metaobject_definition" {
 			id, ok := referenceIds[v.(string)]
 			if !ok {
-				log.Fatalf("Metaobject definition %s not found in reference IDs\n", v.(string))
-				return nil, errors.New("metaobject definition not found")
+				return nil, fmt.Errorf("metaobject definition %v not found", v)
 			}
 
 			fieldValidations = append(fieldValidations, shopify.MetafieldDefinitionValidationInput{
@@ -235,23 +352,23 @@ func NewMetaobjectFieldValidations(validations map[string]any, referenceIds map[
 		}
 
 		if k == "metaobject_definitions" {
-			ids, ok := v.([]any)
+			defTypes, ok := typeList(v)
 			if !ok {
-				log.Fatalf("Metaobject definitions %v not found in reference IDs\n", v)
-				return nil, errors.New("metaobject definitions not found")
+				return nil, fmt.Errorf("metaobject definitions %v must be a list of types", v)
 			}
 
-			definitions := make([]string, len(ids))
-			for i, id := range ids {
-				if referenceId, ok := referenceIds[id.(string)]; ok {
-					definitions[i] = referenceId
+			definitions := make([]string, len(defTypes))
+			for i, defType := range defTypes {
+				referenceId, ok := referenceIds[defType]
+				if !ok {
+					return nil, fmt.Errorf("metaobject definition %v not found", defType)
 				}
+				definitions[i] = referenceId
 			}
 
 			value, err := json.Marshal(definitions)
 			if err != nil {
-				log.Fatalf("Error marshalling validation value for field %s: %v\n", k, err)
-				return nil, err
+				return nil, fmt.Errorf("marshalling validation %s: %w", k, err)
 			}
 
 			fieldValidations = append(fieldValidations, shopify.MetafieldDefinitionValidationInput{
@@ -263,10 +380,8 @@ func NewMetaobjectFieldValidations(validations map[string]any, referenceIds map[
 		}
 
 		valueJson, err := json.Marshal(v)
-
 		if err != nil {
-			log.Fatalf("Error marshalling validation value: %s, %v\n", k, v)
-			return nil, err
+			return nil, fmt.Errorf("marshalling validation %s: %w", k, err)
 		}
 
 		fieldValidations = append(fieldValidations, shopify.MetafieldDefinitionValidationInput{
@@ -293,8 +408,7 @@ func NewMetaobjectFieldCreateInput(key string, field FieldDefinition, referenceI
 
 	validations, err := NewMetaobjectFieldValidations(field.Validations, referenceIds)
 	if err != nil {
-		log.Fatalf("Error creating field validations for field %s: %v\n", key, err)
-		return shopify.MetaobjectFieldDefinitionCreateInput{}, err
+		return shopify.MetaobjectFieldDefinitionCreateInput{}, fmt.Errorf("field %s: %w", key, err)
 	}
 
 	input.Validations = validations
@@ -303,13 +417,9 @@ func NewMetaobjectFieldCreateInput(key string, field FieldDefinition, referenceI
 }
 
 func NewMetaobjectDefinitionCreateInput(defType string, definition MetaobjectDefinition, referenceIds map[string]string) (shopify.MetaobjectDefinitionCreateInput, error) {
-	publicRead := shopify.MetaobjectStorefrontAccessPublicRead
-
 	input := shopify.MetaobjectDefinitionCreateInput{
-		Type: defType,
-		Access: shopify.CustomMetaobjectAccessInput{
-			Storefront: publicRead,
-		},
+		Type:             defType,
+		Access:           newAccessInput(defType, definition.Access),
 		Name:             definition.Name,
 		Description:      definition.Description,
 		FieldDefinitions: make([]shopify.MetaobjectFieldDefinitionCreateInput, 0, len(definition.FieldDefinitions)),
@@ -317,20 +427,7 @@ func NewMetaobjectDefinitionCreateInput(defType string, definition MetaobjectDef
 	}
 
 	if definition.Name == "" {
-		input.Name = titleCase(defType)
-	}
-
-	if definition.Access != nil {
-		if definition.Access.Storefront != "" {
-			input.Access.Storefront = definition.Access.Storefront
-		}
-
-		switch definition.Access.Admin {
-		case shopify.MetaobjectAdminAccessMerchantRead:
-			input.Access.Admin = shopify.MetaobjectAdminAccessInputMerchantRead
-		case shopify.MetaobjectAdminAccessMerchantReadWrite:
-			input.Access.Admin = shopify.MetaobjectAdminAccessInputMerchantReadWrite
-		}
+		input.Name = titleCase(strings.TrimPrefix(defType, appTypePrefix))
 	}
 
 	if definition.Capabilities != nil {
@@ -367,7 +464,6 @@ func NewMetaobjectDefinitionCreateInput(defType string, definition MetaobjectDef
 	for key, field := range definition.FieldDefinitions {
 		fieldDefinition, err := NewMetaobjectFieldCreateInput(key, field, referenceIds)
 		if err != nil {
-			log.Fatalf("Error creating field input for field %s: %v\n", key, err)
 			return shopify.MetaobjectDefinitionCreateInput{}, err
 		}
 
@@ -377,67 +473,99 @@ func NewMetaobjectDefinitionCreateInput(defType string, definition MetaobjectDef
 	return input, nil
 }
 
-func NewMetaobjectDefinitionUpdateInput(defType string, definition MetaobjectDefinition, prevDefinition MetaobjectDefinition, referenceIds map[string]string) (shopify.MetaobjectDefinitionUpdateInput, error) {
-	input := shopify.MetaobjectDefinitionUpdateInput{
-		Access: shopify.CustomMetaobjectAccessInput{
-			Storefront: shopify.MetaobjectStorefrontAccessPublicRead,
-		},
-		Name:             definition.Name,
-		Description:      definition.Description,
-		FieldDefinitions: make([]shopify.CustomMetaobjectFieldDefinitionOperationInput, 0, len(definition.FieldDefinitions)),
-		DisplayNameKey:   definition.DisplayNameKey,
+func newCapabilityUpdateInput(capabilities *Capabilities) shopify.MetaobjectCapabilityUpdateInput {
+	input := shopify.MetaobjectCapabilityUpdateInput{}
+	if capabilities == nil {
+		return input
 	}
 
-	if definition.Access != nil {
-		if definition.Access.Storefront != "" {
-			input.Access.Storefront = definition.Access.Storefront
-		}
+	input.Publishable.Enabled = capabilities.Publishable
+	input.Translatable.Enabled = capabilities.Translatable
 
-		switch definition.Access.Admin {
-		case shopify.MetaobjectAdminAccessMerchantRead:
-			input.Access.Admin = shopify.MetaobjectAdminAccessInputMerchantRead
-		case shopify.MetaobjectAdminAccessMerchantReadWrite:
-			input.Access.Admin = shopify.MetaobjectAdminAccessInputMerchantReadWrite
+	if onlineStore := capabilities.OnlineStore; onlineStore != nil {
+		input.OnlineStore = shopify.MetaobjectCapabilityOnlineStoreInput{
+			Enabled: true,
+			Data: shopify.MetaobjectCapabilityDefinitionDataOnlineStoreInput{
+				CreateRedirects: onlineStore.CanCreateRedirects,
+				UrlHandle:       onlineStore.UrlHandle,
+			},
 		}
 	}
 
-	if definition.Capabilities != nil {
-		input.Capabilities = shopify.MetaobjectCapabilityUpdateInput{
-			Publishable: shopify.MetaobjectCapabilityPublishableInput{
-				Enabled: definition.Capabilities.Publishable,
-			},
-			Translatable: shopify.MetaobjectCapabilityTranslatableInput{
-				Enabled: definition.Capabilities.Translatable,
+	if renderable := capabilities.Renderable; renderable != nil {
+		input.Renderable = shopify.MetaobjectCapabilityRenderableInput{
+			Enabled: true,
+			Data: shopify.MetaobjectCapabilityDefinitionDataRenderableInput{
+				MetaDescriptionKey: renderable.MetaDescriptionKey,
+				MetaTitleKey:       renderable.MetaTitleKey,
 			},
 		}
+	}
 
-		if onlineStore := definition.Capabilities.OnlineStore; onlineStore != nil {
-			input.Capabilities.OnlineStore = shopify.MetaobjectCapabilityOnlineStoreInput{
-				Enabled: true,
-				Data: shopify.MetaobjectCapabilityDefinitionDataOnlineStoreInput{
-					CreateRedirects: onlineStore.CanCreateRedirects,
-					UrlHandle:       onlineStore.UrlHandle,
-				},
-			}
-		}
+	return input
+}
 
-		if renderable := definition.Capabilities.Renderable; renderable != nil {
-			input.Capabilities.Renderable = shopify.MetaobjectCapabilityRenderableInput{
-				Enabled: true,
-				Data: shopify.MetaobjectCapabilityDefinitionDataRenderableInput{
-					MetaDescriptionKey: renderable.MetaDescriptionKey,
-					MetaTitleKey:       renderable.MetaTitleKey,
-				},
-			}
-		}
+// comparableRemote leaves out the access and capabilities of a standard
+// remote definition which the local definition doesn't set, as those are
+// left as the template set them.
+func comparableRemote(local MetaobjectDefinition, remote MetaobjectDefinition) MetaobjectDefinition {
+	if local.Standard == "" {
+		return remote
+	}
+
+	if local.Access == nil {
+		remote.Access = nil
+	}
+
+	if local.Capabilities == nil {
+		remote.Capabilities = nil
+	}
+
+	return remote
+}
+
+// NewStandardMetaobjectDefinitionUpdateInput updates the access and
+// capabilities of a definition enabled from a standard template. The rest of
+// the definition is kept as the template set it.
+func NewStandardMetaobjectDefinitionUpdateInput(definition MetaobjectDefinition, prevDefinition shopify.Cli_MetaobjectDefinition) shopify.MetaobjectDefinitionUpdateInput {
+	prev := ConvertMetaobjectDefinition(prevDefinition)
+
+	access := definition.Access
+	if access == nil {
+		access = prev.Access
+	}
+
+	capabilities := definition.Capabilities
+	if capabilities == nil {
+		capabilities = prev.Capabilities
+	}
+
+	return shopify.MetaobjectDefinitionUpdateInput{
+		Name:             prevDefinition.Name,
+		Description:      prevDefinition.Description,
+		DisplayNameKey:   prevDefinition.DisplayNameKey,
+		FieldDefinitions: make([]shopify.CustomMetaobjectFieldDefinitionOperationInput, 0),
+		Access:           newAccessInput(prevDefinition.Type, access),
+		Capabilities:     newCapabilityUpdateInput(capabilities),
 	}
+}
+
+func NewMetaobjectDefinitionUpdateInput(defType string, definition MetaobjectDefinition, prevDefinition MetaobjectDefinition, referenceIds map[string]string) (shopify.MetaobjectDefinitionUpdateInput, error) {
+	input := shopify.MetaobjectDefinitionUpdateInput{
+		Access:           newAccessInput(defType, definition.Access),
+		Name:             definition.Name,
+		Description:      definition.Description,
+		FieldDefinitions: make([]shopify.CustomMetaobjectFieldDefinitionOperationInput, 0, len(definition.FieldDefinitions)),
+		DisplayNameKey:   definition.DisplayNameKey,
+	}
+
+	input.Capabilities = newCapabilityUpdateInput(definition.Capabilities)
 
 	for key, field := range definition.FieldDefinitions {
 		if _, ok := prevDefinition.FieldDefinitions[key]; !ok {
 
 			create, err := NewMetaobjectFieldCreateInput(key, field, referenceIds)
 			if err != nil {
-				log.Fatalf("Error creating field input for field %s: %v\n", key, err)
 				return shopify.MetaobjectDefinitionUpdateInput{}, err
 			}
 
@@ -461,8 +589,7 @@ func NewMetaobjectDefinitionUpdateInput(defType string, definition MetaobjectDef
 
 		validations, err := NewMetaobjectFieldValidations(field.Validations, referenceIds)
 		if err != nil {
-			log.Fatalf("Error creating field validations for field %s: %v\n", key, err)
-			return shopify.MetaobjectDefinitionUpdateInput{}, err
+			return shopify.MetaobjectDefinitionUpdateInput{}, fmt.Errorf("field %s: %w", key, err)
 		}
 		update.Validations = validations
 
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+const standardDefinitionJson = `{
+	"id": "gid://shopify/MetaobjectDefinition/1",
+	"type": "shopify--qa-pair",
+	"name": "Q&A pair",
+	"description": "A question with its answer",
+	"displayNameKey": "question",
+	"access": {"admin": "PUBLIC_READ_WRITE", "storefront": "PUBLIC_READ"},
+	"capabilities": {
+		"publishable": {"enabled": true},
+		"translatable": {"enabled": true},
+		"onlineStore": {"enabled": false},
+		"renderable": {"enabled": false}
+	},
+	"fieldDefinitions": [
+		{"key": "question", "name": "Question", "type": {"name": "single_line_text_field"}, "validations": []}
+	],
+	"standardTemplate": {"type": "shopify--qa-pair"}
+}`
+
+func standardDefinition(t *testing.T) shopify.Cli_MetaobjectDefinition {
+	t.Helper()
+
+	var d shopify.Cli_MetaobjectDefinition
+	if err := json.Unmarshal([]byte(standardDefinitionJson), &d); err != nil {
+		t.Fatal(err)
+	}
+
+	return d
+}
+
+func TestConvertStandardMetaobjectDefinition(t *testing.T) {
+	d := ConvertMetaobjectDefinition(standardDefinition(t))
+
+	if d.Standard != "shopify--qa-pair" || d.Name != "" || d.Description != "" || d.FieldDefinitions != nil {
+		t.Errorf("template attributes weren't replaced by the marker: %+v", d)
+	}
+	if d.Capabilities == nil || !d.Capabilities.Publishable || !d.Capabilities.Translatable {
+		t.Errorf("capabilities = %+v", d.Capabilities)
+	}
+	if d.Access != nil {
+		t.Errorf("default access = %+v", d.Access)
+	}
+}
+
+func TestComparableRemote(t *testing.T) {
+	remote := ConvertMetaobjectDefinition(standardDefinition(t))
+
+	marker := MetaobjectDefinition{Standard: "shopify--qa-pair"}
+	if got := comparableRemote(marker, remote); got.Capabilities != nil {
+		t.Errorf("capabilities which aren't set locally are compared: %+v", got.Capabilities)
+	}
+
+	local := MetaobjectDefinition{Standard: "shopify--qa-pair", Capabilities: &Capabilities{Publishable: true}}
+	if got := comparableRemote(local, remote); got.Capabilities == nil || !got.Capabilities.Translatable {
+		t.Errorf("capabilities which are set locally aren't compared: %+v", got.Capabilities)
+	}
+
+	custom := MetaobjectDefinition{Name: "Author"}
+	if got := comparableRemote(custom, remote); got.Capabilities == nil {
+		t.Errorf("the capabilities of custom definitions are always compared")
+	}
+}
+
+func TestNewStandardMetaobjectDefinitionUpdateInput(t *testing.T) {
+	prev := standardDefinition(t)
+
+	local := MetaobjectDefinition{
+		Standard:     "shopify--qa-pair",
+		Access:       &Access{Storefront: shopify.MetaobjectStorefrontAccessNone},
+		Capabilities: &Capabilities{Publishable: true},
+	}
+
+	input := NewStandardMetaobjectDefinitionUpdateInput(local, prev)
+
+	if input.Name != prev.Name || input.Description != prev.Description || input.DisplayNameKey != prev.DisplayNameKey {
+		t.Errorf("template attributes changed: %+v", input)
+	}
+	if len(input.FieldDefinitions) != 0 {
+		t.Errorf("fields changed: %v", input.FieldDefinitions)
+	}
+	if input.Access.Storefront != shopify.MetaobjectStorefrontAccessNone {
+		t.Errorf("storefront access = %v", input.Access.Storefront)
+	}
+	if !input.Capabilities.Publishable.Enabled || input.Capabilities.Translatable.Enabled {
+		t.Errorf("capabilities = %+v", input.Capabilities)
+	}
+
+	// Capabilities which aren't set locally are kept as they are.
+	input = NewStandardMetaobjectDefinitionUpdateInput(MetaobjectDefinition{Standard: "shopify--qa-pair"}, prev)
+	if !input.Capabilities.Publishable.Enabled || !input.Capabilities.Translatable.Enabled {
+		t.Errorf("capabilities = %+v", input.Capabilities)
+	}
+}
@@ -0,0 +1,206 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+)
+
+type MetaobjectEntry struct {
+	// RenamedFrom is the previous handle of an entry whose handle changed.
+	RenamedFrom string                   `json:"renamedFrom,omitempty"`
+	Status      shopify.MetaobjectStatus `json:"status,omitempty"`
+	Fields      map[string]any           `json:"fields"`
+}
+
+// Field types whose values are stored by Shopify as JSON encoded strings.
+// These are decoded when pulled so entry files hold structured data.
+var jsonFieldTypes = map[string]bool{
+	"boolean":         true,
+	"number_integer":  true,
+	"json":            true,
+	"rich_text_field": true,
+	"dimension":       true,
+	"volume":          true,
+	"weight":          true,
+	"rating":          true,
+	"money":           true,
+	"link":            true,
+}
+
+func isListFieldType(fieldType string) bool {
+	return strings.HasPrefix(fieldType, "list.")
+}
+
+func isJsonFieldType(fieldType string) bool {
+	return isListFieldType(fieldType) || jsonFieldTypes[fieldType]
+}
+
+func decodeFieldValue(fieldType string, value string) any {
+	if !isJsonFieldType(fieldType) {
+		return value
+	}
+
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+
+	if fieldType == "rich_text_field" {
+		if md, ok := richTextMarkdown(decoded); ok {
+			return md
+		}
+	}
+
+	return decoded
+}
+
+func encodeFieldValue(fieldType string, value any) (string, error) {
+	// Strings are passed through as already encoded values, except for json
+	// fields where a string is a valid value in its own right, and rich text
+	// fields where a string is Markdown.
+	if s, ok := value.(string); ok && fieldType == "rich_text_field" && s != "" && !isEncodedRichText(s) {
+		root, err := markdownToRichText(s)
+		if err != nil {
+			return "", fmt.Errorf("converting Markdown to rich text: %w", err)
+		}
+
+		value = root
+	} else if ok && fieldType != "json" {
+		return s, nil
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("encoding %s value: %w", fieldType, err)
+	}
+
+	return string(b), nil
+}
+
+// ConvertMetaobjectEntry converts a pulled metaobject to an entry, given the
+// keys of its references as looked up by GID.
+func ConvertMetaobjectEntry(metaobject shopify.Cli_Metaobject, references map[string]string) MetaobjectEntry {
+	e := MetaobjectEntry{
+		Status: metaobject.Capabilities.Publishable.Status,
+		Fields: make(map[string]any, len(metaobject.Fields)),
+	}
+
+	for _, f := range metaobject.Fields {
+		if f.Value == "" {
+			continue
+		}
+
+		value := decodeFieldValue(f.Type, f.Value)
+
+		// Replace referenced GIDs with stable keys so entries can be pushed to
+		// other stores. References which can't be resolved keep their GID.
+		if isReferenceFieldType(f.Type) {
+			if mapped, err := mapReferences(value, func(id string) (string, error) {
+				if key := references[id]; key != "" {
+					return key, nil
+				}
+				return id, nil
+			}); err == nil {
+				value = mapped
+			}
+		}
+
+		e.Fields[f.Key] = value
+	}
+
+	return e
+}
+
+// NormalizeMetaobjectEntry round trips an entry through the Shopify encoding
+// so local and remote entries can be compared regardless of how values were
+// written in the entry file.
+func NormalizeMetaobjectEntry(entry MetaobjectEntry, fieldTypes map[string]string) (MetaobjectEntry, error) {
+	e := MetaobjectEntry{
+		Status: entry.Status,
+		Fields: make(map[string]any, len(entry.Fields)),
+	}
+
+	for key, value := range entry.Fields {
+		fieldType, ok := fieldTypes[key]
+		if !ok {
+			return MetaobjectEntry{}, fmt.Errorf("field %s has no field definition", key)
+		}
+
+		encoded, err := encodeFieldValue(fieldType, value)
+		if err != nil {
+			return MetaobjectEntry{}, fmt.Errorf("field %s: %w", key, err)
+		}
+
+		if encoded == "" {
+			continue
+		}
+
+		e.Fields[key] = decodeFieldValue(fieldType, encoded)
+	}
+
+	return e, nil
+}
+
+// NewMetaobjectUpsertInput creates the input for upserting an entry. Fields
+// which are set on the previous entry but missing locally are cleared.
+func NewMetaobjectUpsertInput(entry MetaobjectEntry, prevEntry MetaobjectEntry, fieldTypes map[string]string) (shopify.MetaobjectUpsertInput, error) {
+	input := shopify.MetaobjectUpsertInput{
+		Fields: make([]shopify.MetaobjectFieldInput, 0, len(entry.Fields)),
+	}
+
+	if entry.Status != "" {
+		input.Capabilities = &shopify.MetaobjectCapabilityDataInput{
+			Publishable: &shopify.MetaobjectCapabilityDataPublishableInput{
+				Status: entry.Status,
+			},
+		}
+	}
+
+	for key, value := range entry.Fields {
+		fieldType, ok := fieldTypes[key]
+		if !ok {
+			return shopify.MetaobjectUpsertInput{}, fmt.Errorf("field %s has no field definition", key)
+		}
+
+		encoded, err := encodeFieldValue(fieldType, value)
+		if err != nil {
+			return shopify.MetaobjectUpsertInput{}, fmt.Errorf("field %s: %w", key, err)
+		}
+
+		input.Fields = append(input.Fields, shopify.MetaobjectFieldInput{
+			Key:   key,
+			Value: encoded,
+		})
+	}
+
+	for key := range prevEntry.Fields {
+		if _, ok := entry.Fields[key]; ok {
+			continue
+		}
+
+		input.Fields = append(input.Fields, shopify.MetaobjectFieldInput{
+			Key:   key,
+			Value: "",
+		})
+	}
+
+	return input, nil
+}
+
+func metaobjectFieldTypes(definitions []shopify.Cli_MetaobjectDefinition) map[string]map[string]string {
+	fieldTypes := make(map[string]map[string]string, len(definitions))
+
+	for _, d := range definitions {
+		types := make(map[string]string, len(d.FieldDefinitions))
+		for _, f := range d.FieldDefinitions {
+			types[f.Key] = f.Type.Name
+		}
+
+		fieldTypes[d.Type] = types
+	}
+
+	return fieldTypes
+}
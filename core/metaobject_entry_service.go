@@ -0,0 +1,485 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hjson/hjson-go/v4"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+type MetaobjectEntryService struct {
+	ShopifyClient *graphql.Client
+	// Dir is the entries directory, which local asset paths are resolved
+	// against.
+	Dir string
+	// AppId resolves the $app: types of the app the access token belongs to.
+	AppId      string
+	references *ReferenceResolver
+}
+
+// EntryUpsert is a pending change to a single entry in the store.
+type EntryUpsert struct {
+	Type   string
+	Handle string
+	Input  shopify.MetaobjectUpsertInput
+	Rename *EntryRename
+}
+
+// EntryRename is a change of an entry's handle. Entries of types which can
+// create redirects get a redirect from their previous URL.
+type EntryRename struct {
+	Id       string
+	From     string
+	Redirect bool
+}
+
+// EntryChange holds a local entry next to its remote counterpart. Local and
+// Remote are normalized so they can be marshalled and compared, Entry is the
+// entry as read from its file.
+type EntryChange struct {
+	Type       string
+	Handle     string
+	Entry      MetaobjectEntry
+	Local      MetaobjectEntry
+	Remote     MetaobjectEntry
+	FieldTypes map[string]string
+	Rename     *EntryRename
+}
+
+func (es *MetaobjectEntryService) resolver() *ReferenceResolver {
+	if es.references == nil {
+		es.references = &ReferenceResolver{ShopifyClient: es.ShopifyClient}
+	}
+
+	return es.references
+}
+
+func EntryKey(defType string, handle string) string {
+	return defType + "/" + handle
+}
+
+// entryPageSize keeps a page of entries well under the query cost limit. The
+// resources referenced by the entries are looked up separately, see
+// metaobjectReferences.
+const entryPageSize = 100
+
+func (es *MetaobjectEntryService) ListEntries(defType string) ([]shopify.Cli_Metaobject, error) {
+	var metaobjects []shopify.Cli_Metaobject
+	cursor := ""
+
+	for {
+		data, err := shopify.ListMetaobjects(context.Background(), *es.ShopifyClient, defType, entryPageSize, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("listing entries of type %s: %w", defType, err)
+		}
+
+		metaobjects = append(metaobjects, data.Metaobjects.Nodes...)
+
+		if !data.Metaobjects.PageInfo.HasNextPage {
+			return metaobjects, nil
+		}
+
+		cursor = data.Metaobjects.PageInfo.EndCursor
+	}
+}
+
+func (es *MetaobjectEntryService) Pull(types []string) (map[string]map[string]MetaobjectEntry, error) {
+	if len(types) == 0 {
+		data, err := shopify.ListMetaobjectDefinitions(context.Background(), *es.ShopifyClient, 250)
+		if err != nil {
+			return nil, fmt.Errorf("listing metaobject definitions: %w", err)
+		}
+
+		for _, d := range localMetaobjectDefinitions(data.MetaobjectDefinitions.Nodes, es.AppId) {
+			types = append(types, d.Type)
+		}
+	}
+
+	entries := make(map[string]map[string]MetaobjectEntry, len(types))
+
+	for _, defType := range types {
+		metaobjects, err := es.ListEntries(defType)
+		if err != nil {
+			return nil, err
+		}
+
+		references, err := metaobjectReferences(*es.ShopifyClient, metaobjects)
+		if err != nil {
+			return nil, err
+		}
+
+		typeEntries := make(map[string]MetaobjectEntry, len(metaobjects))
+		for _, m := range metaobjects {
+			typeEntries[m.Handle] = ConvertMetaobjectEntry(m, references)
+		}
+
+		entries[defType] = typeEntries
+	}
+
+	return entries, nil
+}
+
+func (es *MetaobjectEntryService) compare(entries map[string]map[string]MetaobjectEntry) ([]EntryChange, error) {
+	data, err := shopify.ListMetaobjectDefinitions(context.Background(), *es.ShopifyClient, 250)
+	if err != nil {
+		return nil, fmt.Errorf("listing metaobject definitions: %w", err)
+	}
+
+	nodes := localMetaobjectDefinitions(data.MetaobjectDefinitions.Nodes, es.AppId)
+	fieldTypes := metaobjectFieldTypes(nodes)
+	comparisons := make([]EntryChange, 0)
+
+	redirects := make(map[string]bool)
+	for _, d := range nodes {
+		redirects[d.Type] = d.Capabilities.OnlineStore.Enabled && d.Capabilities.OnlineStore.Data.CanCreateRedirects
+	}
+
+	for defType, typeEntries := range entries {
+		types, ok := fieldTypes[defType]
+		if !ok {
+			return nil, fmt.Errorf("metaobject definition %s not found", defType)
+		}
+
+		metaobjects, err := es.ListEntries(defType)
+		if err != nil {
+			return nil, err
+		}
+
+		references, err := metaobjectReferences(*es.ShopifyClient, metaobjects)
+		if err != nil {
+			return nil, err
+		}
+
+		remoteEntries := make(map[string]MetaobjectEntry, len(metaobjects))
+		remoteIds := make(map[string]string, len(metaobjects))
+		for _, m := range metaobjects {
+			remoteEntries[m.Handle] = ConvertMetaobjectEntry(m, references)
+			remoteIds[m.Handle] = m.Id
+			es.resolver().Learn(m, references)
+		}
+
+		normalizedEntries := make(map[string]MetaobjectEntry, len(typeEntries))
+		for handle, localEntry := range typeEntries {
+			resolved, _, err := es.resolveAssets(defType, localEntry, types)
+			if err != nil {
+				return nil, fmt.Errorf("entry %s: %w", EntryKey(defType, handle), err)
+			}
+
+			normalized, err := NormalizeMetaobjectEntry(resolved, types)
+			if err != nil {
+				return nil, fmt.Errorf("entry %s: %w", EntryKey(defType, handle), err)
+			}
+
+			normalizedEntries[handle] = normalized
+		}
+
+		renames, likely := detectRenames(typeEntries, normalizedEntries, remoteEntries)
+		likelyHandles := make([]string, 0, len(likely))
+		for handle := range likely {
+			likelyHandles = append(likelyHandles, handle)
+		}
+		sort.Strings(likelyHandles)
+
+		for _, handle := range likelyHandles {
+			from := likely[handle]
+			log.Printf("Entry %s matches store entry %s, add renamedFrom: %s to rename it instead of creating a new entry\n", EntryKey(defType, handle), EntryKey(defType, from), from)
+		}
+
+		for handle, normalized := range normalizedEntries {
+			remoteEntry := remoteEntries[handle]
+
+			var rename *EntryRename
+			if from, ok := renames[handle]; ok {
+				remoteEntry = remoteEntries[from]
+				rename = &EntryRename{Id: remoteIds[from], From: from, Redirect: redirects[defType]}
+			}
+
+			// Entries without a status leave the publish status unmanaged.
+			if normalized.Status == "" {
+				normalized.Status = remoteEntry.Status
+			}
+
+			comparisons = append(comparisons, EntryChange{
+				Type:       defType,
+				Handle:     handle,
+				Local:      normalized,
+				Remote:     remoteEntry,
+				FieldTypes: types,
+				Rename:     rename,
+			})
+		}
+	}
+
+	return comparisons, nil
+}
+
+func (c EntryChange) diff() ([]diffmatchpatch.Diff, error) {
+	localJson, err := hjson.Marshal(c.Local)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling local entry %s: %w", EntryKey(c.Type, c.Handle), err)
+	}
+
+	remoteJson, err := hjson.Marshal(c.Remote)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling remote entry %s: %w", EntryKey(c.Type, c.Handle), err)
+	}
+
+	if c.Rename != nil {
+		localJson = append([]byte("handle: "+c.Handle+"\n"), localJson...)
+		remoteJson = append([]byte("handle: "+c.Rename.From+"\n"), remoteJson...)
+	}
+
+	if string(localJson) == string(remoteJson) {
+		return nil, nil
+	}
+
+	dmp := diffmatchpatch.New()
+	return dmp.DiffMain(string(remoteJson), string(localJson), false), nil
+}
+
+func (es *MetaobjectEntryService) Diff(entries map[string]map[string]MetaobjectEntry) (map[string][]diffmatchpatch.Diff, error) {
+	comparisons, err := es.compare(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make(map[string][]diffmatchpatch.Diff)
+
+	for _, c := range comparisons {
+		d, err := c.diff()
+		if err != nil {
+			return nil, err
+		}
+
+		if d != nil {
+			diffs[EntryKey(c.Type, c.Handle)] = d
+		}
+	}
+
+	return diffs, nil
+}
+
+// Plan returns the changes needed to bring the store in line with the local
+// entries. Entries which already match the store are left out.
+func (es *MetaobjectEntryService) Plan(entries map[string]map[string]MetaobjectEntry) ([]EntryChange, error) {
+	comparisons, err := es.compare(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]EntryChange, 0, len(comparisons))
+
+	for _, c := range comparisons {
+		d, err := c.diff()
+		if err != nil {
+			return nil, err
+		}
+
+		if d == nil {
+			continue
+		}
+
+		c.Entry = entries[c.Type][c.Handle]
+		changes = append(changes, c)
+	}
+
+	return changes, nil
+}
+
+// NewUpsert uploads the local assets and resolves the references of a
+// change, and creates its upsert.
+func (es *MetaobjectEntryService) NewUpsert(c EntryChange) (EntryUpsert, error) {
+	entry, assets, err := es.resolveAssets(c.Type, c.Entry, c.FieldTypes)
+	if err != nil {
+		return EntryUpsert{}, fmt.Errorf("entry %s: %w", EntryKey(c.Type, c.Handle), err)
+	}
+
+	if err := es.uploadAssets(assets); err != nil {
+		return EntryUpsert{}, fmt.Errorf("entry %s: %w", EntryKey(c.Type, c.Handle), err)
+	}
+
+	entry, err = es.resolver().ResolveEntry(entry, c.FieldTypes)
+	if err != nil {
+		return EntryUpsert{}, fmt.Errorf("entry %s: %w", EntryKey(c.Type, c.Handle), err)
+	}
+
+	input, err := NewMetaobjectUpsertInput(entry, c.Remote, c.FieldTypes)
+	if err != nil {
+		return EntryUpsert{}, fmt.Errorf("entry %s: %w", EntryKey(c.Type, c.Handle), err)
+	}
+
+	return EntryUpsert{
+		Type:   c.Type,
+		Handle: c.Handle,
+		Input:  input,
+		Rename: c.Rename,
+	}, nil
+}
+
+// nextUpserts returns the upserts which can be applied now. Changes that
+// reference entries not yet in the store are deferred until a later round,
+// after the entries they depend on have been created.
+func (es *MetaobjectEntryService) nextUpserts(changes []EntryChange) ([]EntryUpsert, []EntryChange, error) {
+	upserts := make([]EntryUpsert, 0, len(changes))
+	deferred := make([]EntryChange, 0)
+	var deferErr error
+
+	for _, c := range changes {
+		u, err := es.NewUpsert(c)
+		if errors.Is(err, ErrReferenceNotFound) {
+			deferred = append(deferred, c)
+			deferErr = err
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		upserts = append(upserts, u)
+	}
+
+	if len(upserts) == 0 && len(deferred) > 0 {
+		return nil, nil, deferErr
+	}
+
+	return upserts, deferred, nil
+}
+
+func (es *MetaobjectEntryService) Push(entries map[string]map[string]MetaobjectEntry) error {
+	changes, err := es.Plan(entries)
+	if err != nil {
+		return err
+	}
+
+	total := len(changes)
+	failed := 0
+
+	for len(changes) > 0 {
+		upserts, deferred, err := es.nextUpserts(changes)
+		if err != nil {
+			return err
+		}
+
+		for _, u := range upserts {
+			key := EntryKey(u.Type, u.Handle)
+
+			if u.Rename != nil {
+				if err := es.rename(u); err != nil {
+					log.Printf("Error renaming entry %s: %v\n", key, err)
+					failed++
+				}
+				continue
+			}
+
+			res, err := shopify.UpsertMetaobject(context.Background(), *es.ShopifyClient, shopify.MetaobjectHandleInput{
+				Type:   u.Type,
+				Handle: u.Handle,
+			}, u.Input)
+			if err != nil {
+				return fmt.Errorf("upserting entry %s: %w", key, err)
+			}
+
+			if len(res.MetaobjectUpsert.UserErrors) > 0 {
+				log.Printf("Error upserting entry %s: %v\n", key, res.MetaobjectUpsert.UserErrors)
+				failed++
+				continue
+			}
+
+			log.Printf("Upserted entry: %s\n", key)
+		}
+
+		changes = deferred
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d entries failed to upsert", failed, total)
+	}
+
+	return nil
+}
+
+func (es *MetaobjectEntryService) BulkPush(entries map[string]map[string]MetaobjectEntry) ([]BulkImportResult, error) {
+	changes, err := es.Plan(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkImportResult, 0, len(changes))
+
+	for len(changes) > 0 {
+		upserts, deferred, err := es.nextUpserts(changes)
+		if err != nil {
+			return results, err
+		}
+
+		// Bulk upserts match entries by handle, so renames are applied
+		// one at a time.
+		bulkUpserts := make([]EntryUpsert, 0, len(upserts))
+		for _, u := range upserts {
+			if u.Rename == nil {
+				bulkUpserts = append(bulkUpserts, u)
+				continue
+			}
+
+			result := BulkImportResult{Type: u.Type, Handle: u.Handle, Id: u.Rename.Id}
+			if err := es.rename(u); err != nil {
+				result.UserErrors = []string{err.Error()}
+			}
+			results = append(results, result)
+		}
+
+		r, err := RunBulkUpsert(*es.ShopifyClient, bulkUpserts)
+		results = append(results, r...)
+		if err != nil {
+			return results, err
+		}
+
+		changes = deferred
+	}
+
+	return results, nil
+}
+
+// SetStatus publishes or unpublishes store entries of a publishable type.
+func (es *MetaobjectEntryService) SetStatus(defType string, handles []string, status shopify.MetaobjectStatus) error {
+	for _, handle := range handles {
+		key := EntryKey(defType, handle)
+
+		res, err := shopify.GetMetaobjectByHandle(context.Background(), *es.ShopifyClient, shopify.MetaobjectHandleInput{
+			Type:   defType,
+			Handle: handle,
+		})
+		if err != nil {
+			return fmt.Errorf("looking up entry %s: %w", key, err)
+		}
+
+		if res.MetaobjectByHandle.Id == "" {
+			return fmt.Errorf("entry %s not found", key)
+		}
+
+		update, err := shopify.UpdateMetaobject(context.Background(), *es.ShopifyClient, res.MetaobjectByHandle.Id, shopify.MetaobjectUpdateInput{
+			Capabilities: &shopify.MetaobjectCapabilityDataInput{
+				Publishable: &shopify.MetaobjectCapabilityDataPublishableInput{
+					Status: status,
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("updating status of entry %s: %w", key, err)
+		}
+
+		if len(update.MetaobjectUpdate.UserErrors) > 0 {
+			return fmt.Errorf("updating status of entry %s: %v", key, update.MetaobjectUpdate.UserErrors)
+		}
+
+		log.Printf("Set status of entry %s to %s\n", key, status)
+	}
+
+	return nil
+}
@@ -2,7 +2,7 @@ package core
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log"
 
 	"github.com/JohnnyMcGee/metadef/shopify"
@@ -13,52 +13,64 @@ import (
 
 type MetaobjectService struct {
 	ShopifyClient *graphql.Client
+	// AppId is the ID of the app the access token belongs to, which
+	// resolves the $app: types of its reserved namespace.
+	AppId string
+	// Shop names the store in logs, to tell apart pushes to several stores.
+	Shop string
 }
 
 func (ms *MetaobjectService) Pull() (map[string]MetaobjectDefinition, error) {
+	return ms.remoteDefinitions()
+}
 
+// remoteDefinitions returns the store definitions keyed by their type in
+// definition files.
+func (ms *MetaobjectService) remoteDefinitions() (map[string]MetaobjectDefinition, error) {
 	data, err := shopify.ListMetaobjectDefinitions(context.Background(), *ms.ShopifyClient, 250)
 	if err != nil {
-		log.Fatalf("Error fetching data: %v\n", err)
-		return nil, err
+		return nil, fmt.Errorf("listing metaobject definitions: %w", err)
 	}
 
-	return CreateMetaobjectDefinitionMap(data.MetaobjectDefinitions.Nodes), nil
+	return CreateMetaobjectDefinitionMap(localMetaobjectDefinitions(data.MetaobjectDefinitions.Nodes, ms.AppId)), nil
 }
 
 func (ms *MetaobjectService) Diff(definitions map[string]MetaobjectDefinition) (map[string][]diffmatchpatch.Diff, error) {
-	data, err := shopify.ListMetaobjectDefinitions(context.Background(), *ms.ShopifyClient, 250)
+	if err := checkAppTypes(definitions, ms.AppId); err != nil {
+		return nil, err
+	}
+
+	remoteDefinitions, err := ms.remoteDefinitions()
 	if err != nil {
-		log.Fatalf("Error Listing Metaobject Definitions: %v\n", err)
 		return nil, err
 	}
 
-	remoteDefinitions := CreateMetaobjectDefinitionMap(data.MetaobjectDefinitions.Nodes)
+	return diffDefinitions(definitions, remoteDefinitions)
+}
+
+// diffDefinitions diffs the local definitions against the store definitions,
+// leaving out the types which are the same.
+func diffDefinitions(definitions map[string]MetaobjectDefinition, remoteDefinitions map[string]MetaobjectDefinition) (map[string][]diffmatchpatch.Diff, error) {
 	diffs := make(map[string][]diffmatchpatch.Diff)
 
 	for key, localDefinition := range definitions {
-		remoteDefinition := remoteDefinitions[key]
+		remoteDefinition := comparableRemote(localDefinition, remoteDefinitions[key])
 
 		localJson, err := hjson.Marshal(localDefinition)
 		if err != nil {
-			log.Fatalf("Error marshalling local definition %v: %v\n", key, err)
-			return nil, err
+			return nil, fmt.Errorf("marshalling local definition %s: %w", key, err)
 		}
 
 		remoteJson, err := hjson.Marshal(remoteDefinition)
 		if err != nil {
-			log.Fatalf("Error marshalling remote definition %v: %v\n", key, err)
-			return nil, err
+			return nil, fmt.Errorf("marshalling remote definition %s: %w", key, err)
 		}
 
-		dmp := diffmatchpatch.New()
-
-		match := dmp.MatchMain(string(remoteJson), string(localJson), 0)
-
-		if match == 0 {
+		if string(localJson) == string(remoteJson) {
 			continue
 		}
 
+		dmp := diffmatchpatch.New()
 		diffs[key] = dmp.DiffMain(string(remoteJson), string(localJson), false)
 	}
 
@@ -66,102 +78,165 @@ func (ms *MetaobjectService) Diff(definitions map[string]MetaobjectDefinition) (
 }
 
 func (ms *MetaobjectService) Push(definitions map[string]MetaobjectDefinition) error {
+	if err := checkAppTypes(definitions, ms.AppId); err != nil {
+		return err
+	}
+
 	data, err := shopify.ListMetaobjectDefinitions(context.Background(), *ms.ShopifyClient, 250)
 	if err != nil {
-		log.Fatalf("Error fetching data: %v\n", err)
-		return err
+		return fmt.Errorf("listing metaobject definitions: %w", err)
 	}
 
-	remoteDefinitions := CreateMetaobjectDefinitionMap(data.MetaobjectDefinitions.Nodes)
+	nodes := localMetaobjectDefinitions(data.MetaobjectDefinitions.Nodes, ms.AppId)
+	remoteDefinitions := CreateMetaobjectDefinitionMap(nodes)
 
 	referenceMap := make(map[string]string, len(remoteDefinitions))
-	for _, def := range data.MetaobjectDefinitions.Nodes {
+	for _, def := range nodes {
 		referenceMap[def.Type] = def.Id
 	}
 
-	for key, localDefinition := range definitions {
-		if _, ok := remoteDefinitions[key]; ok {
+	create := make(map[string]bool)
+	for key := range definitions {
+		if _, ok := remoteDefinitions[key]; !ok {
+			create[key] = true
+		}
+	}
+
+	for _, key := range creationOrder(definitions, create) {
+		localDefinition := definitions[key]
+
+		if localDefinition.Standard != "" {
+			if err := ms.enableStandard(key, localDefinition.Standard); err != nil {
+				return fmt.Errorf("enabling standard definition %s: %w", key, err)
+			}
+
+			ms.logf("Enabled standard definition: %s\n", key)
 			continue
 		}
 
-		input, err := NewMetaobjectDefinitionCreateInput(key, localDefinition, referenceMap)
+		createDefinition, pending := withoutPendingReferences(localDefinition, referenceMap)
+
+		input, err := NewMetaobjectDefinitionCreateInput(key, createDefinition, referenceMap)
 		if err != nil {
-			log.Fatalf("Error creating input for definition %v: %v\n", key, err)
-			return err
+			return fmt.Errorf("definition %s: %w", key, err)
 		}
 
-		_, err = shopify.CreateMetaobjectDefinition(context.Background(), *ms.ShopifyClient, input)
+		res, err := shopify.CreateMetaobjectDefinition(context.Background(), *ms.ShopifyClient, input)
 		if err != nil {
-			log.Fatalf("Error creating definition %v: %v\n", key, err)
-			return err
+			return fmt.Errorf("creating definition %s: %w", key, err)
+		}
+
+		if len(res.MetaobjectDefinitionCreate.UserErrors) > 0 {
+			return fmt.Errorf("creating definition %s: %v", key, res.MetaobjectDefinitionCreate.UserErrors)
 		}
 
-		log.Printf("Created definition: %s\n", key)
+		referenceMap[key] = res.MetaobjectDefinitionCreate.MetaobjectDefinition.Id
+
+		ms.logf("Created definition: %s\n", key)
+		for _, field := range pending {
+			ms.logf("Deferred field %s of definition %s until its references exist\n", field, key)
+		}
 	}
 
 	data, err = shopify.ListMetaobjectDefinitions(context.Background(), *ms.ShopifyClient, 250)
 	if err != nil {
-		log.Fatalf("Error fetching data: %v\n", err)
-		return err
+		return fmt.Errorf("listing metaobject definitions: %w", err)
 	}
 
-	remoteDefinitions = CreateMetaobjectDefinitionMap(data.MetaobjectDefinitions.Nodes)
+	nodes = localMetaobjectDefinitions(data.MetaobjectDefinitions.Nodes, ms.AppId)
+	remoteDefinitions = CreateMetaobjectDefinitionMap(nodes)
 
-	for _, def := range data.MetaobjectDefinitions.Nodes {
+	nodesByType := make(map[string]shopify.Cli_MetaobjectDefinition, len(nodes))
+	for _, def := range nodes {
 		referenceMap[def.Type] = def.Id
+		nodesByType[def.Type] = def
 	}
 
 	for key, localDefinition := range definitions {
 		remoteDefinition, ok := remoteDefinitions[key]
 		if !ok {
-			log.Fatalf("Error finding definition %v: %v\n", key, err)
-			return errors.New("definition not found")
+			return fmt.Errorf("definition %s not found", key)
 		}
 
+		if localDefinition.Standard != remoteDefinition.Standard {
+			if localDefinition.Standard == "" {
+				return fmt.Errorf("definition %s was enabled from the standard template %s, declare it with standard: %s", key, remoteDefinition.Standard, remoteDefinition.Standard)
+			}
+			return fmt.Errorf("definition %s already exists and wasn't enabled from the standard template %s", key, localDefinition.Standard)
+		}
+
+		remoteDefinition = comparableRemote(localDefinition, remoteDefinition)
+
 		localJson, err := hjson.Marshal(localDefinition)
 		if err != nil {
-			log.Fatalf("Error marshalling local definition %v: %v\n", key, err)
-			return err
+			return fmt.Errorf("marshalling local definition %s: %w", key, err)
 		}
 
 		remoteJson, err := hjson.Marshal(remoteDefinition)
 		if err != nil {
-			log.Fatalf("Error marshalling remote definition %v: %v\n", key, err)
-			return err
+			return fmt.Errorf("marshalling remote definition %s: %w", key, err)
 		}
 
-		dmp := diffmatchpatch.New()
-
-		match := dmp.MatchMain(string(remoteJson), string(localJson), 0)
-
-		if match == 0 {
+		// Compare exactly, so small changes, such as a field deferred on
+		// create, are pushed too.
+		if string(localJson) == string(remoteJson) {
 			continue
 		}
 
-		input, err := NewMetaobjectDefinitionUpdateInput(key, localDefinition, remoteDefinition, referenceMap)
-		if err != nil {
-			log.Fatalf("Error creating input for definition %v: %v\n", key, err)
-			return err
+		var input shopify.MetaobjectDefinitionUpdateInput
+		if localDefinition.Standard != "" {
+			input = NewStandardMetaobjectDefinitionUpdateInput(localDefinition, nodesByType[key])
+		} else {
+			input, err = NewMetaobjectDefinitionUpdateInput(key, localDefinition, remoteDefinition, referenceMap)
+			if err != nil {
+				return fmt.Errorf("definition %s: %w", key, err)
+			}
 		}
 
 		id, ok := referenceMap[key]
 		if !ok {
-			log.Fatalf("Error finding ID for definition %v: %v\n", key, err)
-			return errors.New("ID not found")
+			return fmt.Errorf("ID of definition %s not found", key)
 		}
 
 		res, err := shopify.UpdateMetaobjectDefinition(context.Background(), *ms.ShopifyClient, id, input)
 		if err != nil {
-			log.Fatalf("Error updating definition %v: %v\n", key, err)
-			return err
+			return fmt.Errorf("updating definition %s: %w", key, err)
 		}
 
 		if len(res.MetaobjectDefinitionUpdate.UserErrors) > 0 {
-			log.Fatalf("Error updating definition %v: %v\n", key, res.MetaobjectDefinitionUpdate.UserErrors)
-			return errors.New("Error updating definition")
+			return fmt.Errorf("updating definition %s: %v", key, res.MetaobjectDefinitionUpdate.UserErrors)
 		}
 
-		log.Printf("Updated definition: %s\n", key)
+		ms.logf("Updated definition: %s\n", key)
+	}
+
+	return nil
+}
+
+// logf logs a change, naming the shop when the service has one.
+func (ms *MetaobjectService) logf(format string, v ...any) {
+	if ms.Shop != "" {
+		format = "[" + ms.Shop + "] " + format
+	}
+
+	log.Printf(format, v...)
+}
+
+// enableStandard enables a Shopify standard metaobject definition. The
+// definition takes the type of its template, so the local definition must
+// be keyed by it.
+func (ms *MetaobjectService) enableStandard(key string, template string) error {
+	if key != template {
+		return fmt.Errorf("the standard template %s enables type %s, not %s", template, template, key)
+	}
+
+	res, err := shopify.EnableStandardMetaobjectDefinition(context.Background(), *ms.ShopifyClient, template)
+	if err != nil {
+		return err
+	}
+
+	if len(res.StandardMetaobjectDefinitionEnable.UserErrors) > 0 {
+		return fmt.Errorf("%v", res.StandardMetaobjectDefinitionEnable.UserErrors)
 	}
 
 	return nil
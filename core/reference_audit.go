@@ -0,0 +1,167 @@
+package core
+
+import (
+	"sort"
+	"strings"
+)
+
+// ReferenceIssue is a reference field of a store entry which points at
+// deleted resources, or which lists the same resource more than once.
+type ReferenceIssue struct {
+	Type       string
+	Handle     string
+	Field      string
+	References []string
+}
+
+// UnreachableType is a type with store entries which no reference field of
+// the local definitions can point at.
+type UnreachableType struct {
+	Type    string
+	Entries int
+}
+
+// ReferenceAudit is the result of auditing the references of store entries.
+type ReferenceAudit struct {
+	Dangling    []ReferenceIssue
+	Duplicates  []ReferenceIssue
+	Unreachable []UnreachableType
+}
+
+// danglingReferences returns the GIDs of a field value which the store no
+// longer resolves to a resource.
+func danglingReferences(ids []string, references map[string]string) []string {
+	dangling := make([]string, 0)
+	for _, id := range ids {
+		if _, ok := references[id]; !ok && !contains(dangling, id) {
+			dangling = append(dangling, id)
+		}
+	}
+
+	return dangling
+}
+
+// duplicateReferences returns the keys of the resources listed more than once
+// in a list reference field.
+func duplicateReferences(ids []string, references map[string]string) []string {
+	seen := make(map[string]int, len(ids))
+	duplicates := make([]string, 0)
+
+	for _, id := range ids {
+		seen[id]++
+		if seen[id] != 2 {
+			continue
+		}
+
+		if key := references[id]; key != "" {
+			duplicates = append(duplicates, key)
+		} else {
+			duplicates = append(duplicates, id)
+		}
+	}
+
+	return duplicates
+}
+
+// reachableTypes returns the metaobject types which the reference fields of
+// the definitions, or the metafield definitions, can point at.
+func reachableTypes(definitions map[string]MetaobjectDefinition, metafieldDefinitions map[string]MetafieldOwnerDefinitions) map[string]bool {
+	reachable := make(map[string]bool)
+
+	for _, d := range definitions {
+		for _, f := range d.FieldDefinitions {
+			for _, defType := range referencedTypes(f.Validations) {
+				reachable[defType] = true
+			}
+		}
+	}
+
+	for _, owner := range metafieldDefinitions {
+		for _, d := range owner.Definitions {
+			for _, defType := range referencedTypes(d.Validations) {
+				reachable[defType] = true
+			}
+		}
+	}
+
+	return reachable
+}
+
+// AuditReferences scans the store entries of the defined types for reference
+// fields pointing at deleted metaobjects, products, variants or files, and
+// for list references holding duplicates. It also reports the types which no
+// reference field of the definitions or metafield definitions can reach.
+// Types with the online store capability are reachable through their URLs and
+// aren't reported.
+func (es *MetaobjectEntryService) AuditReferences(definitions map[string]MetaobjectDefinition, metafieldDefinitions map[string]MetafieldOwnerDefinitions) (ReferenceAudit, error) {
+	audit := ReferenceAudit{
+		Dangling:    make([]ReferenceIssue, 0),
+		Duplicates:  make([]ReferenceIssue, 0),
+		Unreachable: make([]UnreachableType, 0),
+	}
+
+	types := make([]string, 0, len(definitions))
+	for defType := range definitions {
+		types = append(types, defType)
+	}
+	sort.Strings(types)
+
+	reachable := reachableTypes(definitions, metafieldDefinitions)
+
+	for _, defType := range types {
+		metaobjects, err := es.ListEntries(defType)
+		if err != nil {
+			return ReferenceAudit{}, err
+		}
+
+		sort.Slice(metaobjects, func(i, j int) bool {
+			return metaobjects[i].Handle < metaobjects[j].Handle
+		})
+
+		references, err := metaobjectReferences(*es.ShopifyClient, metaobjects)
+		if err != nil {
+			return ReferenceAudit{}, err
+		}
+
+		for _, m := range metaobjects {
+			for _, f := range m.Fields {
+				if !isReferenceFieldType(f.Type) {
+					continue
+				}
+
+				ids := fieldReferenceIds(f)
+
+				if dangling := danglingReferences(ids, references); len(dangling) > 0 {
+					audit.Dangling = append(audit.Dangling, ReferenceIssue{
+						Type:       defType,
+						Handle:     m.Handle,
+						Field:      f.Key,
+						References: dangling,
+					})
+				}
+
+				if duplicates := duplicateReferences(ids, references); len(duplicates) > 0 {
+					audit.Duplicates = append(audit.Duplicates, ReferenceIssue{
+						Type:       defType,
+						Handle:     m.Handle,
+						Field:      f.Key,
+						References: duplicates,
+					})
+				}
+			}
+		}
+
+		if _, ok := entryUrlPrefix(defType, definitions[defType]); ok || reachable[defType] || len(metaobjects) == 0 {
+			continue
+		}
+
+		audit.Unreachable = append(audit.Unreachable, UnreachableType{Type: defType, Entries: len(metaobjects)})
+	}
+
+	return audit, nil
+}
+
+// String describes the issue as "<type>/<handle>.<field>: <references>".
+func (i ReferenceIssue) String() string {
+	return EntryKey(i.Type, i.Handle) + "." + i.Field + ": " + strings.Join(i.References, ", ")
+}
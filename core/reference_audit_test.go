@@ -0,0 +1,30 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReachableTypesIncludesMetafieldDefinitions(t *testing.T) {
+	definitions := map[string]MetaobjectDefinition{
+		"size_chart": {FieldDefinitions: map[string]FieldDefinition{
+			"fabric": {Type: "metaobject_reference", Validations: map[string]any{"metaobject_definition": "fabric"}},
+		}},
+	}
+	metafieldDefinitions := map[string]MetafieldOwnerDefinitions{
+		"PRODUCT": {Definitions: map[string]MetafieldDefinition{
+			"custom.size_chart": {Type: "metaobject_reference", Validations: map[string]any{"metaobject_definition": "size_chart"}},
+			"custom.badges":     {Type: "list.metaobject_reference", Validations: map[string]any{"metaobject_definitions": []any{"badge"}}},
+		}},
+	}
+
+	want := map[string]bool{"fabric": true, "size_chart": true, "badge": true}
+	if got := reachableTypes(definitions, metafieldDefinitions); !reflect.DeepEqual(got, want) {
+		t.Errorf("reachableTypes = %v, want %v", got, want)
+	}
+
+	want = map[string]bool{"fabric": true}
+	if got := reachableTypes(definitions, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("reachableTypes without metafield definitions = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,363 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/Khan/genqlient/graphql"
+)
+
+// Reference fields hold store specific GIDs. Entry files use stable keys in
+// their place, so the same entries can be pushed to any store:
+//
+//	metaobject_reference, mixed_reference  <type>/<handle>
+//	product_reference, collection_reference  <handle>
+//	variant_reference  <sku>
+//	file_reference  <file name>
+var referenceFieldTypes = map[string]bool{
+	"metaobject_reference": true,
+	"mixed_reference":      true,
+	"product_reference":    true,
+	"collection_reference": true,
+	"variant_reference":    true,
+	"file_reference":       true,
+}
+
+const gidPrefix = "gid://shopify/"
+
+var ErrReferenceNotFound = errors.New("reference not found")
+
+func baseFieldType(fieldType string) string {
+	return strings.TrimPrefix(fieldType, "list.")
+}
+
+func isReferenceFieldType(fieldType string) bool {
+	return referenceFieldTypes[baseFieldType(fieldType)]
+}
+
+// fileName returns the name of a file from its CDN url.
+func fileName(fileUrl string) string {
+	u, err := url.Parse(fileUrl)
+	if err != nil {
+		return ""
+	}
+
+	return path.Base(u.Path)
+}
+
+func fileKey(file shopify.Cli_File) string {
+	switch f := file.(type) {
+	case *shopify.Cli_FileGenericFile:
+		return fileName(f.Url)
+	case *shopify.Cli_FileMediaImage:
+		return fileName(f.Image.Url)
+	case *shopify.Cli_FileVideo:
+		return f.Filename
+	}
+
+	return ""
+}
+
+// nodeKey returns the stable key of a looked up reference, or "" for a
+// resource which entry files can't refer to by key.
+func nodeKey(node shopify.ReferenceNode) string {
+	switch node.Typename {
+	case "Metaobject":
+		return node.Type + "/" + node.Handle
+	case "Product", "Collection":
+		return node.Handle
+	case "ProductVariant":
+		return node.Sku
+	case "GenericFile":
+		return fileName(node.Url)
+	case "MediaImage":
+		return fileName(node.Image.Url)
+	case "Video":
+		return node.Filename
+	}
+
+	return ""
+}
+
+// referenceIds returns the GIDs held by the encoded value of a reference
+// field or metafield.
+func referenceIds(fieldType string, value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	if !isListFieldType(fieldType) {
+		return []string{value}
+	}
+
+	var ids []string
+	if err := json.Unmarshal([]byte(value), &ids); err != nil {
+		return nil
+	}
+
+	return ids
+}
+
+// fieldReferenceIds returns the GIDs held by the value of a reference field.
+func fieldReferenceIds(field shopify.Cli_MetaobjectFieldsMetaobjectField) []string {
+	return referenceIds(field.Type, field.Value)
+}
+
+// lookupReferences maps GIDs to the stable keys of the resources they
+// reference, 250 at a time. GIDs of deleted resources are left out, and
+// resources without a stable key map to "".
+func lookupReferences(client graphql.Client, ids []string) (map[string]string, error) {
+	keys := make(map[string]string, len(ids))
+
+	for start := 0; start < len(ids); start += 250 {
+		end := min(start+250, len(ids))
+
+		res, err := shopify.GetReferences(context.Background(), client, ids[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("looking up references: %w", err)
+		}
+
+		for _, n := range res.Nodes {
+			if n != nil {
+				keys[n.Id] = nodeKey(*n)
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// metaobjectReferences looks up the resources referenced by the reference
+// fields of the metaobjects, see lookupReferences.
+func metaobjectReferences(client graphql.Client, metaobjects []shopify.Cli_Metaobject) (map[string]string, error) {
+	seen := make(map[string]bool)
+	ids := make([]string, 0)
+
+	for _, m := range metaobjects {
+		for _, f := range m.Fields {
+			if !isReferenceFieldType(f.Type) {
+				continue
+			}
+
+			for _, id := range fieldReferenceIds(f) {
+				if !seen[id] {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+
+	return lookupReferences(client, ids)
+}
+
+// mapReferences applies fn to a single reference or to every reference in a
+// list value.
+func mapReferences(value any, fn func(string) (string, error)) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return fn(v)
+	case []any:
+		mapped := make([]any, len(v))
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid reference %v", item)
+			}
+
+			m, err := fn(s)
+			if err != nil {
+				return nil, err
+			}
+
+			mapped[i] = m
+		}
+
+		return mapped, nil
+	}
+
+	return nil, fmt.Errorf("invalid reference value %v", value)
+}
+
+// ReferenceResolver looks up the GIDs for the stable reference keys used in
+// entry files. Lookups are cached, and GIDs already seen on pulled entries
+// are reused without another request.
+type ReferenceResolver struct {
+	ShopifyClient *graphql.Client
+	ids           map[string]string
+}
+
+func (r *ReferenceResolver) cacheKey(fieldType string, key string) string {
+	kind := baseFieldType(fieldType)
+	if kind == "mixed_reference" {
+		kind = "metaobject_reference"
+	}
+
+	return kind + ":" + key
+}
+
+// Learn records the GIDs referenced by a pulled metaobject, given the keys
+// of its references.
+func (r *ReferenceResolver) Learn(metaobject shopify.Cli_Metaobject, references map[string]string) {
+	for _, f := range metaobject.Fields {
+		if !isReferenceFieldType(f.Type) {
+			continue
+		}
+
+		keys := make(map[string]string)
+		for _, id := range fieldReferenceIds(f) {
+			if key := references[id]; key != "" {
+				keys[id] = key
+			}
+		}
+
+		r.learnKeys(f.Type, keys)
+	}
+}
+
+// learnKeys records the GIDs of references of a field type by their keys.
+func (r *ReferenceResolver) learnKeys(fieldType string, keys map[string]string) {
+	if r.ids == nil {
+		r.ids = make(map[string]string)
+	}
+
+	for id, key := range keys {
+		r.ids[r.cacheKey(fieldType, key)] = id
+	}
+}
+
+func (r *ReferenceResolver) ResolveId(fieldType string, key string) (string, error) {
+	if strings.HasPrefix(key, gidPrefix) {
+		return key, nil
+	}
+
+	if r.ids == nil {
+		r.ids = make(map[string]string)
+	}
+
+	cacheKey := r.cacheKey(fieldType, key)
+	if id, ok := r.ids[cacheKey]; ok {
+		return id, nil
+	}
+
+	id, err := r.lookup(baseFieldType(fieldType), key)
+	if err != nil {
+		return "", err
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("%w: %s %s", ErrReferenceNotFound, baseFieldType(fieldType), key)
+	}
+
+	r.ids[cacheKey] = id
+
+	return id, nil
+}
+
+func (r *ReferenceResolver) lookup(fieldType string, key string) (string, error) {
+	ctx := context.Background()
+	client := *r.ShopifyClient
+
+	switch fieldType {
+	case "metaobject_reference", "mixed_reference":
+		defType, handle, ok := strings.Cut(key, "/")
+		if !ok {
+			return "", fmt.Errorf("metaobject reference %s must be <type>/<handle>", key)
+		}
+
+		res, err := shopify.GetMetaobjectByHandle(ctx, client, shopify.MetaobjectHandleInput{Type: defType, Handle: handle})
+		if err != nil {
+			return "", fmt.Errorf("looking up metaobject %s: %w", key, err)
+		}
+
+		return res.MetaobjectByHandle.Id, nil
+
+	case "product_reference":
+		res, err := shopify.GetProductByHandle(ctx, client, key)
+		if err != nil {
+			return "", fmt.Errorf("looking up product %s: %w", key, err)
+		}
+
+		return res.ProductByIdentifier.Id, nil
+
+	case "collection_reference":
+		res, err := shopify.GetCollectionByHandle(ctx, client, key)
+		if err != nil {
+			return "", fmt.Errorf("looking up collection %s: %w", key, err)
+		}
+
+		return res.CollectionByIdentifier.Id, nil
+
+	case "variant_reference":
+		res, err := shopify.FindProductVariants(ctx, client, fmt.Sprintf("sku:%q", key))
+		if err != nil {
+			return "", fmt.Errorf("looking up variant %s: %w", key, err)
+		}
+
+		id := ""
+		for _, v := range res.ProductVariants.Nodes {
+			if v.Sku != key {
+				continue
+			}
+
+			if id != "" {
+				return "", fmt.Errorf("more than one variant has SKU %s", key)
+			}
+
+			id = v.Id
+		}
+
+		return id, nil
+
+	case "file_reference":
+		res, err := shopify.FindFiles(ctx, client, fmt.Sprintf("filename:%q", key))
+		if err != nil {
+			return "", fmt.Errorf("looking up file %s: %w", key, err)
+		}
+
+		for _, f := range res.Files.Nodes {
+			if fileKey(f) == key {
+				return f.GetId(), nil
+			}
+		}
+
+		return "", nil
+	}
+
+	return "", fmt.Errorf("unsupported reference type %s", fieldType)
+}
+
+// ResolveEntry returns a copy of the entry with reference keys replaced by
+// the GIDs of the referenced resources.
+func (r *ReferenceResolver) ResolveEntry(entry MetaobjectEntry, fieldTypes map[string]string) (MetaobjectEntry, error) {
+	e := MetaobjectEntry{
+		Status: entry.Status,
+		Fields: make(map[string]any, len(entry.Fields)),
+	}
+
+	for key, value := range entry.Fields {
+		fieldType := fieldTypes[key]
+
+		if !isReferenceFieldType(fieldType) {
+			e.Fields[key] = value
+			continue
+		}
+
+		resolved, err := mapReferences(value, func(ref string) (string, error) {
+			return r.ResolveId(fieldType, ref)
+		})
+		if err != nil {
+			return MetaobjectEntry{}, fmt.Errorf("field %s: %w", key, err)
+		}
+
+		e.Fields[key] = resolved
+	}
+
+	return e, nil
+}
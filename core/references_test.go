@@ -0,0 +1,167 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/Khan/genqlient/graphql"
+)
+
+// nodesClient answers GetReferences from a fixed set of nodes, returning null
+// for unknown GIDs, and records the GIDs of each request.
+type nodesClient struct {
+	nodes    map[string]string
+	requests *[][]string
+}
+
+func (c nodesClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	b, err := json.Marshal(req.Variables)
+	if err != nil {
+		return err
+	}
+
+	var vars struct {
+		Ids []string `json:"ids"`
+	}
+	if err := json.Unmarshal(b, &vars); err != nil {
+		return err
+	}
+	*c.requests = append(*c.requests, vars.Ids)
+
+	nodes := make([]string, len(vars.Ids))
+	for i, id := range vars.Ids {
+		if n, ok := c.nodes[id]; ok {
+			nodes[i] = n
+		} else {
+			nodes[i] = "null"
+		}
+	}
+
+	return json.Unmarshal([]byte(`{"nodes":[`+strings.Join(nodes, ",")+`]}`), resp.Data)
+}
+
+func TestMetaobjectReferences(t *testing.T) {
+	var requests [][]string
+	client := nodesClient{
+		nodes: map[string]string{
+			"gid://shopify/Metaobject/1": `{"__typename":"Metaobject","id":"gid://shopify/Metaobject/1","type":"fabric","handle":"linen"}`,
+			"gid://shopify/Product/2":    `{"__typename":"Product","id":"gid://shopify/Product/2","handle":"shirt"}`,
+			"gid://shopify/MediaImage/3": `{"__typename":"MediaImage","id":"gid://shopify/MediaImage/3","image":{"url":"https://cdn.shopify.com/files/chart.png?v=1"}}`,
+			"gid://shopify/Page/4":       `{"__typename":"Page","id":"gid://shopify/Page/4"}`,
+		},
+		requests: &requests,
+	}
+
+	metaobjects := []shopify.Cli_Metaobject{{
+		Handle: "size-chart",
+		Fields: []shopify.Cli_MetaobjectFieldsMetaobjectField{
+			{Key: "title", Type: "single_line_text_field", Value: "gid://shopify/Product/9"},
+			{Key: "fabric", Type: "metaobject_reference", Value: "gid://shopify/Metaobject/1"},
+			{Key: "products", Type: "list.product_reference", Value: `["gid://shopify/Product/2","gid://shopify/Product/5"]`},
+			{Key: "image", Type: "file_reference", Value: "gid://shopify/MediaImage/3"},
+			{Key: "related", Type: "list.mixed_reference", Value: `["gid://shopify/Metaobject/1","gid://shopify/Page/4"]`},
+		},
+	}}
+
+	got, err := metaobjectReferences(client, metaobjects)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"gid://shopify/Metaobject/1": "fabric/linen",
+		"gid://shopify/Product/2":    "shirt",
+		"gid://shopify/MediaImage/3": "chart.png",
+		"gid://shopify/Page/4":       "",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("metaobjectReferences = %v, want %v", got, want)
+	}
+
+	if len(requests) != 1 || len(requests[0]) != 5 {
+		t.Errorf("requests = %v, want one request for the 5 distinct GIDs", requests)
+	}
+
+	entry := ConvertMetaobjectEntry(metaobjects[0], got)
+	wantFields := map[string]any{
+		"title":    "gid://shopify/Product/9",
+		"fabric":   "fabric/linen",
+		"products": []any{"shirt", "gid://shopify/Product/5"},
+		"image":    "chart.png",
+		"related":  []any{"fabric/linen", "gid://shopify/Page/4"},
+	}
+	if !reflect.DeepEqual(entry.Fields, wantFields) {
+		t.Errorf("ConvertMetaobjectEntry fields = %v, want %v", entry.Fields, wantFields)
+	}
+
+	if dangling := danglingReferences([]string{"gid://shopify/Product/2", "gid://shopify/Product/5", "gid://shopify/Page/4"}, got); !reflect.DeepEqual(dangling, []string{"gid://shopify/Product/5"}) {
+		t.Errorf("danglingReferences = %v, want the deleted product", dangling)
+	}
+}
+
+func TestLookupReferencesBatches(t *testing.T) {
+	var requests [][]string
+	client := nodesClient{requests: &requests}
+
+	ids := make([]string, 300)
+	for i := range ids {
+		ids[i] = "gid://shopify/Product/" + strings.Repeat("1", i+1)
+	}
+
+	if _, err := lookupReferences(client, ids); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 2 || len(requests[0]) != 250 || len(requests[1]) != 50 {
+		t.Errorf("looked up %d batches, want batches of 250 and 50", len(requests))
+	}
+}
+
+func TestMetafieldReferences(t *testing.T) {
+	var requests [][]string
+	client := nodesClient{
+		nodes: map[string]string{
+			"gid://shopify/Metaobject/1": `{"__typename":"Metaobject","id":"gid://shopify/Metaobject/1","type":"fabric","handle":"linen"}`,
+			"gid://shopify/Product/2":    `{"__typename":"Product","id":"gid://shopify/Product/2","handle":"shirt"}`,
+		},
+		requests: &requests,
+	}
+
+	resources := []metafieldResource{
+		{Handle: "shirt", Metafields: []shopify.Cli_Metafield{
+			{Namespace: "custom", Key: "fabric", Type: "metaobject_reference", Value: "gid://shopify/Metaobject/1"},
+			{Namespace: "custom", Key: "related", Type: "list.product_reference", Value: `["gid://shopify/Product/2","gid://shopify/Product/5"]`},
+		}},
+		{Handle: "dress", Metafields: []shopify.Cli_Metafield{
+			{Namespace: "custom", Key: "fabric", Type: "metaobject_reference", Value: "gid://shopify/Metaobject/1"},
+			{Namespace: "custom", Key: "care", Type: "single_line_text_field", Value: "gid://shopify/Product/9"},
+		}},
+	}
+
+	references, err := metafieldReferences(client, resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 1 || len(requests[0]) != 3 {
+		t.Errorf("requests = %v, want one request for the 3 distinct GIDs", requests)
+	}
+
+	ms := &MetafieldService{}
+	values, _ := ms.convertResource(resources[0], references)
+	want := MetafieldValues{
+		"custom.fabric":  "fabric/linen",
+		"custom.related": []any{"shirt", "gid://shopify/Product/5"},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("convertResource = %v, want %v", values, want)
+	}
+
+	if id, err := ms.resolver().ResolveId("metaobject_reference", "fabric/linen"); err != nil || id != "gid://shopify/Metaobject/1" {
+		t.Errorf("ResolveId = %q, %v, want the pulled GID", id, err)
+	}
+}
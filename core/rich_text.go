@@ -0,0 +1,599 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Rich text fields hold a JSON tree of paragraphs, headings and lists made of
+// text and links. Entry files hold the same content as Markdown, limited to
+// the constructs rich text can express:
+//
+//	# Heading             headings, levels 1 to 6
+//	- item, 1. item       bulleted and numbered lists, without nesting
+//	**bold**, _italic_    emphasis, which may be combined
+//	[text](url "title")   links
+//
+// Rich text which can't be written as Markdown without losing information,
+// such as links opening in a new tab, is kept as JSON in the entry file.
+
+type richTextMarks struct {
+	bold   bool
+	italic bool
+}
+
+// markdownBlock is a paragraph or list item, as the lines it was written on.
+type markdownBlock struct {
+	line  int
+	lines []string
+}
+
+var (
+	markdownHeading       = regexp.MustCompile(`^(#{1,6})(?:\s+(.*))?$`)
+	markdownBulletItem    = regexp.MustCompile(`^[-+*]\s+(.*)$`)
+	markdownOrderedItem   = regexp.MustCompile(`^\d+[.)]\s+(.*)$`)
+	markdownThematicBreak = regexp.MustCompile(`^(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	markdownHtml          = regexp.MustCompile(`^</?[a-zA-Z]`)
+	markdownLineStart     = regexp.MustCompile(`(?m)^([#>|+\-]|\d+[.)])`)
+)
+
+// isEncodedRichText reports whether a string value already holds rich text
+// JSON rather than Markdown.
+func isEncodedRichText(value string) bool {
+	return strings.HasPrefix(strings.TrimSpace(value), "{") && json.Valid([]byte(value))
+}
+
+// richTextMarkdown returns the Markdown for a decoded rich text value, or
+// false when converting it back wouldn't give the same rich text.
+func richTextMarkdown(value any) (string, bool) {
+	root, ok := value.(map[string]any)
+	if !ok {
+		return "", false
+	}
+
+	md, err := richTextToMarkdown(root)
+	if err != nil || md == "" {
+		return "", false
+	}
+
+	parsed, err := markdownToRichText(md)
+	if err != nil {
+		return "", false
+	}
+
+	a, errA := json.Marshal(parsed)
+	b, errB := json.Marshal(root)
+
+	return md, errA == nil && errB == nil && string(a) == string(b)
+}
+
+func richTextChildren(node map[string]any) ([]map[string]any, error) {
+	children, ok := node["children"].([]any)
+	if !ok {
+		return nil, fmt.Errorf("rich text %v node has no children", node["type"])
+	}
+
+	nodes := make([]map[string]any, len(children))
+	for i, c := range children {
+		n, ok := c.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid rich text node %v", c)
+		}
+
+		nodes[i] = n
+	}
+
+	return nodes, nil
+}
+
+func richTextToMarkdown(root map[string]any) (string, error) {
+	if root["type"] != "root" {
+		return "", fmt.Errorf("expected rich text root node, got %v", root["type"])
+	}
+
+	children, err := richTextChildren(root)
+	if err != nil {
+		return "", err
+	}
+
+	blocks := make([]string, 0, len(children))
+	for _, child := range children {
+		block, err := renderRichTextBlock(child)
+		if err != nil {
+			return "", err
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return strings.Join(blocks, "\n\n"), nil
+}
+
+func renderRichTextBlock(node map[string]any) (string, error) {
+	children, err := richTextChildren(node)
+	if err != nil {
+		return "", err
+	}
+
+	switch node["type"] {
+	case "paragraph":
+		return renderRichTextInline(children)
+
+	case "heading":
+		level, ok := toFloat(node["level"])
+		if !ok || level < 1 || level > 6 || level != float64(int(level)) {
+			return "", fmt.Errorf("invalid heading level %v", node["level"])
+		}
+
+		text, err := renderRichTextInline(children)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.Repeat("#", int(level)) + " " + text, nil
+
+	case "list":
+		items := make([]string, len(children))
+
+		for i, item := range children {
+			if item["type"] != "list-item" {
+				return "", fmt.Errorf("unsupported rich text list node %v", item["type"])
+			}
+
+			itemChildren, err := richTextChildren(item)
+			if err != nil {
+				return "", err
+			}
+
+			text, err := renderRichTextInline(itemChildren)
+			if err != nil {
+				return "", err
+			}
+
+			marker := "- "
+			if node["listType"] == "ordered" {
+				marker = fmt.Sprintf("%d. ", i+1)
+			}
+
+			items[i] = marker + text
+		}
+
+		return strings.Join(items, "\n"), nil
+	}
+
+	return "", fmt.Errorf("unsupported rich text node %v", node["type"])
+}
+
+func renderRichTextInline(nodes []map[string]any) (string, error) {
+	var b strings.Builder
+
+	for _, n := range nodes {
+		switch n["type"] {
+		case "text":
+			value, _ := n["value"].(string)
+			b.WriteString(renderRichTextText(value, richTextMarks{bold: n["bold"] == true, italic: n["italic"] == true}))
+
+		case "link":
+			url, _ := n["url"].(string)
+
+			children, err := richTextChildren(n)
+			if err != nil {
+				return "", err
+			}
+
+			text, err := renderRichTextInline(children)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString("[" + text + "](" + url)
+			if title, ok := n["title"].(string); ok && title != "" {
+				b.WriteString(` "` + strings.ReplaceAll(title, `"`, `\"`) + `"`)
+			}
+			b.WriteString(")")
+
+		default:
+			return "", fmt.Errorf("unsupported rich text node %v", n["type"])
+		}
+	}
+
+	// Text starting a line mustn't be read back as a heading or list item.
+	return markdownLineStart.ReplaceAllStringFunc(b.String(), func(s string) string {
+		if len(s) > 1 {
+			return s[:len(s)-1] + `\` + s[len(s)-1:]
+		}
+		return `\` + s
+	}), nil
+}
+
+func renderRichTextText(value string, marks richTextMarks) string {
+	escaped := strings.NewReplacer(
+		`\`, `\\`,
+		"*", `\*`,
+		"_", `\_`,
+		"[", `\[`,
+		"]", `\]`,
+		"`", "\\`",
+		"\n", "\\\n",
+	).Replace(value)
+
+	core := strings.TrimSpace(escaped)
+	if core == "" || (!marks.bold && !marks.italic) {
+		return escaped
+	}
+
+	start := strings.Index(escaped, core)
+	delim := ""
+	if marks.bold {
+		delim += "**"
+	}
+	if marks.italic {
+		delim += "_"
+	}
+
+	return escaped[:start] + delim + core + reverseString(delim) + escaped[start+len(core):]
+}
+
+func reverseString(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+
+	return string(r)
+}
+
+// markdownToRichText converts Markdown to rich text, failing on constructs
+// which rich text can't express.
+func markdownToRichText(md string) (map[string]any, error) {
+	blocks := make([]any, 0)
+
+	var paragraph *markdownBlock
+	var listItems []*markdownBlock
+	ordered := false
+	blank := false
+
+	flushParagraph := func() error {
+		if paragraph == nil {
+			return nil
+		}
+
+		children, err := parseMarkdownBlock(paragraph)
+		if err != nil {
+			return err
+		}
+
+		blocks = append(blocks, map[string]any{"type": "paragraph", "children": children})
+		paragraph = nil
+		return nil
+	}
+
+	flushList := func() error {
+		if listItems == nil {
+			return nil
+		}
+
+		items := make([]any, len(listItems))
+		for i, item := range listItems {
+			children, err := parseMarkdownBlock(item)
+			if err != nil {
+				return err
+			}
+
+			items[i] = map[string]any{"type": "list-item", "children": children}
+		}
+
+		listType := "unordered"
+		if ordered {
+			listType = "ordered"
+		}
+
+		blocks = append(blocks, map[string]any{"type": "list", "listType": listType, "children": items})
+		listItems = nil
+		return nil
+	}
+
+	lines := strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n")
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			if err := flushParagraph(); err != nil {
+				return nil, err
+			}
+			blank = true
+			continue
+		}
+
+		indented := strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t")
+		nested := line != strings.TrimLeft(line, " \t")
+		continuation := paragraph != nil || (listItems != nil && !blank)
+
+		bullet := markdownBulletItem.FindStringSubmatch(trimmed)
+		numbered := markdownOrderedItem.FindStringSubmatch(trimmed)
+		isItem := (bullet != nil || numbered != nil) && !markdownThematicBreak.MatchString(trimmed)
+
+		if err := unsupportedMarkdownBlock(trimmed, isItem && nested, indented && !continuation); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch heading := markdownHeading.FindStringSubmatch(trimmed); {
+		case heading != nil:
+			if err := flushParagraph(); err != nil {
+				return nil, err
+			}
+			if err := flushList(); err != nil {
+				return nil, err
+			}
+
+			children, err := parseMarkdownBlock(&markdownBlock{line: i + 1, lines: []string{heading[2]}})
+			if err != nil {
+				return nil, err
+			}
+
+			blocks = append(blocks, map[string]any{"type": "heading", "level": len(heading[1]), "children": children})
+
+		case isItem:
+			if err := flushParagraph(); err != nil {
+				return nil, err
+			}
+
+			text := ""
+			if bullet != nil {
+				text = bullet[1]
+			} else {
+				text = numbered[1]
+			}
+
+			if listItems != nil && ordered != (bullet == nil) {
+				if err := flushList(); err != nil {
+					return nil, err
+				}
+			}
+
+			ordered = bullet == nil
+			listItems = append(listItems, &markdownBlock{line: i + 1, lines: []string{text}})
+
+		case listItems != nil && !blank:
+			last := listItems[len(listItems)-1]
+			last.lines = append(last.lines, trimmed)
+
+		default:
+			if err := flushList(); err != nil {
+				return nil, err
+			}
+
+			if paragraph == nil {
+				paragraph = &markdownBlock{line: i + 1}
+			}
+			paragraph.lines = append(paragraph.lines, trimmed)
+		}
+
+		blank = false
+	}
+
+	if err := flushParagraph(); err != nil {
+		return nil, err
+	}
+	if err := flushList(); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"type": "root", "children": blocks}, nil
+}
+
+func unsupportedMarkdownBlock(line string, nestedItem bool, indentedCode bool) error {
+	construct := ""
+
+	switch {
+	case strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~") || indentedCode:
+		construct = "code blocks"
+	case strings.HasPrefix(line, ">"):
+		construct = "blockquotes"
+	case markdownThematicBreak.MatchString(line):
+		construct = "horizontal rules"
+	case strings.HasPrefix(line, "|"):
+		construct = "tables"
+	case markdownHtml.MatchString(line):
+		construct = "HTML"
+	case nestedItem:
+		construct = "nested lists"
+	default:
+		return nil
+	}
+
+	return fmt.Errorf("%s can't be expressed in rich text", construct)
+}
+
+// parseMarkdownBlock parses the inline content of a block. Lines ending in a
+// backslash are joined with a line break, other lines with a space.
+func parseMarkdownBlock(block *markdownBlock) ([]any, error) {
+	var b strings.Builder
+
+	for i, line := range block.lines {
+		if i == len(block.lines)-1 {
+			b.WriteString(line)
+			break
+		}
+
+		trailing := len(line) - len(strings.TrimRight(line, `\`))
+		if trailing%2 == 1 {
+			b.WriteString(line[:len(line)-1] + "\n")
+		} else {
+			b.WriteString(line + " ")
+		}
+	}
+
+	nodes, marks, err := parseMarkdownInline(b.String(), richTextMarks{})
+	if err == nil && marks.bold {
+		err = errors.New("unclosed ** emphasis")
+	}
+	if err == nil && marks.italic {
+		err = errors.New("unclosed _ emphasis")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", block.line, err)
+	}
+
+	return nodes, nil
+}
+
+// parseMarkdownInline parses text, emphasis and links, starting with the
+// given emphasis, and returns the emphasis still open at the end.
+func parseMarkdownInline(s string, marks richTextMarks) ([]any, richTextMarks, error) {
+	nodes := make([]any, 0)
+	var text strings.Builder
+
+	flush := func() {
+		if text.Len() == 0 {
+			return
+		}
+
+		n := map[string]any{"type": "text", "value": text.String()}
+		if marks.bold {
+			n["bold"] = true
+		}
+		if marks.italic {
+			n["italic"] = true
+		}
+
+		nodes = append(nodes, n)
+		text.Reset()
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '\\' && i+1 < len(s) && isAsciiPunct(s[i+1]):
+			text.WriteByte(s[i+1])
+			i++
+
+		case c == '`':
+			return nil, marks, errors.New("inline code can't be expressed in rich text")
+
+		case strings.HasPrefix(s[i:], "~~"):
+			return nil, marks, errors.New("strikethrough can't be expressed in rich text")
+
+		case strings.HasPrefix(s[i:], "!["):
+			return nil, marks, errors.New("images can't be expressed in rich text")
+
+		case c == '*' || (c == '_' && !isIntraword(s, i)):
+			n := 1
+			for n < 3 && i+n < len(s) && (s[i+n] == '*' || s[i+n] == '_') {
+				n++
+			}
+
+			flush()
+			if n >= 2 {
+				marks.bold = !marks.bold
+			}
+			if n != 2 {
+				marks.italic = !marks.italic
+			}
+			i += n - 1
+
+		case c == '[':
+			label, url, title, end, ok := parseMarkdownLink(s, i)
+			if !ok {
+				text.WriteByte(c)
+				continue
+			}
+
+			children, labelMarks, err := parseMarkdownInline(label, marks)
+			if err != nil {
+				return nil, marks, err
+			}
+			if labelMarks != marks {
+				return nil, marks, fmt.Errorf("emphasis in link text [%s] must be closed inside the link", label)
+			}
+
+			flush()
+			link := map[string]any{"type": "link", "url": url, "children": children}
+			if title != "" {
+				link["title"] = title
+			}
+			nodes = append(nodes, link)
+			i = end - 1
+
+		default:
+			text.WriteByte(c)
+		}
+	}
+
+	flush()
+
+	return nodes, marks, nil
+}
+
+// parseMarkdownLink parses a [label](url "title") link starting at i, and
+// returns the index just past it.
+func parseMarkdownLink(s string, i int) (label string, url string, title string, end int, ok bool) {
+	depth := 0
+	j := i
+
+	for ; j < len(s); j++ {
+		if s[j] == '\\' {
+			j++
+			continue
+		}
+		if s[j] == '[' {
+			depth++
+		}
+		if s[j] == ']' {
+			depth--
+			if depth == 0 {
+				break
+			}
+		}
+	}
+
+	if j >= len(s)-1 || s[j+1] != '(' {
+		return "", "", "", 0, false
+	}
+
+	k := j + 2
+	for ; k < len(s); k++ {
+		if s[k] == '\\' {
+			k++
+			continue
+		}
+		if s[k] == ')' {
+			break
+		}
+	}
+
+	if k >= len(s) {
+		return "", "", "", 0, false
+	}
+
+	target := strings.TrimSpace(s[j+2 : k])
+	url, rest, _ := strings.Cut(target, " ")
+	rest = strings.TrimSpace(rest)
+
+	if rest != "" {
+		if len(rest) < 2 || rest[0] != '"' || rest[len(rest)-1] != '"' {
+			return "", "", "", 0, false
+		}
+		title = strings.ReplaceAll(rest[1:len(rest)-1], `\"`, `"`)
+	}
+
+	return s[i+1 : j], url, title, k + 1, true
+}
+
+func isAsciiPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+// isIntraword reports whether the character at i is between two word
+// characters, like the underscore in snake_case, which isn't emphasis.
+func isIntraword(s string, i int) bool {
+	isWord := func(c byte) bool {
+		return c >= 0x80 || c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+	}
+
+	return i > 0 && i < len(s)-1 && isWord(s[i-1]) && isWord(s[i+1]) && s[i-1] != '_' && s[i+1] != '_'
+}
@@ -0,0 +1,245 @@
+package core
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// canonicalJson marshals a value with sorted keys, so rich text built by the
+// parser compares equal to rich text decoded from JSON.
+func canonicalJson(t *testing.T, value any) string {
+	t.Helper()
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err = json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(b)
+}
+
+func TestRichTextRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		richText string
+	}{
+		{
+			"headings",
+			"# Title\n\n### Section\n\nSome text",
+			`{"type":"root","children":[
+				{"type":"heading","level":1,"children":[{"type":"text","value":"Title"}]},
+				{"type":"heading","level":3,"children":[{"type":"text","value":"Section"}]},
+				{"type":"paragraph","children":[{"type":"text","value":"Some text"}]}]}`,
+		},
+		{
+			"lists",
+			"- one\n- two\n\n1. first\n2. second",
+			`{"type":"root","children":[
+				{"type":"list","listType":"unordered","children":[
+					{"type":"list-item","children":[{"type":"text","value":"one"}]},
+					{"type":"list-item","children":[{"type":"text","value":"two"}]}]},
+				{"type":"list","listType":"ordered","children":[
+					{"type":"list-item","children":[{"type":"text","value":"first"}]},
+					{"type":"list-item","children":[{"type":"text","value":"second"}]}]}]}`,
+		},
+		{
+			"emphasis",
+			"**bold**, _italic_ and **_both_**",
+			`{"type":"root","children":[{"type":"paragraph","children":[
+				{"type":"text","value":"bold","bold":true},
+				{"type":"text","value":", "},
+				{"type":"text","value":"italic","italic":true},
+				{"type":"text","value":" and "},
+				{"type":"text","value":"both","bold":true,"italic":true}]}]}`,
+		},
+		{
+			"links",
+			"See [the **docs**](https://example.com/docs \"Read \\\"me\\\"\") or [home](https://example.com)",
+			`{"type":"root","children":[{"type":"paragraph","children":[
+				{"type":"text","value":"See "},
+				{"type":"link","url":"https://example.com/docs","title":"Read \"me\"","children":[
+					{"type":"text","value":"the "},
+					{"type":"text","value":"docs","bold":true}]},
+				{"type":"text","value":" or "},
+				{"type":"link","url":"https://example.com","children":[{"type":"text","value":"home"}]}]}]}`,
+		},
+		{
+			"list item with link and emphasis",
+			"- _Care:_ see [guide](https://example.com/care)",
+			`{"type":"root","children":[{"type":"list","listType":"unordered","children":[
+				{"type":"list-item","children":[
+					{"type":"text","value":"Care:","italic":true},
+					{"type":"text","value":" see "},
+					{"type":"link","url":"https://example.com/care","children":[{"type":"text","value":"guide"}]}]}]}]}`,
+		},
+		{
+			"escapes",
+			"1\\. not a list, snake\\_case and \\*stars\\*",
+			`{"type":"root","children":[{"type":"paragraph","children":[
+				{"type":"text","value":"1. not a list, snake_case and *stars*"}]}]}`,
+		},
+		{
+			"line breaks",
+			"first\\\nsecond",
+			`{"type":"root","children":[{"type":"paragraph","children":[
+				{"type":"text","value":"first\nsecond"}]}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		var want any
+		if err := json.Unmarshal([]byte(tt.richText), &want); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		root, err := markdownToRichText(tt.markdown)
+		if err != nil {
+			t.Errorf("%s: markdownToRichText: %v", tt.name, err)
+			continue
+		}
+
+		if got, want := canonicalJson(t, root), canonicalJson(t, want); got != want {
+			t.Errorf("%s: markdownToRichText = %s, want %s", tt.name, got, want)
+		}
+
+		md, ok := richTextMarkdown(want)
+		if !ok || md != tt.markdown {
+			t.Errorf("%s: richTextMarkdown = %q, %v, want %q", tt.name, md, ok, tt.markdown)
+		}
+	}
+}
+
+func TestMarkdownToRichTextParsesLooseMarkdown(t *testing.T) {
+	tests := []struct {
+		markdown string
+		want     string
+	}{
+		{"* one\n+ two", "- one\n- two"},
+		{"1) first\n7. second", "1. first\n2. second"},
+		{"__bold__ *italic*", "**bold** _italic_"},
+		{"a paragraph\nover two lines", "a paragraph over two lines"},
+		{"- an item\n  continued", "- an item continued"},
+		{"snake_case", "snake\\_case"},
+	}
+
+	for _, tt := range tests {
+		root, err := markdownToRichText(tt.markdown)
+		if err != nil {
+			t.Errorf("markdownToRichText(%q): %v", tt.markdown, err)
+			continue
+		}
+
+		md, err := richTextToMarkdown(root)
+		if err != nil {
+			t.Errorf("richTextToMarkdown(%q): %v", tt.markdown, err)
+			continue
+		}
+
+		if md != tt.want {
+			t.Errorf("markdown %q is written as %q, want %q", tt.markdown, md, tt.want)
+		}
+	}
+}
+
+func TestMarkdownToRichTextUnsupported(t *testing.T) {
+	tests := []struct {
+		markdown string
+		err      string
+	}{
+		{"- one\n  - nested", "line 2: nested lists can't be expressed in rich text"},
+		{"1. one\n   1. nested", "line 2: nested lists can't be expressed in rich text"},
+		{"```\ncode\n```", "line 1: code blocks can't be expressed in rich text"},
+		{"text\n\n    indented code", "line 3: code blocks can't be expressed in rich text"},
+		{"> quoted", "line 1: blockquotes can't be expressed in rich text"},
+		{"above\n\n---", "line 3: horizontal rules can't be expressed in rich text"},
+		{"| a | b |", "line 1: tables can't be expressed in rich text"},
+		{"<div>html</div>", "line 1: HTML can't be expressed in rich text"},
+		{"some `code`", "line 1: inline code can't be expressed in rich text"},
+		{"~~gone~~", "line 1: strikethrough can't be expressed in rich text"},
+		{"![alt](cover.png)", "line 1: images can't be expressed in rich text"},
+		{"first\n\n**never closed", "line 3: unclosed ** emphasis"},
+		{"_never closed", "line 1: unclosed _ emphasis"},
+		{"[**bold](https://example.com) text**", "emphasis in link text [**bold] must be closed inside the link"},
+	}
+
+	for _, tt := range tests {
+		_, err := markdownToRichText(tt.markdown)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("markdownToRichText(%q) = %v, want error %q", tt.markdown, err, tt.err)
+		}
+	}
+}
+
+func TestRichTextMarkdownKeepsJson(t *testing.T) {
+	tests := []struct {
+		name     string
+		richText string
+	}{
+		{
+			"link opening in a new tab",
+			`{"type":"root","children":[{"type":"paragraph","children":[
+				{"type":"link","url":"https://example.com","target":"_blank","children":[{"type":"text","value":"home"}]}]}]}`,
+		},
+		{
+			"nested list",
+			`{"type":"root","children":[{"type":"list","listType":"unordered","children":[
+				{"type":"list-item","children":[{"type":"list","listType":"unordered","children":[
+					{"type":"list-item","children":[{"type":"text","value":"nested"}]}]}]}]}]}`,
+		},
+		{
+			"unknown node",
+			`{"type":"root","children":[{"type":"blockquote","children":[{"type":"text","value":"quoted"}]}]}`,
+		},
+		{
+			"text with trailing whitespace in emphasis",
+			`{"type":"root","children":[{"type":"paragraph","children":[{"type":"text","value":"bold ","bold":true}]}]}`,
+		},
+		{
+			"empty",
+			`{"type":"root","children":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		var value any
+		if err := json.Unmarshal([]byte(tt.richText), &value); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		if md, ok := richTextMarkdown(value); ok {
+			t.Errorf("%s: richTextMarkdown = %q, want the rich text kept as JSON", tt.name, md)
+		}
+	}
+}
+
+func TestRichTextFieldValues(t *testing.T) {
+	encoded, err := encodeFieldValue("rich_text_field", "# Title\n\n- **one**")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded := decodeFieldValue("rich_text_field", encoded); decoded != "# Title\n\n- **one**" {
+		t.Errorf("decodeFieldValue = %v, want the Markdown back", decoded)
+	}
+
+	raw := `{"type":"root","children":[{"type":"paragraph","children":[{"type":"link","url":"https://example.com","target":"_blank","children":[{"type":"text","value":"home"}]}]}]}`
+	if encoded, err := encodeFieldValue("rich_text_field", raw); err != nil || encoded != raw {
+		t.Errorf("encodeFieldValue of rich text JSON = %q, %v, want it unchanged", encoded, err)
+	}
+
+	if _, err := encodeFieldValue("rich_text_field", "> quoted"); err == nil {
+		t.Errorf("encodeFieldValue of a blockquote succeeded, want an error")
+	}
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"sync"
+)
+
+// RolloutStatus is the outcome of pushing definitions to one shop.
+type RolloutStatus string
+
+const (
+	RolloutPushed RolloutStatus = "pushed"
+	RolloutInSync RolloutStatus = "in-sync"
+	RolloutFailed RolloutStatus = "failed"
+	// RolloutSkipped is a shop left alone after another shop failed.
+	RolloutSkipped RolloutStatus = "skipped"
+)
+
+// ShopRollout is the result of pushing definitions to one shop, with the
+// number of definitions which differed from the shop.
+type ShopRollout struct {
+	Shop    string
+	Status  RolloutStatus
+	Changed int
+	Error   error
+}
+
+// Rollout plans and pushes the same definitions to several shops, at most
+// parallel at a time, in the order of shops. With failFast, the first shop is
+// pushed on its own before the others start, and shops which haven't started
+// when a shop fails are skipped. The results are in the order of shops.
+func Rollout(shops []string, services map[string]*MetaobjectService, definitions map[string]MetaobjectDefinition, parallel int, failFast bool) []ShopRollout {
+	results := make([]ShopRollout, len(shops))
+	slots := make(chan struct{}, max(parallel, 1))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	failed := false
+
+	for i, shop := range shops {
+		// The first shop is a canary for the rest of the rollout.
+		if failFast && i == 1 {
+			wg.Wait()
+		}
+
+		slots <- struct{}{}
+
+		mu.Lock()
+		skip := failFast && failed
+		mu.Unlock()
+
+		if skip {
+			results[i] = ShopRollout{Shop: shop, Status: RolloutSkipped}
+			<-slots
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			r := pushShop(shop, services[shop], definitions)
+			results[i] = r
+
+			if r.Status == RolloutFailed {
+				mu.Lock()
+				failed = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+func pushShop(shop string, ms *MetaobjectService, definitions map[string]MetaobjectDefinition) ShopRollout {
+	r := ShopRollout{Shop: shop}
+
+	diffs, err := ms.Diff(definitions)
+	if err != nil {
+		r.Status, r.Error = RolloutFailed, err
+		return r
+	}
+
+	r.Changed = len(diffs)
+	if r.Changed == 0 {
+		r.Status = RolloutInSync
+		return r
+	}
+
+	if err := ms.Push(definitions); err != nil {
+		r.Status, r.Error = RolloutFailed, err
+		return r
+	}
+
+	r.Status = RolloutPushed
+	return r
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// failingClient counts and fails every request, after a moment so shops
+// would overlap if they ran concurrently.
+type failingClient struct {
+	mu      *sync.Mutex
+	started *int
+}
+
+func (c failingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	c.mu.Lock()
+	*c.started++
+	c.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	return errors.New("boom")
+}
+
+func rolloutServices(shops []string) (map[string]*MetaobjectService, *int) {
+	var mu sync.Mutex
+	started := 0
+
+	services := make(map[string]*MetaobjectService, len(shops))
+	for _, shop := range shops {
+		var client graphql.Client = failingClient{mu: &mu, started: &started}
+		services[shop] = &MetaobjectService{ShopifyClient: &client, Shop: shop}
+	}
+
+	return services, &started
+}
+
+func TestRolloutFailFastStopsAfterFirstShop(t *testing.T) {
+	shops := []string{"a", "b", "c", "d"}
+	services, started := rolloutServices(shops)
+
+	results := Rollout(shops, services, map[string]MetaobjectDefinition{}, 4, true)
+
+	if results[0].Status != RolloutFailed {
+		t.Errorf("shop a: %v", results[0].Status)
+	}
+	for _, r := range results[1:] {
+		if r.Status != RolloutSkipped {
+			t.Errorf("shop %s: %v, want skipped", r.Shop, r.Status)
+		}
+	}
+	if *started != 1 {
+		t.Errorf("%d shops were contacted, want 1", *started)
+	}
+}
+
+func TestRolloutWithoutFailFastTriesEveryShop(t *testing.T) {
+	shops := []string{"a", "b", "c"}
+	services, started := rolloutServices(shops)
+
+	results := Rollout(shops, services, map[string]MetaobjectDefinition{}, 2, false)
+
+	for _, r := range results {
+		if r.Status != RolloutFailed || r.Error == nil {
+			t.Errorf("shop %s: %v %v, want failed", r.Shop, r.Status, r.Error)
+		}
+	}
+	if *started != 3 {
+		t.Errorf("%d shops were contacted, want 3", *started)
+	}
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/JohnnyMcGee/metadef/shopify"
+	"github.com/Khan/genqlient/graphql"
+)
+
+type StagedUploadTarget = shopify.CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget
+
+// StagedUpload reserves a staged upload target and uploads content to it.
+func StagedUpload(client graphql.Client, input shopify.StagedUploadInput, content []byte) (StagedUploadTarget, error) {
+	input.HttpMethod = shopify.StagedUploadHttpMethodTypePost
+
+	res, err := shopify.CreateStagedUploads(context.Background(), client, []shopify.StagedUploadInput{input})
+	if err != nil {
+		return StagedUploadTarget{}, fmt.Errorf("creating staged upload for %s: %w", input.Filename, err)
+	}
+
+	if len(res.StagedUploadsCreate.UserErrors) > 0 {
+		return StagedUploadTarget{}, fmt.Errorf("creating staged upload for %s: %v", input.Filename, res.StagedUploadsCreate.UserErrors)
+	}
+
+	if len(res.StagedUploadsCreate.StagedTargets) == 0 {
+		return StagedUploadTarget{}, errors.New("no staged upload target returned for " + input.Filename)
+	}
+
+	target := res.StagedUploadsCreate.StagedTargets[0]
+
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+
+	for _, p := range target.Parameters {
+		if err := form.WriteField(p.Name, p.Value); err != nil {
+			return StagedUploadTarget{}, err
+		}
+	}
+
+	file, err := form.CreateFormFile("file", input.Filename)
+	if err != nil {
+		return StagedUploadTarget{}, err
+	}
+
+	if _, err := file.Write(content); err != nil {
+		return StagedUploadTarget{}, err
+	}
+
+	if err := form.Close(); err != nil {
+		return StagedUploadTarget{}, err
+	}
+
+	resp, err := http.Post(target.Url, form.FormDataContentType(), body)
+	if err != nil {
+		return StagedUploadTarget{}, fmt.Errorf("uploading %s: %w", input.Filename, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(resp.Body)
+		return StagedUploadTarget{}, fmt.Errorf("uploading %s: %s: %s", input.Filename, resp.Status, msg)
+	}
+
+	return target, nil
+}
+
+// stagedUploadPath returns the path bulk operations expect, which is the key
+// parameter of the staged upload target.
+func stagedUploadPath(target StagedUploadTarget) string {
+	for _, p := range target.Parameters {
+		if p.Name == "key" {
+			return p.Value
+		}
+	}
+
+	return ""
+}
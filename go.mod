@@ -3,6 +3,7 @@ module github.com/JohnnyMcGee/metadef
 go 1.24.2
 
 require (
+	github.com/BurntSushi/toml v1.6.0
 	github.com/Khan/genqlient v0.8.0
 	github.com/hjson/hjson-go/v4 v4.4.0
 	github.com/sergi/go-diff v1.3.1
@@ -4,1068 +4,8488 @@ package shopify
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
 )
 
-// Cli_MetaobjectDefinition includes the GraphQL fields of MetaobjectDefinition requested by the fragment Cli_MetaobjectDefinition.
+// BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayload includes the requested fields of the GraphQL type MetaobjectBulkDeletePayload.
 // The GraphQL type's documentation follows.
 //
-// Provides the definition of a generic object structure composed of metafields.
-type Cli_MetaobjectDefinition struct {
-	// Access configuration for the metaobject definition.
-	Access Cli_MetaobjectDefinitionAccessMetaobjectAccess `json:"access"`
-	// The capabilities of the metaobject definition.
-	Capabilities Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities `json:"capabilities"`
-	// The administrative description.
-	Description string `json:"description"`
-	// The key of a field to reference as the display name for each object.
-	DisplayNameKey string `json:"displayNameKey"`
-	// The fields defined for this object type.
-	FieldDefinitions []Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition `json:"fieldDefinitions"`
-	// A globally-unique ID.
-	Id string `json:"id"`
-	// The human-readable name.
-	Name string `json:"name"`
-	// The type of the object definition. Defines the namespace of associated metafields.
-	Type string `json:"type"`
-}
-
-// GetAccess returns Cli_MetaobjectDefinition.Access, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinition) GetAccess() Cli_MetaobjectDefinitionAccessMetaobjectAccess {
-	return v.Access
+// Return type for `metaobjectBulkDelete` mutation.
+type BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayload struct {
+	// The asynchronous job that deletes the metaobjects.
+	Job BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadJob `json:"job"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError `json:"userErrors"`
 }
 
-// GetCapabilities returns Cli_MetaobjectDefinition.Capabilities, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinition) GetCapabilities() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities {
-	return v.Capabilities
+// GetJob returns BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayload.Job, and is useful for accessing the field via an interface.
+func (v *BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayload) GetJob() BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadJob {
+	return v.Job
 }
 
-// GetDescription returns Cli_MetaobjectDefinition.Description, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinition) GetDescription() string { return v.Description }
-
-// GetDisplayNameKey returns Cli_MetaobjectDefinition.DisplayNameKey, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinition) GetDisplayNameKey() string { return v.DisplayNameKey }
-
-// GetFieldDefinitions returns Cli_MetaobjectDefinition.FieldDefinitions, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinition) GetFieldDefinitions() []Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition {
-	return v.FieldDefinitions
+// GetUserErrors returns BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayload) GetUserErrors() []BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError {
+	return v.UserErrors
 }
 
-// GetId returns Cli_MetaobjectDefinition.Id, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinition) GetId() string { return v.Id }
-
-// GetName returns Cli_MetaobjectDefinition.Name, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinition) GetName() string { return v.Name }
-
-// GetType returns Cli_MetaobjectDefinition.Type, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinition) GetType() string { return v.Type }
-
-// Cli_MetaobjectDefinitionAccessMetaobjectAccess includes the requested fields of the GraphQL type MetaobjectAccess.
+// BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadJob includes the requested fields of the GraphQL type Job.
 // The GraphQL type's documentation follows.
 //
-// Access permissions for the definition's metaobjects.
-type Cli_MetaobjectDefinitionAccessMetaobjectAccess struct {
-	// The access permitted on the Admin API.
-	Admin MetaobjectAdminAccess `json:"admin"`
-	// The access permitted on the Storefront API.
-	Storefront MetaobjectStorefrontAccess `json:"storefront"`
+// A job corresponds to some long running task that the client should poll for status.
+type BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadJob struct {
+	// A globally-unique ID that's returned when running an asynchronous mutation.
+	Id string `json:"id"`
+	// This indicates if the job is still queued or has been run.
+	Done bool `json:"done"`
 }
 
-// GetAdmin returns Cli_MetaobjectDefinitionAccessMetaobjectAccess.Admin, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionAccessMetaobjectAccess) GetAdmin() MetaobjectAdminAccess {
-	return v.Admin
+// GetId returns BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadJob.Id, and is useful for accessing the field via an interface.
+func (v *BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadJob) GetId() string {
+	return v.Id
 }
 
-// GetStorefront returns Cli_MetaobjectDefinitionAccessMetaobjectAccess.Storefront, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionAccessMetaobjectAccess) GetStorefront() MetaobjectStorefrontAccess {
-	return v.Storefront
+// GetDone returns BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadJob.Done, and is useful for accessing the field via an interface.
+func (v *BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadJob) GetDone() bool {
+	return v.Done
 }
 
-// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities includes the requested fields of the GraphQL type MetaobjectCapabilities.
+// BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError includes the requested fields of the GraphQL type MetaobjectUserError.
 // The GraphQL type's documentation follows.
 //
-// Provides the capabilities of a metaobject definition.
-type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities struct {
-	// Indicates whether a metaobject definition can be displayed as a page on the Online Store.
-	OnlineStore Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore `json:"onlineStore"`
-	// Indicate whether a metaobject definition is publishable.
-	Publishable Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable `json:"publishable"`
-	// Indicate whether a metaobject definition is renderable and exposes SEO data.
-	Renderable Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable `json:"renderable"`
-	// Indicate whether a metaobject definition is translatable.
-	Translatable Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable `json:"translatable"`
+// Defines errors encountered while managing metaobject resources.
+type BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetaobjectUserErrorCode `json:"code"`
 }
 
-// GetOnlineStore returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities.OnlineStore, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities) GetOnlineStore() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore {
-	return v.OnlineStore
+// GetField returns BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError.Field, and is useful for accessing the field via an interface.
+func (v *BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError) GetField() []string {
+	return v.Field
 }
 
-// GetPublishable returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities.Publishable, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities) GetPublishable() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable {
-	return v.Publishable
+// GetMessage returns BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError.Message, and is useful for accessing the field via an interface.
+func (v *BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError) GetMessage() string {
+	return v.Message
 }
 
-// GetRenderable returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities.Renderable, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities) GetRenderable() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable {
-	return v.Renderable
+// GetCode returns BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError.Code, and is useful for accessing the field via an interface.
+func (v *BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayloadUserErrorsMetaobjectUserError) GetCode() MetaobjectUserErrorCode {
+	return v.Code
 }
 
-// GetTranslatable returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities.Translatable, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities) GetTranslatable() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable {
-	return v.Translatable
+// BulkDeleteMetaobjectsResponse is returned by BulkDeleteMetaobjects on success.
+type BulkDeleteMetaobjectsResponse struct {
+	// Asynchronously delete metaobjects and their associated metafields in bulk.
+	MetaobjectBulkDelete BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayload `json:"metaobjectBulkDelete"`
 }
 
-// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore includes the requested fields of the GraphQL type MetaobjectCapabilitiesOnlineStore.
-// The GraphQL type's documentation follows.
-//
-// The Online Store capability of a metaobject definition.
-type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore struct {
-	// The data associated with the Online Store capability.
-	Data Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore `json:"data"`
-	// Indicates if the capability is enabled.
-	Enabled bool `json:"enabled"`
+// GetMetaobjectBulkDelete returns BulkDeleteMetaobjectsResponse.MetaobjectBulkDelete, and is useful for accessing the field via an interface.
+func (v *BulkDeleteMetaobjectsResponse) GetMetaobjectBulkDelete() BulkDeleteMetaobjectsMetaobjectBulkDeleteMetaobjectBulkDeletePayload {
+	return v.MetaobjectBulkDelete
 }
 
-// GetData returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore.Data, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore) GetData() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore {
-	return v.Data
-}
+// Possible error codes that can be returned by `BulkMutationUserError`.
+type BulkMutationErrorCode string
 
-// GetEnabled returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore.Enabled, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore) GetEnabled() bool {
-	return v.Enabled
-}
+const (
+	// The operation did not run because another bulk mutation is already running. [Wait for the operation to finish](https://shopify.dev/api/usage/bulk-operations/imports#wait-for-the-operation-to-finish) before retrying this operation.
+	BulkMutationErrorCodeOperationInProgress BulkMutationErrorCode = "OPERATION_IN_PROGRESS"
+	// The operation did not run because the mutation is invalid. Check your mutation syntax and try again.
+	BulkMutationErrorCodeInvalidMutation BulkMutationErrorCode = "INVALID_MUTATION"
+	// The JSONL file submitted via the `stagedUploadsCreate` mutation is invalid. Update the file and try again.
+	BulkMutationErrorCodeInvalidStagedUploadFile BulkMutationErrorCode = "INVALID_STAGED_UPLOAD_FILE"
+	// The JSONL file could not be found. Try [uploading the file](https://shopify.dev/api/usage/bulk-operations/imports#generate-the-uploaded-url-and-parameters) again, and check that you've entered the URL correctly for the `stagedUploadPath` mutation argument.
+	BulkMutationErrorCodeNoSuchFile BulkMutationErrorCode = "NO_SUCH_FILE"
+	// There was a problem reading the JSONL file. This error might be intermittent, so you can try performing the same query again.
+	BulkMutationErrorCodeInternalFileServerError BulkMutationErrorCode = "INTERNAL_FILE_SERVER_ERROR"
+)
 
-// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore includes the requested fields of the GraphQL type MetaobjectCapabilityDefinitionDataOnlineStore.
-// The GraphQL type's documentation follows.
-//
-// The Online Store capability data for the metaobject definition.
-type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore struct {
-	// Flag indicating if a sufficient number of redirects are available to redirect all published entries.
-	CanCreateRedirects bool `json:"canCreateRedirects"`
-	// The URL handle for accessing pages of this metaobject type in the Online Store.
-	UrlHandle string `json:"urlHandle"`
+var AllBulkMutationErrorCode = []BulkMutationErrorCode{
+	BulkMutationErrorCodeOperationInProgress,
+	BulkMutationErrorCodeInvalidMutation,
+	BulkMutationErrorCodeInvalidStagedUploadFile,
+	BulkMutationErrorCodeNoSuchFile,
+	BulkMutationErrorCodeInternalFileServerError,
 }
 
-// GetCanCreateRedirects returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore.CanCreateRedirects, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore) GetCanCreateRedirects() bool {
-	return v.CanCreateRedirects
+// Error codes for failed bulk operations.
+type BulkOperationErrorCode string
+
+const (
+	// The provided operation `query` returned access denied due to missing
+	// [access scopes](https://shopify.dev/api/usage/access-scopes).
+	// Review the requested object permissions and execute the query as a normal non-bulk GraphQL request to see more details.
+	BulkOperationErrorCodeAccessDenied BulkOperationErrorCode = "ACCESS_DENIED"
+	// The operation resulted in partial or incomplete data due to internal server errors during execution.
+	// These errors might be intermittent, so you can try performing the same query again.
+	BulkOperationErrorCodeInternalServerError BulkOperationErrorCode = "INTERNAL_SERVER_ERROR"
+	// The operation resulted in partial or incomplete data due to query timeouts during execution.
+	// In some cases, timeouts can be avoided by modifying your `query` to select fewer fields.
+	BulkOperationErrorCodeTimeout BulkOperationErrorCode = "TIMEOUT"
+)
+
+var AllBulkOperationErrorCode = []BulkOperationErrorCode{
+	BulkOperationErrorCodeAccessDenied,
+	BulkOperationErrorCodeInternalServerError,
+	BulkOperationErrorCodeTimeout,
 }
 
-// GetUrlHandle returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore.UrlHandle, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore) GetUrlHandle() string {
-	return v.UrlHandle
+// The valid values for the status of a bulk operation.
+type BulkOperationStatus string
+
+const (
+	// The bulk operation has been canceled.
+	BulkOperationStatusCanceled BulkOperationStatus = "CANCELED"
+	// Cancelation has been initiated on the bulk operation. There may be a short delay from when a cancelation
+	// starts until the operation is actually canceled.
+	BulkOperationStatusCanceling BulkOperationStatus = "CANCELING"
+	// The bulk operation has successfully completed.
+	BulkOperationStatusCompleted BulkOperationStatus = "COMPLETED"
+	// The bulk operation has been created.
+	BulkOperationStatusCreated BulkOperationStatus = "CREATED"
+	// The bulk operation URL has expired.
+	BulkOperationStatusExpired BulkOperationStatus = "EXPIRED"
+	// The bulk operation has failed. For information on why the operation failed, use
+	// [BulkOperation.errorCode](https://shopify.dev/api/admin-graphql/latest/enums/bulkoperationerrorcode).
+	BulkOperationStatusFailed BulkOperationStatus = "FAILED"
+	// The bulk operation is runnning.
+	BulkOperationStatusRunning BulkOperationStatus = "RUNNING"
+)
+
+var AllBulkOperationStatus = []BulkOperationStatus{
+	BulkOperationStatusCanceled,
+	BulkOperationStatusCanceling,
+	BulkOperationStatusCompleted,
+	BulkOperationStatusCreated,
+	BulkOperationStatusExpired,
+	BulkOperationStatusFailed,
+	BulkOperationStatusRunning,
 }
 
-// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable includes the requested fields of the GraphQL type MetaobjectCapabilitiesPublishable.
+// Cli_BulkOperation includes the GraphQL fields of BulkOperation requested by the fragment Cli_BulkOperation.
 // The GraphQL type's documentation follows.
 //
-// The publishable capability of a metaobject definition.
-type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable struct {
-	// Indicates if the capability is enabled.
-	Enabled bool `json:"enabled"`
+// An asynchronous long-running operation to fetch data in bulk or to bulk import data.
+//
+// Bulk operations are created using the `bulkOperationRunQuery` or `bulkOperationRunMutation` mutation. After
+// they are created, clients should poll the `status` field for updates. When `COMPLETED`, the `url` field contains
+// a link to the data in [JSONL](http://jsonlines.org/) format.
+//
+// Refer to the [bulk operations guide](https://shopify.dev/api/usage/bulk-operations/imports) for more details.
+type Cli_BulkOperation struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// Status of the bulk operation.
+	Status BulkOperationStatus `json:"status"`
+	// Error code for failed operations.
+	ErrorCode BulkOperationErrorCode `json:"errorCode"`
+	// A running count of all the objects processed.
+	// For example, when fetching all the products and their variants, this field counts both products and variants.
+	// This field can be used to track operation progress.
+	ObjectCount string `json:"objectCount"`
+	// The URL that points to the response data in [JSONL](http://jsonlines.org/) format.
+	// The URL expires 7 days after the operation completes.
+	Url string `json:"url"`
+	// The URL that points to the partial or incomplete response data (in [JSONL](http://jsonlines.org/) format) that was returned by a failed operation.
+	// The URL expires 7 days after the operation fails. Returns `null` when there's no data available.
+	PartialDataUrl string `json:"partialDataUrl"`
 }
 
-// GetEnabled returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable.Enabled, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable) GetEnabled() bool {
-	return v.Enabled
-}
+// GetId returns Cli_BulkOperation.Id, and is useful for accessing the field via an interface.
+func (v *Cli_BulkOperation) GetId() string { return v.Id }
 
-// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable includes the requested fields of the GraphQL type MetaobjectCapabilitiesRenderable.
+// GetStatus returns Cli_BulkOperation.Status, and is useful for accessing the field via an interface.
+func (v *Cli_BulkOperation) GetStatus() BulkOperationStatus { return v.Status }
+
+// GetErrorCode returns Cli_BulkOperation.ErrorCode, and is useful for accessing the field via an interface.
+func (v *Cli_BulkOperation) GetErrorCode() BulkOperationErrorCode { return v.ErrorCode }
+
+// GetObjectCount returns Cli_BulkOperation.ObjectCount, and is useful for accessing the field via an interface.
+func (v *Cli_BulkOperation) GetObjectCount() string { return v.ObjectCount }
+
+// GetUrl returns Cli_BulkOperation.Url, and is useful for accessing the field via an interface.
+func (v *Cli_BulkOperation) GetUrl() string { return v.Url }
+
+// GetPartialDataUrl returns Cli_BulkOperation.PartialDataUrl, and is useful for accessing the field via an interface.
+func (v *Cli_BulkOperation) GetPartialDataUrl() string { return v.PartialDataUrl }
+
+// Cli_File includes the GraphQL fields of File requested by the fragment Cli_File.
 // The GraphQL type's documentation follows.
 //
-// The renderable capability of a metaobject definition.
-type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable struct {
-	// Indicates if the capability is enabled.
-	Enabled bool `json:"enabled"`
-	// The data associated with the renderable capability.
-	Data Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable `json:"data"`
+// A file interface.
+//
+// Cli_File is implemented by the following types:
+// Cli_FileExternalVideo
+// Cli_FileGenericFile
+// Cli_FileMediaImage
+// Cli_FileModel3d
+// Cli_FileVideo
+type Cli_File interface {
+	implementsGraphQLInterfaceCli_File()
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// A globally-unique ID.
+	GetId() string
+	// GetFileStatus returns the interface-field "fileStatus" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The status of the file.
+	GetFileStatus() FileStatus
+	// GetFileErrors returns the interface-field "fileErrors" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Any errors that have occurred on the file.
+	GetFileErrors() []Cli_FileFileErrorsFileError
 }
 
-// GetEnabled returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable.Enabled, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable) GetEnabled() bool {
-	return v.Enabled
+func (v *Cli_FileExternalVideo) implementsGraphQLInterfaceCli_File() {}
+func (v *Cli_FileGenericFile) implementsGraphQLInterfaceCli_File()   {}
+func (v *Cli_FileMediaImage) implementsGraphQLInterfaceCli_File()    {}
+func (v *Cli_FileModel3d) implementsGraphQLInterfaceCli_File()       {}
+func (v *Cli_FileVideo) implementsGraphQLInterfaceCli_File()         {}
+
+func __unmarshalCli_File(b []byte, v *Cli_File) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ExternalVideo":
+		*v = new(Cli_FileExternalVideo)
+		return json.Unmarshal(b, *v)
+	case "GenericFile":
+		*v = new(Cli_FileGenericFile)
+		return json.Unmarshal(b, *v)
+	case "MediaImage":
+		*v = new(Cli_FileMediaImage)
+		return json.Unmarshal(b, *v)
+	case "Model3d":
+		*v = new(Cli_FileModel3d)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(Cli_FileVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing File.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for Cli_File: "%v"`, tn.TypeName)
+	}
 }
 
-// GetData returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable.Data, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable) GetData() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable {
-	return v.Data
+func __marshalCli_File(v *Cli_File) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *Cli_FileExternalVideo:
+		typename = "ExternalVideo"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_FileExternalVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_FileGenericFile:
+		typename = "GenericFile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_FileGenericFile
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_FileMediaImage:
+		typename = "MediaImage"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_FileMediaImage
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_FileModel3d:
+		typename = "Model3d"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_FileModel3d
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_FileVideo:
+		typename = "Video"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_FileVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for Cli_File: "%T"`, v)
+	}
 }
 
-// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable includes the requested fields of the GraphQL type MetaobjectCapabilityDefinitionDataRenderable.
+// Cli_File includes the GraphQL fields of ExternalVideo requested by the fragment Cli_File.
 // The GraphQL type's documentation follows.
 //
-// The renderable capability data for the metaobject definition.
-type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable struct {
-	// The metaobject field used as an alias for the SEO page description.
-	MetaDescriptionKey string `json:"metaDescriptionKey"`
-	// The metaobject field used as an alias for the SEO page title.
-	MetaTitleKey string `json:"metaTitleKey"`
+// A file interface.
+type Cli_FileExternalVideo struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The status of the file.
+	FileStatus FileStatus `json:"fileStatus"`
+	// Any errors that have occurred on the file.
+	FileErrors []Cli_FileFileErrorsFileError `json:"fileErrors"`
 }
 
-// GetMetaDescriptionKey returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable.MetaDescriptionKey, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable) GetMetaDescriptionKey() string {
-	return v.MetaDescriptionKey
-}
+// GetId returns Cli_FileExternalVideo.Id, and is useful for accessing the field via an interface.
+func (v *Cli_FileExternalVideo) GetId() string { return v.Id }
 
-// GetMetaTitleKey returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable.MetaTitleKey, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable) GetMetaTitleKey() string {
-	return v.MetaTitleKey
-}
+// GetFileStatus returns Cli_FileExternalVideo.FileStatus, and is useful for accessing the field via an interface.
+func (v *Cli_FileExternalVideo) GetFileStatus() FileStatus { return v.FileStatus }
 
-// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable includes the requested fields of the GraphQL type MetaobjectCapabilitiesTranslatable.
+// GetFileErrors returns Cli_FileExternalVideo.FileErrors, and is useful for accessing the field via an interface.
+func (v *Cli_FileExternalVideo) GetFileErrors() []Cli_FileFileErrorsFileError { return v.FileErrors }
+
+// Cli_FileFileErrorsFileError includes the requested fields of the GraphQL type FileError.
 // The GraphQL type's documentation follows.
 //
-// The translatable capability of a metaobject definition.
-type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable struct {
-	// Indicates if the capability is enabled.
-	Enabled bool `json:"enabled"`
+// A file error. This typically occurs when there is an issue with the file itself causing it to fail validation.
+// Check the file before attempting to upload again.
+type Cli_FileFileErrorsFileError struct {
+	// Translated error message.
+	Message string `json:"message"`
 }
 
-// GetEnabled returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable.Enabled, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable) GetEnabled() bool {
-	return v.Enabled
-}
+// GetMessage returns Cli_FileFileErrorsFileError.Message, and is useful for accessing the field via an interface.
+func (v *Cli_FileFileErrorsFileError) GetMessage() string { return v.Message }
 
-// Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition includes the requested fields of the GraphQL type MetaobjectFieldDefinition.
+// Cli_File includes the GraphQL fields of GenericFile requested by the fragment Cli_File.
 // The GraphQL type's documentation follows.
 //
-// Defines a field for a MetaobjectDefinition with properties
-// such as the field's data type and validations.
-type Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition struct {
-	// The administrative description.
-	Description string `json:"description"`
-	// A key name used to identify the field within the metaobject composition.
-	Key string `json:"key"`
-	// The human-readable name.
-	Name string `json:"name"`
-	// Required status of the field within the metaobject composition.
-	Required bool `json:"required"`
-	// The type of data that the field stores.
-	Type Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType `json:"type"`
-	// A list of [validation options](https://shopify.dev/apps/metafields/definitions/validation) for
-	// the field. For example, a field with the type `date` can set a minimum date requirement.
-	Validations []Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation `json:"validations"`
+// A file interface.
+type Cli_FileGenericFile struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The status of the file.
+	FileStatus FileStatus `json:"fileStatus"`
+	// Any errors that have occurred on the file.
+	FileErrors []Cli_FileFileErrorsFileError `json:"fileErrors"`
+	// The generic file's URL.
+	Url string `json:"url"`
 }
 
-// GetDescription returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Description, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetDescription() string {
-	return v.Description
-}
+// GetId returns Cli_FileGenericFile.Id, and is useful for accessing the field via an interface.
+func (v *Cli_FileGenericFile) GetId() string { return v.Id }
 
-// GetKey returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Key, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetKey() string {
-	return v.Key
-}
+// GetFileStatus returns Cli_FileGenericFile.FileStatus, and is useful for accessing the field via an interface.
+func (v *Cli_FileGenericFile) GetFileStatus() FileStatus { return v.FileStatus }
 
-// GetName returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Name, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetName() string {
-	return v.Name
-}
+// GetFileErrors returns Cli_FileGenericFile.FileErrors, and is useful for accessing the field via an interface.
+func (v *Cli_FileGenericFile) GetFileErrors() []Cli_FileFileErrorsFileError { return v.FileErrors }
 
-// GetRequired returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Required, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetRequired() bool {
-	return v.Required
-}
+// GetUrl returns Cli_FileGenericFile.Url, and is useful for accessing the field via an interface.
+func (v *Cli_FileGenericFile) GetUrl() string { return v.Url }
 
-// GetType returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Type, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetType() Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType {
-	return v.Type
+// Cli_FileImage includes the requested fields of the GraphQL type Image.
+// The GraphQL type's documentation follows.
+//
+// Represents an image resource.
+type Cli_FileImage struct {
+	// The location of the image as a URL.
+	//
+	// If no transform options are specified, then the original image will be preserved including any pre-applied transforms.
+	//
+	// All transformation options are considered "best-effort". Any transformation that the original image type doesn't support will be ignored.
+	//
+	// If you need multiple variations of the same image, then you can use [GraphQL aliases](https://graphql.org/learn/queries/#aliases).
+	Url string `json:"url"`
 }
 
-// GetValidations returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Validations, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetValidations() []Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation {
-	return v.Validations
-}
+// GetUrl returns Cli_FileImage.Url, and is useful for accessing the field via an interface.
+func (v *Cli_FileImage) GetUrl() string { return v.Url }
 
-// Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType includes the requested fields of the GraphQL type MetafieldDefinitionType.
+// Cli_File includes the GraphQL fields of MediaImage requested by the fragment Cli_File.
 // The GraphQL type's documentation follows.
 //
-// A metafield definition type provides basic foundation and validation for a metafield.
-type Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType struct {
-	// The category associated with the metafield definition type.
-	Category string `json:"category"`
-	// The name of the type for the metafield definition.
-	// See the list of [supported types](https://shopify.dev/apps/metafields/types).
-	Name string `json:"name"`
+// A file interface.
+type Cli_FileMediaImage struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The status of the file.
+	FileStatus FileStatus `json:"fileStatus"`
+	// Any errors that have occurred on the file.
+	FileErrors []Cli_FileFileErrorsFileError `json:"fileErrors"`
+	// The image for the media. Returns `null` until `status` is `READY`.
+	Image Cli_FileImage `json:"image"`
 }
 
-// GetCategory returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType.Category, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType) GetCategory() string {
-	return v.Category
-}
+// GetId returns Cli_FileMediaImage.Id, and is useful for accessing the field via an interface.
+func (v *Cli_FileMediaImage) GetId() string { return v.Id }
 
-// GetName returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType.Name, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType) GetName() string {
-	return v.Name
+// GetFileStatus returns Cli_FileMediaImage.FileStatus, and is useful for accessing the field via an interface.
+func (v *Cli_FileMediaImage) GetFileStatus() FileStatus { return v.FileStatus }
+
+// GetFileErrors returns Cli_FileMediaImage.FileErrors, and is useful for accessing the field via an interface.
+func (v *Cli_FileMediaImage) GetFileErrors() []Cli_FileFileErrorsFileError { return v.FileErrors }
+
+// GetImage returns Cli_FileMediaImage.Image, and is useful for accessing the field via an interface.
+func (v *Cli_FileMediaImage) GetImage() Cli_FileImage { return v.Image }
+
+// Cli_File includes the GraphQL fields of Model3d requested by the fragment Cli_File.
+// The GraphQL type's documentation follows.
+//
+// A file interface.
+type Cli_FileModel3d struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The status of the file.
+	FileStatus FileStatus `json:"fileStatus"`
+	// Any errors that have occurred on the file.
+	FileErrors []Cli_FileFileErrorsFileError `json:"fileErrors"`
 }
 
-// Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation includes the requested fields of the GraphQL type MetafieldDefinitionValidation.
+// GetId returns Cli_FileModel3d.Id, and is useful for accessing the field via an interface.
+func (v *Cli_FileModel3d) GetId() string { return v.Id }
+
+// GetFileStatus returns Cli_FileModel3d.FileStatus, and is useful for accessing the field via an interface.
+func (v *Cli_FileModel3d) GetFileStatus() FileStatus { return v.FileStatus }
+
+// GetFileErrors returns Cli_FileModel3d.FileErrors, and is useful for accessing the field via an interface.
+func (v *Cli_FileModel3d) GetFileErrors() []Cli_FileFileErrorsFileError { return v.FileErrors }
+
+// Cli_File includes the GraphQL fields of Video requested by the fragment Cli_File.
 // The GraphQL type's documentation follows.
 //
-// A configured metafield definition validation.
+// A file interface.
+type Cli_FileVideo struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The status of the file.
+	FileStatus FileStatus `json:"fileStatus"`
+	// Any errors that have occurred on the file.
+	FileErrors []Cli_FileFileErrorsFileError `json:"fileErrors"`
+	// The video's filename.
+	Filename string `json:"filename"`
+}
+
+// GetId returns Cli_FileVideo.Id, and is useful for accessing the field via an interface.
+func (v *Cli_FileVideo) GetId() string { return v.Id }
+
+// GetFileStatus returns Cli_FileVideo.FileStatus, and is useful for accessing the field via an interface.
+func (v *Cli_FileVideo) GetFileStatus() FileStatus { return v.FileStatus }
+
+// GetFileErrors returns Cli_FileVideo.FileErrors, and is useful for accessing the field via an interface.
+func (v *Cli_FileVideo) GetFileErrors() []Cli_FileFileErrorsFileError { return v.FileErrors }
+
+// GetFilename returns Cli_FileVideo.Filename, and is useful for accessing the field via an interface.
+func (v *Cli_FileVideo) GetFilename() string { return v.Filename }
+
+// Cli_Metafield includes the GraphQL fields of Metafield requested by the fragment Cli_Metafield.
+// The GraphQL type's documentation follows.
 //
-// For example, for a metafield definition of `number_integer` type, you can set a validation with the name `max`
-// and a value of `15`. This validation will ensure that the value of the metafield is a number less than or equal to 15.
+// Metafields enable you to attach additional information to a Shopify resource, such as a [Product](https://shopify.dev/api/admin-graphql/latest/objects/product) or a [Collection](https://shopify.dev/api/admin-graphql/latest/objects/collection).
+// For more information about where you can attach metafields refer to [HasMetafields](https://shopify.dev/api/admin/graphql/reference/common-objects/HasMetafields).
+// Some examples of the data that metafields enable you to store are specifications, size charts, downloadable documents, release dates, images, or part numbers.
+// Metafields are identified by an owner resource, namespace, and key. and store a value along with type information for that value.
+type Cli_Metafield struct {
+	// The container for a group of metafields that the metafield is associated with.
+	Namespace string `json:"namespace"`
+	// The unique identifier for the metafield within its namespace.
+	Key string `json:"key"`
+	// The type of data that is stored in the metafield.
+	// Refer to the list of [supported types](https://shopify.dev/apps/metafields/types).
+	Type string `json:"type"`
+	// The data stored in the metafield. Always stored as a string, regardless of the metafield's type.
+	Value string `json:"value"`
+}
+
+// GetNamespace returns Cli_Metafield.Namespace, and is useful for accessing the field via an interface.
+func (v *Cli_Metafield) GetNamespace() string { return v.Namespace }
+
+// GetKey returns Cli_Metafield.Key, and is useful for accessing the field via an interface.
+func (v *Cli_Metafield) GetKey() string { return v.Key }
+
+// GetType returns Cli_Metafield.Type, and is useful for accessing the field via an interface.
+func (v *Cli_Metafield) GetType() string { return v.Type }
+
+// GetValue returns Cli_Metafield.Value, and is useful for accessing the field via an interface.
+func (v *Cli_Metafield) GetValue() string { return v.Value }
+
+// Cli_MetafieldDefinition includes the GraphQL fields of MetafieldDefinition requested by the fragment Cli_MetafieldDefinition.
+// The GraphQL type's documentation follows.
 //
-// Refer to the [list of supported validations](https://shopify.dev/api/admin/graphql/reference/common-objects/metafieldDefinitionTypes#examples-Fetch_all_metafield_definition_types).
-type Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation struct {
-	// The validation name.
+// Metafield definitions enable you to define additional validation constraints for metafields, and enable the
+// merchant to edit metafield values in context.
+type Cli_MetafieldDefinition struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The container for a group of metafields that the metafield definition is associated with.
+	Namespace string `json:"namespace"`
+	// The unique identifier for the metafield definition within its namespace.
+	Key string `json:"key"`
+	// The human-readable name of the metafield definition.
 	Name string `json:"name"`
-	// The validation value.
-	Value string `json:"value"`
+	// The description of the metafield definition.
+	Description string `json:"description"`
+	// The resource type that the metafield definition is attached to.
+	OwnerType MetafieldOwnerType `json:"ownerType"`
+	// The position of the metafield definition in the pinned list.
+	PinnedPosition *int `json:"pinnedPosition"`
+	// The standard metafield definition template associated with the metafield definition.
+	StandardTemplate *Cli_MetafieldDefinitionStandardTemplateStandardMetafieldDefinitionTemplate `json:"standardTemplate"`
+	// The type of data that each of the metafields that belong to the metafield definition will store.
+	// Refer to the list of [supported types](https://shopify.dev/apps/metafields/types).
+	Type Cli_MetafieldDefinitionType `json:"type"`
+	// A list of [validation options](https://shopify.dev/apps/metafields/definitions/validation) for
+	// the metafields that belong to the metafield definition. For example, for a metafield definition with the
+	// type `date`, you can set a minimum date validation so that each of the metafields that belong to it can only
+	// store dates after the specified minimum.
+	Validations []Cli_MetafieldDefinitionValidationsMetafieldDefinitionValidation `json:"validations"`
+	// The access settings associated with the metafield definition.
+	Access Cli_MetafieldDefinitionAccessMetafieldAccess `json:"access"`
+	// The capabilities of the metafield definition.
+	Capabilities Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities `json:"capabilities"`
+	// The [constraints](https://shopify.dev/apps/build/custom-data/metafields/conditional-metafield-definitions)
+	// that determine what subtypes of resources a metafield definition applies to.
+	Constraints *Cli_MetafieldDefinitionConstraints `json:"constraints"`
 }
 
-// GetName returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation.Name, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation) GetName() string {
-	return v.Name
+// GetId returns Cli_MetafieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetId() string { return v.Id }
+
+// GetNamespace returns Cli_MetafieldDefinition.Namespace, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetNamespace() string { return v.Namespace }
+
+// GetKey returns Cli_MetafieldDefinition.Key, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetKey() string { return v.Key }
+
+// GetName returns Cli_MetafieldDefinition.Name, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetName() string { return v.Name }
+
+// GetDescription returns Cli_MetafieldDefinition.Description, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetDescription() string { return v.Description }
+
+// GetOwnerType returns Cli_MetafieldDefinition.OwnerType, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetOwnerType() MetafieldOwnerType { return v.OwnerType }
+
+// GetPinnedPosition returns Cli_MetafieldDefinition.PinnedPosition, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetPinnedPosition() *int { return v.PinnedPosition }
+
+// GetStandardTemplate returns Cli_MetafieldDefinition.StandardTemplate, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetStandardTemplate() *Cli_MetafieldDefinitionStandardTemplateStandardMetafieldDefinitionTemplate {
+	return v.StandardTemplate
 }
 
-// GetValue returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation.Value, and is useful for accessing the field via an interface.
-func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation) GetValue() string {
-	return v.Value
+// GetType returns Cli_MetafieldDefinition.Type, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetType() Cli_MetafieldDefinitionType { return v.Type }
+
+// GetValidations returns Cli_MetafieldDefinition.Validations, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetValidations() []Cli_MetafieldDefinitionValidationsMetafieldDefinitionValidation {
+	return v.Validations
 }
 
-// CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload includes the requested fields of the GraphQL type MetaobjectDefinitionCreatePayload.
+// GetAccess returns Cli_MetafieldDefinition.Access, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetAccess() Cli_MetafieldDefinitionAccessMetafieldAccess {
+	return v.Access
+}
+
+// GetCapabilities returns Cli_MetafieldDefinition.Capabilities, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetCapabilities() Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities {
+	return v.Capabilities
+}
+
+// GetConstraints returns Cli_MetafieldDefinition.Constraints, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinition) GetConstraints() *Cli_MetafieldDefinitionConstraints {
+	return v.Constraints
+}
+
+// Cli_MetafieldDefinitionAccessMetafieldAccess includes the requested fields of the GraphQL type MetafieldAccess.
 // The GraphQL type's documentation follows.
 //
-// Return type for `metaobjectDefinitionCreate` mutation.
-type CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload struct {
-	// The created metaobject definition.
-	MetaobjectDefinition CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition `json:"metaobjectDefinition"`
-	// The list of errors that occurred from executing the mutation.
-	UserErrors []CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError `json:"userErrors"`
+// Access permissions for the definition's metafields.
+type Cli_MetafieldDefinitionAccessMetafieldAccess struct {
+	// The access permitted on the Admin API.
+	Admin MetafieldAdminAccess `json:"admin"`
+	// The access permitted on the Storefront API.
+	Storefront MetafieldStorefrontAccess `json:"storefront"`
+	// The access permitted on the Customer Account API.
+	CustomerAccount MetafieldCustomerAccountAccess `json:"customerAccount"`
 }
 
-// GetMetaobjectDefinition returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload.MetaobjectDefinition, and is useful for accessing the field via an interface.
-func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload) GetMetaobjectDefinition() CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition {
-	return v.MetaobjectDefinition
+// GetAdmin returns Cli_MetafieldDefinitionAccessMetafieldAccess.Admin, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionAccessMetafieldAccess) GetAdmin() MetafieldAdminAccess {
+	return v.Admin
 }
 
-// GetUserErrors returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload.UserErrors, and is useful for accessing the field via an interface.
-func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload) GetUserErrors() []CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError {
-	return v.UserErrors
+// GetStorefront returns Cli_MetafieldDefinitionAccessMetafieldAccess.Storefront, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionAccessMetafieldAccess) GetStorefront() MetafieldStorefrontAccess {
+	return v.Storefront
 }
 
-// CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition includes the requested fields of the GraphQL type MetaobjectDefinition.
+// GetCustomerAccount returns Cli_MetafieldDefinitionAccessMetafieldAccess.CustomerAccount, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionAccessMetafieldAccess) GetCustomerAccount() MetafieldCustomerAccountAccess {
+	return v.CustomerAccount
+}
+
+// Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities includes the requested fields of the GraphQL type MetafieldCapabilities.
 // The GraphQL type's documentation follows.
 //
-// Provides the definition of a generic object structure composed of metafields.
-type CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition struct {
-	// A globally-unique ID.
-	Id string `json:"id"`
-	// The type of the object definition. Defines the namespace of associated metafields.
-	Type string `json:"type"`
+// Provides the capabilities of a metafield definition.
+type Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities struct {
+	// Indicate whether a metafield definition is configured for filtering.
+	AdminFilterable Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesAdminFilterableMetafieldCapabilityAdminFilterable `json:"adminFilterable"`
+	// Indicate whether a metafield definition can be used as a smart collection condition.
+	SmartCollectionCondition Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesSmartCollectionConditionMetafieldCapabilitySmartCollectionCondition `json:"smartCollectionCondition"`
+	// Indicate whether the metafield values for a metafield definition are required to be unique.
+	UniqueValues Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesUniqueValuesMetafieldCapabilityUniqueValues `json:"uniqueValues"`
 }
 
-// GetId returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition.Id, and is useful for accessing the field via an interface.
-func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition) GetId() string {
-	return v.Id
+// GetAdminFilterable returns Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities.AdminFilterable, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities) GetAdminFilterable() Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesAdminFilterableMetafieldCapabilityAdminFilterable {
+	return v.AdminFilterable
 }
 
-// GetType returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition.Type, and is useful for accessing the field via an interface.
-func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition) GetType() string {
-	return v.Type
+// GetSmartCollectionCondition returns Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities.SmartCollectionCondition, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities) GetSmartCollectionCondition() Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesSmartCollectionConditionMetafieldCapabilitySmartCollectionCondition {
+	return v.SmartCollectionCondition
 }
 
-// CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError includes the requested fields of the GraphQL type MetaobjectUserError.
+// GetUniqueValues returns Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities.UniqueValues, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilities) GetUniqueValues() Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesUniqueValuesMetafieldCapabilityUniqueValues {
+	return v.UniqueValues
+}
+
+// Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesAdminFilterableMetafieldCapabilityAdminFilterable includes the requested fields of the GraphQL type MetafieldCapabilityAdminFilterable.
 // The GraphQL type's documentation follows.
 //
-// Defines errors encountered while managing metaobject resources.
-type CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError struct {
-	// The path to the input field that caused the error.
-	Field []string `json:"field"`
-	// The error message.
-	Message string `json:"message"`
-	// The error code.
-	Code MetaobjectUserErrorCode `json:"code"`
+// Information about the admin filterable capability on a metafield definition.
+type Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesAdminFilterableMetafieldCapabilityAdminFilterable struct {
+	// Indicates if the capability is enabled.
+	Enabled bool `json:"enabled"`
 }
 
-// GetField returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError.Field, and is useful for accessing the field via an interface.
-func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError) GetField() []string {
-	return v.Field
+// GetEnabled returns Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesAdminFilterableMetafieldCapabilityAdminFilterable.Enabled, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesAdminFilterableMetafieldCapabilityAdminFilterable) GetEnabled() bool {
+	return v.Enabled
 }
 
-// GetMessage returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError.Message, and is useful for accessing the field via an interface.
-func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError) GetMessage() string {
-	return v.Message
+// Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesSmartCollectionConditionMetafieldCapabilitySmartCollectionCondition includes the requested fields of the GraphQL type MetafieldCapabilitySmartCollectionCondition.
+// The GraphQL type's documentation follows.
+//
+// Information about the smart collection condition capability on a metafield definition.
+type Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesSmartCollectionConditionMetafieldCapabilitySmartCollectionCondition struct {
+	// Indicates if the capability is enabled.
+	Enabled bool `json:"enabled"`
 }
 
-// GetCode returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError.Code, and is useful for accessing the field via an interface.
-func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError) GetCode() MetaobjectUserErrorCode {
-	return v.Code
+// GetEnabled returns Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesSmartCollectionConditionMetafieldCapabilitySmartCollectionCondition.Enabled, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesSmartCollectionConditionMetafieldCapabilitySmartCollectionCondition) GetEnabled() bool {
+	return v.Enabled
 }
 
-// CreateMetaobjectDefinitionResponse is returned by CreateMetaobjectDefinition on success.
-type CreateMetaobjectDefinitionResponse struct {
-	// Creates a new metaobject definition.
-	MetaobjectDefinitionCreate CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload `json:"metaobjectDefinitionCreate"`
+// Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesUniqueValuesMetafieldCapabilityUniqueValues includes the requested fields of the GraphQL type MetafieldCapabilityUniqueValues.
+// The GraphQL type's documentation follows.
+//
+// Information about the unique values capability on a metafield definition.
+type Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesUniqueValuesMetafieldCapabilityUniqueValues struct {
+	// Indicates if the capability is enabled.
+	Enabled bool `json:"enabled"`
 }
 
-// GetMetaobjectDefinitionCreate returns CreateMetaobjectDefinitionResponse.MetaobjectDefinitionCreate, and is useful for accessing the field via an interface.
-func (v *CreateMetaobjectDefinitionResponse) GetMetaobjectDefinitionCreate() CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload {
-	return v.MetaobjectDefinitionCreate
+// GetEnabled returns Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesUniqueValuesMetafieldCapabilityUniqueValues.Enabled, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionCapabilitiesMetafieldCapabilitiesUniqueValuesMetafieldCapabilityUniqueValues) GetEnabled() bool {
+	return v.Enabled
 }
 
-// GetMetaobjectDefinitionByTypeResponse is returned by GetMetaobjectDefinitionByType on success.
-type GetMetaobjectDefinitionByTypeResponse struct {
-	// Finds a metaobject definition by type.
-	MetaobjectDefinitionByType Cli_MetaobjectDefinition `json:"metaobjectDefinitionByType"`
+// Cli_MetafieldDefinitionConstraints includes the requested fields of the GraphQL type MetafieldDefinitionConstraints.
+// The GraphQL type's documentation follows.
+//
+// The [constraints](https://shopify.dev/apps/build/custom-data/metafields/conditional-metafield-definitions)
+// that determine what subtypes of resources a metafield definition applies to.
+type Cli_MetafieldDefinitionConstraints struct {
+	// The category of resource subtypes that the definition applies to.
+	Key string `json:"key"`
+	// The specific constraint subtype values that the definition applies to.
+	Values Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnection `json:"values"`
 }
 
-// GetMetaobjectDefinitionByType returns GetMetaobjectDefinitionByTypeResponse.MetaobjectDefinitionByType, and is useful for accessing the field via an interface.
-func (v *GetMetaobjectDefinitionByTypeResponse) GetMetaobjectDefinitionByType() Cli_MetaobjectDefinition {
-	return v.MetaobjectDefinitionByType
+// GetKey returns Cli_MetafieldDefinitionConstraints.Key, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionConstraints) GetKey() string { return v.Key }
+
+// GetValues returns Cli_MetafieldDefinitionConstraints.Values, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionConstraints) GetValues() Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnection {
+	return v.Values
 }
 
-// ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection includes the requested fields of the GraphQL type MetaobjectDefinitionConnection.
+// Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnection includes the requested fields of the GraphQL type MetafieldDefinitionConstraintValueConnection.
 // The GraphQL type's documentation follows.
 //
-// An auto-generated type for paginating through multiple MetaobjectDefinitions.
-type ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection struct {
-	// A list of nodes that are contained in MetaobjectDefinitionEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
-	Nodes []Cli_MetaobjectDefinition `json:"nodes"`
-	// An object that’s used to retrieve [cursor information](https://shopify.dev/api/usage/pagination-graphql) about the current page.
-	PageInfo ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo `json:"pageInfo"`
+// An auto-generated type for paginating through multiple MetafieldDefinitionConstraintValues.
+type Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnection struct {
+	// A list of nodes that are contained in MetafieldDefinitionConstraintValueEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnectionNodesMetafieldDefinitionConstraintValue `json:"nodes"`
 }
 
-// GetNodes returns ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection) GetNodes() []Cli_MetaobjectDefinition {
+// GetNodes returns Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnection) GetNodes() []Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnectionNodesMetafieldDefinitionConstraintValue {
 	return v.Nodes
 }
 
-// GetPageInfo returns ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection.PageInfo, and is useful for accessing the field via an interface.
-func (v *ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection) GetPageInfo() ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo {
-	return v.PageInfo
-}
-
-// ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnectionNodesMetafieldDefinitionConstraintValue includes the requested fields of the GraphQL type MetafieldDefinitionConstraintValue.
 // The GraphQL type's documentation follows.
 //
-// Returns information about pagination in a connection, in accordance with the
-// [Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
-// For more information, please read our [GraphQL Pagination Usage Guide](https://shopify.dev/api/usage/pagination-graphql).
-type ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo struct {
-	// Whether there are more pages to fetch following the current page.
-	HasNextPage bool `json:"hasNextPage"`
-	// The cursor corresponding to the last node in edges.
-	EndCursor string `json:"endCursor"`
+// A constraint subtype value that the metafield definition applies to.
+type Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnectionNodesMetafieldDefinitionConstraintValue struct {
+	// The subtype value of the constraint.
+	Value string `json:"value"`
 }
 
-// GetHasNextPage returns ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
-func (v *ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo) GetHasNextPage() bool {
-	return v.HasNextPage
+// GetValue returns Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnectionNodesMetafieldDefinitionConstraintValue.Value, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionConstraintsValuesMetafieldDefinitionConstraintValueConnectionNodesMetafieldDefinitionConstraintValue) GetValue() string {
+	return v.Value
 }
 
-// GetEndCursor returns ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
-func (v *ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo) GetEndCursor() string {
-	return v.EndCursor
+// Cli_MetafieldDefinitionStandardTemplateStandardMetafieldDefinitionTemplate includes the requested fields of the GraphQL type StandardMetafieldDefinitionTemplate.
+// The GraphQL type's documentation follows.
+//
+// Standard metafield definition templates provide preset configurations to create metafield definitions.
+// Each template has a specific namespace and key that we've reserved to have specific meanings for common use cases.
+//
+// Refer to the [list of standard metafield definitions](https://shopify.dev/apps/metafields/definitions/standard-definitions).
+type Cli_MetafieldDefinitionStandardTemplateStandardMetafieldDefinitionTemplate struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
 }
 
-// ListMetaobjectDefinitionsResponse is returned by ListMetaobjectDefinitions on success.
-type ListMetaobjectDefinitionsResponse struct {
-	// All metaobject definitions.
-	MetaobjectDefinitions ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection `json:"metaobjectDefinitions"`
+// GetId returns Cli_MetafieldDefinitionStandardTemplateStandardMetafieldDefinitionTemplate.Id, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionStandardTemplateStandardMetafieldDefinitionTemplate) GetId() string {
+	return v.Id
 }
 
-// GetMetaobjectDefinitions returns ListMetaobjectDefinitionsResponse.MetaobjectDefinitions, and is useful for accessing the field via an interface.
-func (v *ListMetaobjectDefinitionsResponse) GetMetaobjectDefinitions() ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection {
-	return v.MetaobjectDefinitions
+// Cli_MetafieldDefinitionType includes the requested fields of the GraphQL type MetafieldDefinitionType.
+// The GraphQL type's documentation follows.
+//
+// A metafield definition type provides basic foundation and validation for a metafield.
+type Cli_MetafieldDefinitionType struct {
+	// The name of the type for the metafield definition.
+	// See the list of [supported types](https://shopify.dev/apps/metafields/types).
+	Name string `json:"name"`
 }
 
-// The name and value for a metafield definition validation.
+// GetName returns Cli_MetafieldDefinitionType.Name, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionType) GetName() string { return v.Name }
+
+// Cli_MetafieldDefinitionValidationsMetafieldDefinitionValidation includes the requested fields of the GraphQL type MetafieldDefinitionValidation.
+// The GraphQL type's documentation follows.
 //
-// For example, for a metafield definition of `single_line_text_field` type, you can set a validation with the name `min` and a value of `10`.
-// This validation will ensure that the value of the metafield is at least 10 characters.
+// A configured metafield definition validation.
+//
+// For example, for a metafield definition of `number_integer` type, you can set a validation with the name `max`
+// and a value of `15`. This validation will ensure that the value of the metafield is a number less than or equal to 15.
 //
 // Refer to the [list of supported validations](https://shopify.dev/api/admin/graphql/reference/common-objects/metafieldDefinitionTypes#examples-Fetch_all_metafield_definition_types).
-type MetafieldDefinitionValidationInput struct {
-	// The name for the metafield definition validation.
+type Cli_MetafieldDefinitionValidationsMetafieldDefinitionValidation struct {
+	// The validation name.
 	Name string `json:"name"`
-	// The value for the metafield definition validation.
+	// The validation value.
 	Value string `json:"value"`
 }
 
-// GetName returns MetafieldDefinitionValidationInput.Name, and is useful for accessing the field via an interface.
-func (v *MetafieldDefinitionValidationInput) GetName() string { return v.Name }
+// GetName returns Cli_MetafieldDefinitionValidationsMetafieldDefinitionValidation.Name, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionValidationsMetafieldDefinitionValidation) GetName() string {
+	return v.Name
+}
 
-// GetValue returns MetafieldDefinitionValidationInput.Value, and is useful for accessing the field via an interface.
-func (v *MetafieldDefinitionValidationInput) GetValue() string { return v.Value }
+// GetValue returns Cli_MetafieldDefinitionValidationsMetafieldDefinitionValidation.Value, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldDefinitionValidationsMetafieldDefinitionValidation) GetValue() string {
+	return v.Value
+}
 
-// Metaobject access permissions for the Admin API. When the metaobject is app-owned, the owning app always has
-// full access.
-type MetaobjectAdminAccess string
+// Cli_MetafieldPage includes the GraphQL fields of MetafieldConnection requested by the fragment Cli_MetafieldPage.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple Metafields.
+type Cli_MetafieldPage struct {
+	// A list of nodes that are contained in MetafieldEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []Cli_Metafield `json:"nodes"`
+	// An object that’s used to retrieve [cursor information](https://shopify.dev/api/usage/pagination-graphql) about the current page.
+	PageInfo Cli_MetafieldPagePageInfo `json:"pageInfo"`
+}
 
-const (
-	// The merchant and other apps have no access.
-	MetaobjectAdminAccessPrivate MetaobjectAdminAccess = "PRIVATE"
-	// The merchant has read-only access. No other apps have access.
-	MetaobjectAdminAccessMerchantRead MetaobjectAdminAccess = "MERCHANT_READ"
-	// The merchant has read and write access. No other apps have access.
-	MetaobjectAdminAccessMerchantReadWrite MetaobjectAdminAccess = "MERCHANT_READ_WRITE"
-	// The merchant and other apps have read-only access.
-	MetaobjectAdminAccessPublicRead MetaobjectAdminAccess = "PUBLIC_READ"
-	// The merchant and other apps have read and write access.
-	MetaobjectAdminAccessPublicReadWrite MetaobjectAdminAccess = "PUBLIC_READ_WRITE"
-)
+// GetNodes returns Cli_MetafieldPage.Nodes, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldPage) GetNodes() []Cli_Metafield { return v.Nodes }
 
-var AllMetaobjectAdminAccess = []MetaobjectAdminAccess{
-	MetaobjectAdminAccessPrivate,
-	MetaobjectAdminAccessMerchantRead,
-	MetaobjectAdminAccessMerchantReadWrite,
-	MetaobjectAdminAccessPublicRead,
-	MetaobjectAdminAccessPublicReadWrite,
-}
+// GetPageInfo returns Cli_MetafieldPage.PageInfo, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldPage) GetPageInfo() Cli_MetafieldPagePageInfo { return v.PageInfo }
 
-// The input fields for creating a metaobject capability.
-type MetaobjectCapabilityCreateInput struct {
-	// The input for enabling the publishable capability.
-	Publishable MetaobjectCapabilityPublishableInput `json:"publishable"`
-	// The input for enabling the translatable capability.
-	Translatable MetaobjectCapabilityTranslatableInput `json:"translatable"`
-	// The input for enabling the renderable capability.
-	Renderable MetaobjectCapabilityRenderableInput `json:"renderable"`
-	// The input for enabling the Online Store capability.
-	OnlineStore MetaobjectCapabilityOnlineStoreInput `json:"onlineStore"`
+// Cli_MetafieldPagePageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Returns information about pagination in a connection, in accordance with the
+// [Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
+// For more information, please read our [GraphQL Pagination Usage Guide](https://shopify.dev/api/usage/pagination-graphql).
+type Cli_MetafieldPagePageInfo struct {
+	// Whether there are more pages to fetch following the current page.
+	HasNextPage bool `json:"hasNextPage"`
+	// The cursor corresponding to the last node in edges.
+	EndCursor string `json:"endCursor"`
 }
 
-// GetPublishable returns MetaobjectCapabilityCreateInput.Publishable, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityCreateInput) GetPublishable() MetaobjectCapabilityPublishableInput {
-	return v.Publishable
-}
+// GetHasNextPage returns Cli_MetafieldPagePageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldPagePageInfo) GetHasNextPage() bool { return v.HasNextPage }
 
-// GetTranslatable returns MetaobjectCapabilityCreateInput.Translatable, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityCreateInput) GetTranslatable() MetaobjectCapabilityTranslatableInput {
-	return v.Translatable
-}
+// GetEndCursor returns Cli_MetafieldPagePageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *Cli_MetafieldPagePageInfo) GetEndCursor() string { return v.EndCursor }
 
-// GetRenderable returns MetaobjectCapabilityCreateInput.Renderable, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityCreateInput) GetRenderable() MetaobjectCapabilityRenderableInput {
-	return v.Renderable
+// Cli_Metaobject includes the GraphQL fields of Metaobject requested by the fragment Cli_Metaobject.
+// The GraphQL type's documentation follows.
+//
+// Provides an object instance represented by a MetaobjectDefinition.
+type Cli_Metaobject struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The unique handle of the object, useful as a custom ID.
+	Handle string `json:"handle"`
+	// The type of the metaobject.
+	Type string `json:"type"`
+	// Metaobject capabilities for this Metaobject.
+	Capabilities Cli_MetaobjectCapabilitiesMetaobjectCapabilityData `json:"capabilities"`
+	// All ordered fields of the metaobject with their definitions and values.
+	Fields []Cli_MetaobjectFieldsMetaobjectField `json:"fields"`
 }
 
-// GetOnlineStore returns MetaobjectCapabilityCreateInput.OnlineStore, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityCreateInput) GetOnlineStore() MetaobjectCapabilityOnlineStoreInput {
-	return v.OnlineStore
+// GetId returns Cli_Metaobject.Id, and is useful for accessing the field via an interface.
+func (v *Cli_Metaobject) GetId() string { return v.Id }
+
+// GetHandle returns Cli_Metaobject.Handle, and is useful for accessing the field via an interface.
+func (v *Cli_Metaobject) GetHandle() string { return v.Handle }
+
+// GetType returns Cli_Metaobject.Type, and is useful for accessing the field via an interface.
+func (v *Cli_Metaobject) GetType() string { return v.Type }
+
+// GetCapabilities returns Cli_Metaobject.Capabilities, and is useful for accessing the field via an interface.
+func (v *Cli_Metaobject) GetCapabilities() Cli_MetaobjectCapabilitiesMetaobjectCapabilityData {
+	return v.Capabilities
 }
 
-// The input fields of the Online Store capability.
-type MetaobjectCapabilityDefinitionDataOnlineStoreInput struct {
-	// The URL handle for accessing pages of this metaobject type in the Online Store.
-	UrlHandle string `json:"urlHandle"`
-	// Whether to redirect published metaobjects automatically when the URL handle changes.
-	CreateRedirects bool `json:"createRedirects"`
+// GetFields returns Cli_Metaobject.Fields, and is useful for accessing the field via an interface.
+func (v *Cli_Metaobject) GetFields() []Cli_MetaobjectFieldsMetaobjectField { return v.Fields }
+
+// Cli_MetaobjectCapabilitiesMetaobjectCapabilityData includes the requested fields of the GraphQL type MetaobjectCapabilityData.
+// The GraphQL type's documentation follows.
+//
+// Provides the capabilities of a metaobject.
+type Cli_MetaobjectCapabilitiesMetaobjectCapabilityData struct {
+	// The publishable capability for this metaobject.
+	Publishable Cli_MetaobjectCapabilitiesMetaobjectCapabilityDataPublishable `json:"publishable"`
 }
 
-// GetUrlHandle returns MetaobjectCapabilityDefinitionDataOnlineStoreInput.UrlHandle, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityDefinitionDataOnlineStoreInput) GetUrlHandle() string {
-	return v.UrlHandle
+// GetPublishable returns Cli_MetaobjectCapabilitiesMetaobjectCapabilityData.Publishable, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectCapabilitiesMetaobjectCapabilityData) GetPublishable() Cli_MetaobjectCapabilitiesMetaobjectCapabilityDataPublishable {
+	return v.Publishable
 }
 
-// GetCreateRedirects returns MetaobjectCapabilityDefinitionDataOnlineStoreInput.CreateRedirects, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityDefinitionDataOnlineStoreInput) GetCreateRedirects() bool {
-	return v.CreateRedirects
+// Cli_MetaobjectCapabilitiesMetaobjectCapabilityDataPublishable includes the requested fields of the GraphQL type MetaobjectCapabilityDataPublishable.
+// The GraphQL type's documentation follows.
+//
+// The publishable capability for the parent metaobject.
+type Cli_MetaobjectCapabilitiesMetaobjectCapabilityDataPublishable struct {
+	// The visibility status of this metaobject across all channels.
+	Status MetaobjectStatus `json:"status"`
 }
 
-// The input fields of the renderable capability for SEO aliases.
-type MetaobjectCapabilityDefinitionDataRenderableInput struct {
-	// The metaobject field used as an alias for the SEO page title.
-	MetaTitleKey string `json:"metaTitleKey"`
-	// The metaobject field used as an alias for the SEO page description.
-	MetaDescriptionKey string `json:"metaDescriptionKey"`
+// GetStatus returns Cli_MetaobjectCapabilitiesMetaobjectCapabilityDataPublishable.Status, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectCapabilitiesMetaobjectCapabilityDataPublishable) GetStatus() MetaobjectStatus {
+	return v.Status
 }
 
-// GetMetaTitleKey returns MetaobjectCapabilityDefinitionDataRenderableInput.MetaTitleKey, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityDefinitionDataRenderableInput) GetMetaTitleKey() string {
-	return v.MetaTitleKey
+// Cli_MetaobjectDefinition includes the GraphQL fields of MetaobjectDefinition requested by the fragment Cli_MetaobjectDefinition.
+// The GraphQL type's documentation follows.
+//
+// Provides the definition of a generic object structure composed of metafields.
+type Cli_MetaobjectDefinition struct {
+	// Access configuration for the metaobject definition.
+	Access Cli_MetaobjectDefinitionAccessMetaobjectAccess `json:"access"`
+	// The capabilities of the metaobject definition.
+	Capabilities Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities `json:"capabilities"`
+	// The administrative description.
+	Description string `json:"description"`
+	// The key of a field to reference as the display name for each object.
+	DisplayNameKey string `json:"displayNameKey"`
+	// The fields defined for this object type.
+	FieldDefinitions []Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition `json:"fieldDefinitions"`
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The count of metaobjects created for the definition.
+	MetaobjectsCount int `json:"metaobjectsCount"`
+	// The human-readable name.
+	Name string `json:"name"`
+	// The standard metaobject template associated with the definition.
+	StandardTemplate *Cli_MetaobjectDefinitionStandardTemplateStandardMetaobjectDefinitionTemplate `json:"standardTemplate"`
+	// The type of the object definition. Defines the namespace of associated metafields.
+	Type string `json:"type"`
 }
 
-// GetMetaDescriptionKey returns MetaobjectCapabilityDefinitionDataRenderableInput.MetaDescriptionKey, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityDefinitionDataRenderableInput) GetMetaDescriptionKey() string {
-	return v.MetaDescriptionKey
+// GetAccess returns Cli_MetaobjectDefinition.Access, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetAccess() Cli_MetaobjectDefinitionAccessMetaobjectAccess {
+	return v.Access
 }
 
-// The input fields for enabling and disabling the Online Store capability.
-type MetaobjectCapabilityOnlineStoreInput struct {
-	// Indicates whether the capability should be enabled or disabled.
-	Enabled bool `json:"enabled"`
-	// The data associated with the Online Store capability.
-	Data MetaobjectCapabilityDefinitionDataOnlineStoreInput `json:"data"`
+// GetCapabilities returns Cli_MetaobjectDefinition.Capabilities, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetCapabilities() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities {
+	return v.Capabilities
 }
 
-// GetEnabled returns MetaobjectCapabilityOnlineStoreInput.Enabled, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityOnlineStoreInput) GetEnabled() bool { return v.Enabled }
+// GetDescription returns Cli_MetaobjectDefinition.Description, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetDescription() string { return v.Description }
 
-// GetData returns MetaobjectCapabilityOnlineStoreInput.Data, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityOnlineStoreInput) GetData() MetaobjectCapabilityDefinitionDataOnlineStoreInput {
-	return v.Data
-}
+// GetDisplayNameKey returns Cli_MetaobjectDefinition.DisplayNameKey, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetDisplayNameKey() string { return v.DisplayNameKey }
 
-// The input fields for enabling and disabling the publishable capability.
-type MetaobjectCapabilityPublishableInput struct {
-	// Indicates whether the capability should be enabled or disabled.
-	Enabled bool `json:"enabled"`
+// GetFieldDefinitions returns Cli_MetaobjectDefinition.FieldDefinitions, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetFieldDefinitions() []Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition {
+	return v.FieldDefinitions
 }
 
-// GetEnabled returns MetaobjectCapabilityPublishableInput.Enabled, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityPublishableInput) GetEnabled() bool { return v.Enabled }
+// GetId returns Cli_MetaobjectDefinition.Id, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetId() string { return v.Id }
 
-// The input fields for enabling and disabling the renderable capability.
-type MetaobjectCapabilityRenderableInput struct {
-	// Indicates whether the capability should be enabled or disabled.
-	Enabled bool `json:"enabled"`
-	// The data associated with the renderable capability.
-	Data MetaobjectCapabilityDefinitionDataRenderableInput `json:"data"`
+// GetMetaobjectsCount returns Cli_MetaobjectDefinition.MetaobjectsCount, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetMetaobjectsCount() int { return v.MetaobjectsCount }
+
+// GetName returns Cli_MetaobjectDefinition.Name, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetName() string { return v.Name }
+
+// GetStandardTemplate returns Cli_MetaobjectDefinition.StandardTemplate, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetStandardTemplate() *Cli_MetaobjectDefinitionStandardTemplateStandardMetaobjectDefinitionTemplate {
+	return v.StandardTemplate
 }
 
-// GetEnabled returns MetaobjectCapabilityRenderableInput.Enabled, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityRenderableInput) GetEnabled() bool { return v.Enabled }
+// GetType returns Cli_MetaobjectDefinition.Type, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinition) GetType() string { return v.Type }
 
-// GetData returns MetaobjectCapabilityRenderableInput.Data, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityRenderableInput) GetData() MetaobjectCapabilityDefinitionDataRenderableInput {
-	return v.Data
+// Cli_MetaobjectDefinitionAccessMetaobjectAccess includes the requested fields of the GraphQL type MetaobjectAccess.
+// The GraphQL type's documentation follows.
+//
+// Access permissions for the definition's metaobjects.
+type Cli_MetaobjectDefinitionAccessMetaobjectAccess struct {
+	// The access permitted on the Admin API.
+	Admin MetaobjectAdminAccess `json:"admin"`
+	// The access permitted on the Storefront API.
+	Storefront MetaobjectStorefrontAccess `json:"storefront"`
 }
 
-// The input fields for enabling and disabling the translatable capability.
-type MetaobjectCapabilityTranslatableInput struct {
-	// Indicates whether the capability should be enabled or disabled.
-	Enabled bool `json:"enabled"`
+// GetAdmin returns Cli_MetaobjectDefinitionAccessMetaobjectAccess.Admin, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionAccessMetaobjectAccess) GetAdmin() MetaobjectAdminAccess {
+	return v.Admin
 }
 
-// GetEnabled returns MetaobjectCapabilityTranslatableInput.Enabled, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityTranslatableInput) GetEnabled() bool { return v.Enabled }
+// GetStorefront returns Cli_MetaobjectDefinitionAccessMetaobjectAccess.Storefront, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionAccessMetaobjectAccess) GetStorefront() MetaobjectStorefrontAccess {
+	return v.Storefront
+}
 
-// The input fields for updating a metaobject capability.
-type MetaobjectCapabilityUpdateInput struct {
-	// The input for updating the publishable capability.
-	Publishable MetaobjectCapabilityPublishableInput `json:"publishable"`
-	// The input for updating the translatable capability.
-	Translatable MetaobjectCapabilityTranslatableInput `json:"translatable"`
-	// The input for enabling the renderable capability.
-	Renderable MetaobjectCapabilityRenderableInput `json:"renderable"`
-	// The input for enabling the Online Store capability.
-	OnlineStore MetaobjectCapabilityOnlineStoreInput `json:"onlineStore"`
+// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities includes the requested fields of the GraphQL type MetaobjectCapabilities.
+// The GraphQL type's documentation follows.
+//
+// Provides the capabilities of a metaobject definition.
+type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities struct {
+	// Indicates whether a metaobject definition can be displayed as a page on the Online Store.
+	OnlineStore Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore `json:"onlineStore"`
+	// Indicate whether a metaobject definition is publishable.
+	Publishable Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable `json:"publishable"`
+	// Indicate whether a metaobject definition is renderable and exposes SEO data.
+	Renderable Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable `json:"renderable"`
+	// Indicate whether a metaobject definition is translatable.
+	Translatable Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable `json:"translatable"`
 }
 
-// GetPublishable returns MetaobjectCapabilityUpdateInput.Publishable, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityUpdateInput) GetPublishable() MetaobjectCapabilityPublishableInput {
-	return v.Publishable
+// GetOnlineStore returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities.OnlineStore, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities) GetOnlineStore() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore {
+	return v.OnlineStore
 }
 
-// GetTranslatable returns MetaobjectCapabilityUpdateInput.Translatable, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityUpdateInput) GetTranslatable() MetaobjectCapabilityTranslatableInput {
-	return v.Translatable
+// GetPublishable returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities.Publishable, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities) GetPublishable() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable {
+	return v.Publishable
 }
 
-// GetRenderable returns MetaobjectCapabilityUpdateInput.Renderable, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityUpdateInput) GetRenderable() MetaobjectCapabilityRenderableInput {
+// GetRenderable returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities.Renderable, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities) GetRenderable() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable {
 	return v.Renderable
 }
 
-// GetOnlineStore returns MetaobjectCapabilityUpdateInput.OnlineStore, and is useful for accessing the field via an interface.
-func (v *MetaobjectCapabilityUpdateInput) GetOnlineStore() MetaobjectCapabilityOnlineStoreInput {
-	return v.OnlineStore
+// GetTranslatable returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities.Translatable, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilities) GetTranslatable() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable {
+	return v.Translatable
 }
 
-// The input fields for creating a metaobject definition.
-type MetaobjectDefinitionCreateInput struct {
-	// A human-readable name for the definition. This can be changed at any time.
-	Name string `json:"name,omitempty"`
-	// An administrative description of the definition.
-	Description string `json:"description"`
-	// The type of the metaobject definition. This can't be changed.
-	//
-	// Must be 3-255 characters long and only contain alphanumeric, hyphen, and underscore characters.
-	Type string `json:"type"`
-	// A set of field definitions to create on this metaobject definition.
-	FieldDefinitions []MetaobjectFieldDefinitionCreateInput `json:"fieldDefinitions"`
-	// Access configuration for the metaobjects created with this definition.
-	Access CustomMetaobjectAccessInput `json:"access"`
-	// The key of a field to reference as the display name for metaobjects of this type.
-	DisplayNameKey string `json:"displayNameKey,omitempty"`
-	// The capabilities of the metaobject definition.
-	Capabilities MetaobjectCapabilityCreateInput `json:"capabilities"`
+// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore includes the requested fields of the GraphQL type MetaobjectCapabilitiesOnlineStore.
+// The GraphQL type's documentation follows.
+//
+// The Online Store capability of a metaobject definition.
+type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore struct {
+	// The data associated with the Online Store capability.
+	Data Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore `json:"data"`
+	// Indicates if the capability is enabled.
+	Enabled bool `json:"enabled"`
 }
 
-// GetName returns MetaobjectDefinitionCreateInput.Name, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionCreateInput) GetName() string { return v.Name }
-
-// GetDescription returns MetaobjectDefinitionCreateInput.Description, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionCreateInput) GetDescription() string { return v.Description }
+// GetData returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore.Data, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore) GetData() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore {
+	return v.Data
+}
 
-// GetType returns MetaobjectDefinitionCreateInput.Type, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionCreateInput) GetType() string { return v.Type }
+// GetEnabled returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore.Enabled, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStore) GetEnabled() bool {
+	return v.Enabled
+}
 
-// GetFieldDefinitions returns MetaobjectDefinitionCreateInput.FieldDefinitions, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionCreateInput) GetFieldDefinitions() []MetaobjectFieldDefinitionCreateInput {
-	return v.FieldDefinitions
+// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore includes the requested fields of the GraphQL type MetaobjectCapabilityDefinitionDataOnlineStore.
+// The GraphQL type's documentation follows.
+//
+// The Online Store capability data for the metaobject definition.
+type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore struct {
+	// Flag indicating if a sufficient number of redirects are available to redirect all published entries.
+	CanCreateRedirects bool `json:"canCreateRedirects"`
+	// The URL handle for accessing pages of this metaobject type in the Online Store.
+	UrlHandle string `json:"urlHandle"`
 }
 
-// GetAccess returns MetaobjectDefinitionCreateInput.Access, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionCreateInput) GetAccess() CustomMetaobjectAccessInput { return v.Access }
+// GetCanCreateRedirects returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore.CanCreateRedirects, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore) GetCanCreateRedirects() bool {
+	return v.CanCreateRedirects
+}
 
-// GetDisplayNameKey returns MetaobjectDefinitionCreateInput.DisplayNameKey, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionCreateInput) GetDisplayNameKey() string { return v.DisplayNameKey }
+// GetUrlHandle returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore.UrlHandle, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesOnlineStoreDataMetaobjectCapabilityDefinitionDataOnlineStore) GetUrlHandle() string {
+	return v.UrlHandle
+}
 
-// GetCapabilities returns MetaobjectDefinitionCreateInput.Capabilities, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionCreateInput) GetCapabilities() MetaobjectCapabilityCreateInput {
-	return v.Capabilities
+// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable includes the requested fields of the GraphQL type MetaobjectCapabilitiesPublishable.
+// The GraphQL type's documentation follows.
+//
+// The publishable capability of a metaobject definition.
+type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable struct {
+	// Indicates if the capability is enabled.
+	Enabled bool `json:"enabled"`
 }
 
-// The input fields for updating a metaobject definition.
-type MetaobjectDefinitionUpdateInput struct {
-	// A human-readable name for the definition.
-	Name string `json:"name,omitempty"`
-	// An administrative description of the definition.
-	Description string `json:"description"`
-	// A set of operations for modifying field definitions.
-	FieldDefinitions []CustomMetaobjectFieldDefinitionOperationInput `json:"fieldDefinitions"`
-	// Access configuration for the metaobjects created with this definition.
-	Access CustomMetaobjectAccessInput `json:"access"`
-	// The key of a metafield to reference as the display name for objects of this type.
-	DisplayNameKey string `json:"displayNameKey,omitempty"`
-	// Whether the field order should be reset while updating.
-	// If `true`, then the order is assigned based on submitted fields followed by alphabetized field omissions.
-	// If `false`, then no changes are made to the existing field order and new fields are appended at the end.
-	ResetFieldOrder bool `json:"resetFieldOrder"`
-	// The capabilities of the metaobject definition.
-	Capabilities MetaobjectCapabilityUpdateInput `json:"capabilities"`
+// GetEnabled returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable.Enabled, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesPublishable) GetEnabled() bool {
+	return v.Enabled
 }
 
-// GetName returns MetaobjectDefinitionUpdateInput.Name, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionUpdateInput) GetName() string { return v.Name }
+// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable includes the requested fields of the GraphQL type MetaobjectCapabilitiesRenderable.
+// The GraphQL type's documentation follows.
+//
+// The renderable capability of a metaobject definition.
+type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable struct {
+	// Indicates if the capability is enabled.
+	Enabled bool `json:"enabled"`
+	// The data associated with the renderable capability.
+	Data Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable `json:"data"`
+}
 
-// GetDescription returns MetaobjectDefinitionUpdateInput.Description, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionUpdateInput) GetDescription() string { return v.Description }
+// GetEnabled returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable.Enabled, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable) GetEnabled() bool {
+	return v.Enabled
+}
 
-// GetFieldDefinitions returns MetaobjectDefinitionUpdateInput.FieldDefinitions, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionUpdateInput) GetFieldDefinitions() []CustomMetaobjectFieldDefinitionOperationInput {
-	return v.FieldDefinitions
+// GetData returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable.Data, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderable) GetData() Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable {
+	return v.Data
 }
 
-// GetAccess returns MetaobjectDefinitionUpdateInput.Access, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionUpdateInput) GetAccess() CustomMetaobjectAccessInput { return v.Access }
+// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable includes the requested fields of the GraphQL type MetaobjectCapabilityDefinitionDataRenderable.
+// The GraphQL type's documentation follows.
+//
+// The renderable capability data for the metaobject definition.
+type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable struct {
+	// The metaobject field used as an alias for the SEO page description.
+	MetaDescriptionKey string `json:"metaDescriptionKey"`
+	// The metaobject field used as an alias for the SEO page title.
+	MetaTitleKey string `json:"metaTitleKey"`
+}
 
-// GetDisplayNameKey returns MetaobjectDefinitionUpdateInput.DisplayNameKey, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionUpdateInput) GetDisplayNameKey() string { return v.DisplayNameKey }
+// GetMetaDescriptionKey returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable.MetaDescriptionKey, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable) GetMetaDescriptionKey() string {
+	return v.MetaDescriptionKey
+}
 
-// GetResetFieldOrder returns MetaobjectDefinitionUpdateInput.ResetFieldOrder, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionUpdateInput) GetResetFieldOrder() bool { return v.ResetFieldOrder }
+// GetMetaTitleKey returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable.MetaTitleKey, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesRenderableDataMetaobjectCapabilityDefinitionDataRenderable) GetMetaTitleKey() string {
+	return v.MetaTitleKey
+}
 
-// GetCapabilities returns MetaobjectDefinitionUpdateInput.Capabilities, and is useful for accessing the field via an interface.
-func (v *MetaobjectDefinitionUpdateInput) GetCapabilities() MetaobjectCapabilityUpdateInput {
-	return v.Capabilities
+// Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable includes the requested fields of the GraphQL type MetaobjectCapabilitiesTranslatable.
+// The GraphQL type's documentation follows.
+//
+// The translatable capability of a metaobject definition.
+type Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable struct {
+	// Indicates if the capability is enabled.
+	Enabled bool `json:"enabled"`
 }
 
-// The input fields for creating a metaobject field definition.
-type MetaobjectFieldDefinitionCreateInput struct {
-	// The key of the new field definition. This can't be changed.
-	//
-	// Must be 2-64 characters long and only contain alphanumeric, hyphen, and underscore characters.
+// GetEnabled returns Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable.Enabled, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionCapabilitiesMetaobjectCapabilitiesTranslatable) GetEnabled() bool {
+	return v.Enabled
+}
+
+// Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition includes the requested fields of the GraphQL type MetaobjectFieldDefinition.
+// The GraphQL type's documentation follows.
+//
+// Defines a field for a MetaobjectDefinition with properties
+// such as the field's data type and validations.
+type Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition struct {
+	// The administrative description.
+	Description string `json:"description"`
+	// A key name used to identify the field within the metaobject composition.
 	Key string `json:"key"`
-	// The metafield type applied to values of the field.
-	Type string `json:"type"`
-	// A human-readable name for the field. This can be changed at any time.
+	// The human-readable name.
 	Name string `json:"name"`
-	// An administrative description of the field.
-	Description string `json:"description"`
-	// Whether metaobjects require a saved value for the field.
+	// Required status of the field within the metaobject composition.
 	Required bool `json:"required"`
-	// Custom validations that apply to values assigned to the field.
-	Validations []MetafieldDefinitionValidationInput `json:"validations"`
+	// The type of data that the field stores.
+	Type Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType `json:"type"`
+	// A list of [validation options](https://shopify.dev/apps/metafields/definitions/validation) for
+	// the field. For example, a field with the type `date` can set a minimum date requirement.
+	Validations []Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation `json:"validations"`
 }
 
-// GetKey returns MetaobjectFieldDefinitionCreateInput.Key, and is useful for accessing the field via an interface.
-func (v *MetaobjectFieldDefinitionCreateInput) GetKey() string { return v.Key }
+// GetDescription returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Description, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetDescription() string {
+	return v.Description
+}
 
-// GetType returns MetaobjectFieldDefinitionCreateInput.Type, and is useful for accessing the field via an interface.
-func (v *MetaobjectFieldDefinitionCreateInput) GetType() string { return v.Type }
+// GetKey returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Key, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetKey() string {
+	return v.Key
+}
 
-// GetName returns MetaobjectFieldDefinitionCreateInput.Name, and is useful for accessing the field via an interface.
-func (v *MetaobjectFieldDefinitionCreateInput) GetName() string { return v.Name }
+// GetName returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Name, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetName() string {
+	return v.Name
+}
 
-// GetDescription returns MetaobjectFieldDefinitionCreateInput.Description, and is useful for accessing the field via an interface.
-func (v *MetaobjectFieldDefinitionCreateInput) GetDescription() string { return v.Description }
+// GetRequired returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Required, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetRequired() bool {
+	return v.Required
+}
 
-// GetRequired returns MetaobjectFieldDefinitionCreateInput.Required, and is useful for accessing the field via an interface.
-func (v *MetaobjectFieldDefinitionCreateInput) GetRequired() bool { return v.Required }
+// GetType returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Type, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetType() Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType {
+	return v.Type
+}
 
-// GetValidations returns MetaobjectFieldDefinitionCreateInput.Validations, and is useful for accessing the field via an interface.
-func (v *MetaobjectFieldDefinitionCreateInput) GetValidations() []MetafieldDefinitionValidationInput {
+// GetValidations returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition.Validations, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinition) GetValidations() []Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation {
 	return v.Validations
 }
 
-// Metaobject access permissions for the Storefront API.
-type MetaobjectStorefrontAccess string
+// Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType includes the requested fields of the GraphQL type MetafieldDefinitionType.
+// The GraphQL type's documentation follows.
+//
+// A metafield definition type provides basic foundation and validation for a metafield.
+type Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType struct {
+	// The category associated with the metafield definition type.
+	Category string `json:"category"`
+	// The name of the type for the metafield definition.
+	// See the list of [supported types](https://shopify.dev/apps/metafields/types).
+	Name string `json:"name"`
+}
 
-const (
-	// No access.
-	MetaobjectStorefrontAccessNone MetaobjectStorefrontAccess = "NONE"
-	// Read-only access.
-	MetaobjectStorefrontAccessPublicRead MetaobjectStorefrontAccess = "PUBLIC_READ"
-)
+// GetCategory returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType.Category, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType) GetCategory() string {
+	return v.Category
+}
 
-var AllMetaobjectStorefrontAccess = []MetaobjectStorefrontAccess{
-	MetaobjectStorefrontAccessNone,
-	MetaobjectStorefrontAccessPublicRead,
+// GetName returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType.Name, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionTypeMetafieldDefinitionType) GetName() string {
+	return v.Name
 }
 
-// Possible error codes that can be returned by `MetaobjectUserError`.
-type MetaobjectUserErrorCode string
+// Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation includes the requested fields of the GraphQL type MetafieldDefinitionValidation.
+// The GraphQL type's documentation follows.
+//
+// A configured metafield definition validation.
+//
+// For example, for a metafield definition of `number_integer` type, you can set a validation with the name `max`
+// and a value of `15`. This validation will ensure that the value of the metafield is a number less than or equal to 15.
+//
+// Refer to the [list of supported validations](https://shopify.dev/api/admin/graphql/reference/common-objects/metafieldDefinitionTypes#examples-Fetch_all_metafield_definition_types).
+type Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation struct {
+	// The validation name.
+	Name string `json:"name"`
+	// The validation value.
+	Value string `json:"value"`
+}
 
-const (
-	// The input value is invalid.
-	MetaobjectUserErrorCodeInvalid MetaobjectUserErrorCode = "INVALID"
-	// The input value isn't included in the list.
-	MetaobjectUserErrorCodeInclusion MetaobjectUserErrorCode = "INCLUSION"
-	// The input value is already taken.
-	MetaobjectUserErrorCodeTaken MetaobjectUserErrorCode = "TAKEN"
-	// The input value is too long.
-	MetaobjectUserErrorCodeTooLong MetaobjectUserErrorCode = "TOO_LONG"
-	// The input value is too short.
-	MetaobjectUserErrorCodeTooShort MetaobjectUserErrorCode = "TOO_SHORT"
-	// The input value needs to be blank.
-	MetaobjectUserErrorCodePresent MetaobjectUserErrorCode = "PRESENT"
-	// The input value is blank.
-	MetaobjectUserErrorCodeBlank MetaobjectUserErrorCode = "BLANK"
-	// The metafield type is invalid.
-	MetaobjectUserErrorCodeInvalidType MetaobjectUserErrorCode = "INVALID_TYPE"
-	// The value is invalid for the metafield type or the definition options.
-	MetaobjectUserErrorCodeInvalidValue MetaobjectUserErrorCode = "INVALID_VALUE"
-	// The value for the metafield definition option was invalid.
-	MetaobjectUserErrorCodeInvalidOption MetaobjectUserErrorCode = "INVALID_OPTION"
-	// Duplicate inputs were provided for this field key.
-	MetaobjectUserErrorCodeDuplicateFieldInput MetaobjectUserErrorCode = "DUPLICATE_FIELD_INPUT"
-	// No metaobject definition found for this type.
-	MetaobjectUserErrorCodeUndefinedObjectType MetaobjectUserErrorCode = "UNDEFINED_OBJECT_TYPE"
-	// No field definition found for this key.
-	MetaobjectUserErrorCodeUndefinedObjectField MetaobjectUserErrorCode = "UNDEFINED_OBJECT_FIELD"
-	// The specified field key is already in use.
-	MetaobjectUserErrorCodeObjectFieldTaken MetaobjectUserErrorCode = "OBJECT_FIELD_TAKEN"
-	// Missing required fields were found for this object.
-	MetaobjectUserErrorCodeObjectFieldRequired MetaobjectUserErrorCode = "OBJECT_FIELD_REQUIRED"
-	// The requested record couldn't be found.
-	MetaobjectUserErrorCodeRecordNotFound MetaobjectUserErrorCode = "RECORD_NOT_FOUND"
-	// An unexpected error occurred.
-	MetaobjectUserErrorCodeInternalError MetaobjectUserErrorCode = "INTERNAL_ERROR"
-	// The maximum number of metaobjects definitions has been exceeded.
-	MetaobjectUserErrorCodeMaxDefinitionsExceeded MetaobjectUserErrorCode = "MAX_DEFINITIONS_EXCEEDED"
-	// The maximum number of metaobjects per shop has been exceeded.
-	MetaobjectUserErrorCodeMaxObjectsExceeded MetaobjectUserErrorCode = "MAX_OBJECTS_EXCEEDED"
-	// The targeted object cannot be modified.
-	MetaobjectUserErrorCodeImmutable MetaobjectUserErrorCode = "IMMUTABLE"
-	// Not authorized.
-	MetaobjectUserErrorCodeNotAuthorized MetaobjectUserErrorCode = "NOT_AUTHORIZED"
-	// The provided name is reserved for system use.
-	MetaobjectUserErrorCodeReservedName MetaobjectUserErrorCode = "RESERVED_NAME"
-	// The display name cannot be the same when using the metaobject as a product option.
-	MetaobjectUserErrorCodeDisplayNameConflict MetaobjectUserErrorCode = "DISPLAY_NAME_CONFLICT"
-	// Admin access can only be specified on metaobject definitions that have an app-reserved type.
-	MetaobjectUserErrorCodeAdminAccessInputNotAllowed MetaobjectUserErrorCode = "ADMIN_ACCESS_INPUT_NOT_ALLOWED"
-	// The capability you are using is not enabled.
-	MetaobjectUserErrorCodeCapabilityNotEnabled MetaobjectUserErrorCode = "CAPABILITY_NOT_ENABLED"
-	// The Online Store URL handle is already taken.
-	MetaobjectUserErrorCodeUrlHandleTaken MetaobjectUserErrorCode = "URL_HANDLE_TAKEN"
-	// The Online Store URL handle is invalid.
-	MetaobjectUserErrorCodeUrlHandleInvalid MetaobjectUserErrorCode = "URL_HANDLE_INVALID"
-	// The Online Store URL handle cannot be blank.
-	MetaobjectUserErrorCodeUrlHandleBlank MetaobjectUserErrorCode = "URL_HANDLE_BLANK"
-	// Renderable data input is referencing an invalid field.
-	MetaobjectUserErrorCodeFieldTypeInvalid MetaobjectUserErrorCode = "FIELD_TYPE_INVALID"
-	// The input is missing required keys.
-	MetaobjectUserErrorCodeMissingRequiredKeys MetaobjectUserErrorCode = "MISSING_REQUIRED_KEYS"
-	// The action cannot be completed because associated metaobjects are referenced by another resource.
-	MetaobjectUserErrorCodeReferenceExistsError MetaobjectUserErrorCode = "REFERENCE_EXISTS_ERROR"
-)
+// GetName returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation.Name, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation) GetName() string {
+	return v.Name
+}
 
-var AllMetaobjectUserErrorCode = []MetaobjectUserErrorCode{
-	MetaobjectUserErrorCodeInvalid,
-	MetaobjectUserErrorCodeInclusion,
-	MetaobjectUserErrorCodeTaken,
-	MetaobjectUserErrorCodeTooLong,
-	MetaobjectUserErrorCodeTooShort,
-	MetaobjectUserErrorCodePresent,
-	MetaobjectUserErrorCodeBlank,
-	MetaobjectUserErrorCodeInvalidType,
-	MetaobjectUserErrorCodeInvalidValue,
-	MetaobjectUserErrorCodeInvalidOption,
-	MetaobjectUserErrorCodeDuplicateFieldInput,
-	MetaobjectUserErrorCodeUndefinedObjectType,
-	MetaobjectUserErrorCodeUndefinedObjectField,
-	MetaobjectUserErrorCodeObjectFieldTaken,
-	MetaobjectUserErrorCodeObjectFieldRequired,
-	MetaobjectUserErrorCodeRecordNotFound,
-	MetaobjectUserErrorCodeInternalError,
-	MetaobjectUserErrorCodeMaxDefinitionsExceeded,
-	MetaobjectUserErrorCodeMaxObjectsExceeded,
-	MetaobjectUserErrorCodeImmutable,
-	MetaobjectUserErrorCodeNotAuthorized,
-	MetaobjectUserErrorCodeReservedName,
-	MetaobjectUserErrorCodeDisplayNameConflict,
-	MetaobjectUserErrorCodeAdminAccessInputNotAllowed,
-	MetaobjectUserErrorCodeCapabilityNotEnabled,
-	MetaobjectUserErrorCodeUrlHandleTaken,
-	MetaobjectUserErrorCodeUrlHandleInvalid,
-	MetaobjectUserErrorCodeUrlHandleBlank,
-	MetaobjectUserErrorCodeFieldTypeInvalid,
-	MetaobjectUserErrorCodeMissingRequiredKeys,
-	MetaobjectUserErrorCodeReferenceExistsError,
+// GetValue returns Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation.Value, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionFieldDefinitionsMetaobjectFieldDefinitionValidationsMetafieldDefinitionValidation) GetValue() string {
+	return v.Value
 }
 
-// UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload includes the requested fields of the GraphQL type MetaobjectDefinitionUpdatePayload.
+// Cli_MetaobjectDefinitionStandardTemplateStandardMetaobjectDefinitionTemplate includes the requested fields of the GraphQL type StandardMetaobjectDefinitionTemplate.
 // The GraphQL type's documentation follows.
 //
-// Return type for `metaobjectDefinitionUpdate` mutation.
-type UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload struct {
-	// The updated metaobject definition.
-	MetaobjectDefinition UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition `json:"metaobjectDefinition"`
-	// The list of errors that occurred from executing the mutation.
-	UserErrors []UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError `json:"userErrors"`
+// Standard metaobject definition templates provide preset configurations to create metaobject definitions.
+type Cli_MetaobjectDefinitionStandardTemplateStandardMetaobjectDefinitionTemplate struct {
+	// The namespace owned by the definition after the definition has been enabled.
+	Type string `json:"type"`
 }
 
-// GetMetaobjectDefinition returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload.MetaobjectDefinition, and is useful for accessing the field via an interface.
-func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload) GetMetaobjectDefinition() UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition {
-	return v.MetaobjectDefinition
+// GetType returns Cli_MetaobjectDefinitionStandardTemplateStandardMetaobjectDefinitionTemplate.Type, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectDefinitionStandardTemplateStandardMetaobjectDefinitionTemplate) GetType() string {
+	return v.Type
 }
 
-// GetUserErrors returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload.UserErrors, and is useful for accessing the field via an interface.
-func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload) GetUserErrors() []UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError {
-	return v.UserErrors
+// Cli_MetaobjectFieldValues includes the GraphQL fields of Metaobject requested by the fragment Cli_MetaobjectFieldValues.
+// The GraphQL type's documentation follows.
+//
+// Provides an object instance represented by a MetaobjectDefinition.
+type Cli_MetaobjectFieldValues struct {
+	// All ordered fields of the metaobject with their definitions and values.
+	Fields []Cli_MetaobjectFieldValuesFieldsMetaobjectField `json:"fields"`
 }
 
-// UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition includes the requested fields of the GraphQL type MetaobjectDefinition.
+// GetFields returns Cli_MetaobjectFieldValues.Fields, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectFieldValues) GetFields() []Cli_MetaobjectFieldValuesFieldsMetaobjectField {
+	return v.Fields
+}
+
+// Cli_MetaobjectFieldValuesFieldsMetaobjectField includes the requested fields of the GraphQL type MetaobjectField.
 // The GraphQL type's documentation follows.
 //
-// Provides the definition of a generic object structure composed of metafields.
-type UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition struct {
-	// A globally-unique ID.
-	Id string `json:"id"`
-	// The type of the object definition. Defines the namespace of associated metafields.
+// Provides a field definition and the data value assigned to it.
+type Cli_MetaobjectFieldValuesFieldsMetaobjectField struct {
+	// The object key of this field.
+	Key string `json:"key"`
+	// The assigned field value, always stored as a string regardless of the field type.
+	Value string `json:"value"`
+}
+
+// GetKey returns Cli_MetaobjectFieldValuesFieldsMetaobjectField.Key, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectFieldValuesFieldsMetaobjectField) GetKey() string { return v.Key }
+
+// GetValue returns Cli_MetaobjectFieldValuesFieldsMetaobjectField.Value, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectFieldValuesFieldsMetaobjectField) GetValue() string { return v.Value }
+
+// Cli_MetaobjectFieldsMetaobjectField includes the requested fields of the GraphQL type MetaobjectField.
+// The GraphQL type's documentation follows.
+//
+// Provides a field definition and the data value assigned to it.
+type Cli_MetaobjectFieldsMetaobjectField struct {
+	// The object key of this field.
+	Key string `json:"key"`
+	// The type of the field.
 	Type string `json:"type"`
+	// The assigned field value, always stored as a string regardless of the field type.
+	Value string `json:"value"`
 }
 
-// GetId returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition.Id, and is useful for accessing the field via an interface.
-func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition) GetId() string {
-	return v.Id
+// GetKey returns Cli_MetaobjectFieldsMetaobjectField.Key, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectFieldsMetaobjectField) GetKey() string { return v.Key }
+
+// GetType returns Cli_MetaobjectFieldsMetaobjectField.Type, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectFieldsMetaobjectField) GetType() string { return v.Type }
+
+// GetValue returns Cli_MetaobjectFieldsMetaobjectField.Value, and is useful for accessing the field via an interface.
+func (v *Cli_MetaobjectFieldsMetaobjectField) GetValue() string { return v.Value }
+
+// Cli_Referencer includes the GraphQL fields of MetafieldReferencer requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+//
+// Cli_Referencer is implemented by the following types:
+// Cli_ReferencerAppInstallation
+// Cli_ReferencerArticle
+// Cli_ReferencerBlog
+// Cli_ReferencerCollection
+// Cli_ReferencerCompany
+// Cli_ReferencerCompanyLocation
+// Cli_ReferencerCustomer
+// Cli_ReferencerDeliveryCustomization
+// Cli_ReferencerDiscountAutomaticNode
+// Cli_ReferencerDiscountCodeNode
+// Cli_ReferencerDiscountNode
+// Cli_ReferencerDraftOrder
+// Cli_ReferencerFulfillmentOrder
+// Cli_ReferencerLocation
+// Cli_ReferencerMarket
+// Cli_ReferencerMetaobject
+// Cli_ReferencerOrder
+// Cli_ReferencerPage
+// Cli_ReferencerPaymentCustomization
+// Cli_ReferencerProduct
+// Cli_ReferencerProductVariant
+// Cli_ReferencerShop
+type Cli_Referencer interface {
+	implementsGraphQLInterfaceCli_Referencer()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
 }
 
-// GetType returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition.Type, and is useful for accessing the field via an interface.
-func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition) GetType() string {
-	return v.Type
+func (v *Cli_ReferencerAppInstallation) implementsGraphQLInterfaceCli_Referencer()       {}
+func (v *Cli_ReferencerArticle) implementsGraphQLInterfaceCli_Referencer()               {}
+func (v *Cli_ReferencerBlog) implementsGraphQLInterfaceCli_Referencer()                  {}
+func (v *Cli_ReferencerCollection) implementsGraphQLInterfaceCli_Referencer()            {}
+func (v *Cli_ReferencerCompany) implementsGraphQLInterfaceCli_Referencer()               {}
+func (v *Cli_ReferencerCompanyLocation) implementsGraphQLInterfaceCli_Referencer()       {}
+func (v *Cli_ReferencerCustomer) implementsGraphQLInterfaceCli_Referencer()              {}
+func (v *Cli_ReferencerDeliveryCustomization) implementsGraphQLInterfaceCli_Referencer() {}
+func (v *Cli_ReferencerDiscountAutomaticNode) implementsGraphQLInterfaceCli_Referencer() {}
+func (v *Cli_ReferencerDiscountCodeNode) implementsGraphQLInterfaceCli_Referencer()      {}
+func (v *Cli_ReferencerDiscountNode) implementsGraphQLInterfaceCli_Referencer()          {}
+func (v *Cli_ReferencerDraftOrder) implementsGraphQLInterfaceCli_Referencer()            {}
+func (v *Cli_ReferencerFulfillmentOrder) implementsGraphQLInterfaceCli_Referencer()      {}
+func (v *Cli_ReferencerLocation) implementsGraphQLInterfaceCli_Referencer()              {}
+func (v *Cli_ReferencerMarket) implementsGraphQLInterfaceCli_Referencer()                {}
+func (v *Cli_ReferencerMetaobject) implementsGraphQLInterfaceCli_Referencer()            {}
+func (v *Cli_ReferencerOrder) implementsGraphQLInterfaceCli_Referencer()                 {}
+func (v *Cli_ReferencerPage) implementsGraphQLInterfaceCli_Referencer()                  {}
+func (v *Cli_ReferencerPaymentCustomization) implementsGraphQLInterfaceCli_Referencer()  {}
+func (v *Cli_ReferencerProduct) implementsGraphQLInterfaceCli_Referencer()               {}
+func (v *Cli_ReferencerProductVariant) implementsGraphQLInterfaceCli_Referencer()        {}
+func (v *Cli_ReferencerShop) implementsGraphQLInterfaceCli_Referencer()                  {}
+
+func __unmarshalCli_Referencer(b []byte, v *Cli_Referencer) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AppInstallation":
+		*v = new(Cli_ReferencerAppInstallation)
+		return json.Unmarshal(b, *v)
+	case "Article":
+		*v = new(Cli_ReferencerArticle)
+		return json.Unmarshal(b, *v)
+	case "Blog":
+		*v = new(Cli_ReferencerBlog)
+		return json.Unmarshal(b, *v)
+	case "Collection":
+		*v = new(Cli_ReferencerCollection)
+		return json.Unmarshal(b, *v)
+	case "Company":
+		*v = new(Cli_ReferencerCompany)
+		return json.Unmarshal(b, *v)
+	case "CompanyLocation":
+		*v = new(Cli_ReferencerCompanyLocation)
+		return json.Unmarshal(b, *v)
+	case "Customer":
+		*v = new(Cli_ReferencerCustomer)
+		return json.Unmarshal(b, *v)
+	case "DeliveryCustomization":
+		*v = new(Cli_ReferencerDeliveryCustomization)
+		return json.Unmarshal(b, *v)
+	case "DiscountAutomaticNode":
+		*v = new(Cli_ReferencerDiscountAutomaticNode)
+		return json.Unmarshal(b, *v)
+	case "DiscountCodeNode":
+		*v = new(Cli_ReferencerDiscountCodeNode)
+		return json.Unmarshal(b, *v)
+	case "DiscountNode":
+		*v = new(Cli_ReferencerDiscountNode)
+		return json.Unmarshal(b, *v)
+	case "DraftOrder":
+		*v = new(Cli_ReferencerDraftOrder)
+		return json.Unmarshal(b, *v)
+	case "FulfillmentOrder":
+		*v = new(Cli_ReferencerFulfillmentOrder)
+		return json.Unmarshal(b, *v)
+	case "Location":
+		*v = new(Cli_ReferencerLocation)
+		return json.Unmarshal(b, *v)
+	case "Market":
+		*v = new(Cli_ReferencerMarket)
+		return json.Unmarshal(b, *v)
+	case "Metaobject":
+		*v = new(Cli_ReferencerMetaobject)
+		return json.Unmarshal(b, *v)
+	case "Order":
+		*v = new(Cli_ReferencerOrder)
+		return json.Unmarshal(b, *v)
+	case "Page":
+		*v = new(Cli_ReferencerPage)
+		return json.Unmarshal(b, *v)
+	case "PaymentCustomization":
+		*v = new(Cli_ReferencerPaymentCustomization)
+		return json.Unmarshal(b, *v)
+	case "Product":
+		*v = new(Cli_ReferencerProduct)
+		return json.Unmarshal(b, *v)
+	case "ProductVariant":
+		*v = new(Cli_ReferencerProductVariant)
+		return json.Unmarshal(b, *v)
+	case "Shop":
+		*v = new(Cli_ReferencerShop)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing MetafieldReferencer.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for Cli_Referencer: "%v"`, tn.TypeName)
+	}
 }
 
-// UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError includes the requested fields of the GraphQL type MetaobjectUserError.
+func __marshalCli_Referencer(v *Cli_Referencer) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *Cli_ReferencerAppInstallation:
+		typename = "AppInstallation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerAppInstallation
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerArticle:
+		typename = "Article"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerArticle
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerBlog:
+		typename = "Blog"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerBlog
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerCollection:
+		typename = "Collection"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerCollection
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerCompany:
+		typename = "Company"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerCompany
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerCompanyLocation:
+		typename = "CompanyLocation"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerCompanyLocation
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerCustomer:
+		typename = "Customer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerCustomer
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerDeliveryCustomization:
+		typename = "DeliveryCustomization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerDeliveryCustomization
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerDiscountAutomaticNode:
+		typename = "DiscountAutomaticNode"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerDiscountAutomaticNode
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerDiscountCodeNode:
+		typename = "DiscountCodeNode"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerDiscountCodeNode
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerDiscountNode:
+		typename = "DiscountNode"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerDiscountNode
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerDraftOrder:
+		typename = "DraftOrder"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerDraftOrder
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerFulfillmentOrder:
+		typename = "FulfillmentOrder"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerFulfillmentOrder
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerLocation:
+		typename = "Location"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerLocation
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerMarket:
+		typename = "Market"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerMarket
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerMetaobject:
+		typename = "Metaobject"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerMetaobject
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerOrder:
+		typename = "Order"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerOrder
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerPage:
+		typename = "Page"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerPage
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerPaymentCustomization:
+		typename = "PaymentCustomization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerPaymentCustomization
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerProduct:
+		typename = "Product"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerProduct
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerProductVariant:
+		typename = "ProductVariant"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerProductVariant
+		}{typename, v}
+		return json.Marshal(result)
+	case *Cli_ReferencerShop:
+		typename = "Shop"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*Cli_ReferencerShop
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for Cli_Referencer: "%T"`, v)
+	}
+}
+
+// Cli_Referencer includes the GraphQL fields of AppInstallation requested by the fragment Cli_Referencer.
 // The GraphQL type's documentation follows.
 //
-// Defines errors encountered while managing metaobject resources.
-type UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError struct {
-	// The path to the input field that caused the error.
-	Field []string `json:"field"`
-	// The error message.
-	Message string `json:"message"`
-	// The error code.
-	Code MetaobjectUserErrorCode `json:"code"`
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerAppInstallation struct {
+	Typename string `json:"__typename"`
 }
 
-// GetField returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError.Field, and is useful for accessing the field via an interface.
-func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError) GetField() []string {
-	return v.Field
+// GetTypename returns Cli_ReferencerAppInstallation.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerAppInstallation) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of Article requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerArticle struct {
+	Typename string `json:"__typename"`
 }
 
-// GetMessage returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError.Message, and is useful for accessing the field via an interface.
-func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError) GetMessage() string {
-	return v.Message
+// GetTypename returns Cli_ReferencerArticle.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerArticle) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of Blog requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerBlog struct {
+	Typename string `json:"__typename"`
 }
 
-// GetCode returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError.Code, and is useful for accessing the field via an interface.
-func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError) GetCode() MetaobjectUserErrorCode {
-	return v.Code
+// GetTypename returns Cli_ReferencerBlog.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerBlog) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of Collection requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerCollection struct {
+	Typename string `json:"__typename"`
+	// A unique string that identifies the collection. If a handle isn't specified when a collection is created, it's automatically generated from the collection's original title, and typically includes words from the title separated by hyphens. For example, a collection that was created with the title `Summer Catalog 2022` might have the handle `summer-catalog-2022`.
+	//
+	// If the title is changed, the handle doesn't automatically change.
+	//
+	// The handle can be used in themes by the Liquid templating language to refer to the collection, but using the ID is preferred because it never changes.
+	Handle string `json:"handle"`
 }
 
-// UpdateMetaobjectDefinitionResponse is returned by UpdateMetaobjectDefinition on success.
-type UpdateMetaobjectDefinitionResponse struct {
-	// Updates a metaobject definition with new settings and metafield definitions.
-	MetaobjectDefinitionUpdate UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload `json:"metaobjectDefinitionUpdate"`
+// GetTypename returns Cli_ReferencerCollection.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerCollection) GetTypename() string { return v.Typename }
+
+// GetHandle returns Cli_ReferencerCollection.Handle, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerCollection) GetHandle() string { return v.Handle }
+
+// Cli_Referencer includes the GraphQL fields of Company requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerCompany struct {
+	Typename string `json:"__typename"`
 }
 
-// GetMetaobjectDefinitionUpdate returns UpdateMetaobjectDefinitionResponse.MetaobjectDefinitionUpdate, and is useful for accessing the field via an interface.
-func (v *UpdateMetaobjectDefinitionResponse) GetMetaobjectDefinitionUpdate() UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload {
-	return v.MetaobjectDefinitionUpdate
+// GetTypename returns Cli_ReferencerCompany.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerCompany) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of CompanyLocation requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerCompanyLocation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerCompanyLocation.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerCompanyLocation) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of Customer requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerCustomer struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerCustomer.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerCustomer) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of DeliveryCustomization requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerDeliveryCustomization struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerDeliveryCustomization.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerDeliveryCustomization) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of DiscountAutomaticNode requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerDiscountAutomaticNode struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerDiscountAutomaticNode.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerDiscountAutomaticNode) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of DiscountCodeNode requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerDiscountCodeNode struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerDiscountCodeNode.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerDiscountCodeNode) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of DiscountNode requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerDiscountNode struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerDiscountNode.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerDiscountNode) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of DraftOrder requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerDraftOrder struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerDraftOrder.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerDraftOrder) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of FulfillmentOrder requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerFulfillmentOrder struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerFulfillmentOrder.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerFulfillmentOrder) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of Location requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerLocation struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerLocation.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerLocation) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of Market requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerMarket struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerMarket.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerMarket) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of Metaobject requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerMetaobject struct {
+	Typename string `json:"__typename"`
+	// The type of the metaobject.
+	Type string `json:"type"`
+	// The unique handle of the object, useful as a custom ID.
+	Handle string `json:"handle"`
+}
+
+// GetTypename returns Cli_ReferencerMetaobject.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerMetaobject) GetTypename() string { return v.Typename }
+
+// GetType returns Cli_ReferencerMetaobject.Type, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerMetaobject) GetType() string { return v.Type }
+
+// GetHandle returns Cli_ReferencerMetaobject.Handle, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerMetaobject) GetHandle() string { return v.Handle }
+
+// Cli_Referencer includes the GraphQL fields of Order requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerOrder struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerOrder.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerOrder) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of Page requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerPage struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerPage.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerPage) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of PaymentCustomization requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerPaymentCustomization struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerPaymentCustomization.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerPaymentCustomization) GetTypename() string { return v.Typename }
+
+// Cli_Referencer includes the GraphQL fields of Product requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerProduct struct {
+	Typename string `json:"__typename"`
+	// A unique, human-readable string of the product's title. A handle can contain letters, hyphens (`-`), and numbers, but no spaces.
+	// The handle is used in the online store URL for the product.
+	Handle string `json:"handle"`
+}
+
+// GetTypename returns Cli_ReferencerProduct.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerProduct) GetTypename() string { return v.Typename }
+
+// GetHandle returns Cli_ReferencerProduct.Handle, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerProduct) GetHandle() string { return v.Handle }
+
+// Cli_Referencer includes the GraphQL fields of ProductVariant requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerProductVariant struct {
+	Typename string `json:"__typename"`
+	// A case-sensitive identifier for the product variant in the shop.
+	// Required in order to connect to a fulfillment service.
+	Sku string `json:"sku"`
+}
+
+// GetTypename returns Cli_ReferencerProductVariant.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerProductVariant) GetTypename() string { return v.Typename }
+
+// GetSku returns Cli_ReferencerProductVariant.Sku, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerProductVariant) GetSku() string { return v.Sku }
+
+// Cli_Referencer includes the GraphQL fields of Shop requested by the fragment Cli_Referencer.
+// The GraphQL type's documentation follows.
+//
+// Types of resources that may use metafields to reference other resources.
+type Cli_ReferencerShop struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns Cli_ReferencerShop.Typename, and is useful for accessing the field via an interface.
+func (v *Cli_ReferencerShop) GetTypename() string { return v.Typename }
+
+// CreateFilesFileCreateFileCreatePayload includes the requested fields of the GraphQL type FileCreatePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `fileCreate` mutation.
+type CreateFilesFileCreateFileCreatePayload struct {
+	// The newly created files.
+	Files []Cli_File `json:"-"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError `json:"userErrors"`
+}
+
+// GetFiles returns CreateFilesFileCreateFileCreatePayload.Files, and is useful for accessing the field via an interface.
+func (v *CreateFilesFileCreateFileCreatePayload) GetFiles() []Cli_File { return v.Files }
+
+// GetUserErrors returns CreateFilesFileCreateFileCreatePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *CreateFilesFileCreateFileCreatePayload) GetUserErrors() []CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError {
+	return v.UserErrors
+}
+
+func (v *CreateFilesFileCreateFileCreatePayload) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateFilesFileCreateFileCreatePayload
+		Files []json.RawMessage `json:"files"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateFilesFileCreateFileCreatePayload = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Files
+		src := firstPass.Files
+		*dst = make(
+			[]Cli_File,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalCli_File(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal CreateFilesFileCreateFileCreatePayload.Files: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalCreateFilesFileCreateFileCreatePayload struct {
+	Files []json.RawMessage `json:"files"`
+
+	UserErrors []CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError `json:"userErrors"`
+}
+
+func (v *CreateFilesFileCreateFileCreatePayload) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateFilesFileCreateFileCreatePayload) __premarshalJSON() (*__premarshalCreateFilesFileCreateFileCreatePayload, error) {
+	var retval __premarshalCreateFilesFileCreateFileCreatePayload
+
+	{
+
+		dst := &retval.Files
+		src := v.Files
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalCli_File(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal CreateFilesFileCreateFileCreatePayload.Files: %w", err)
+			}
+		}
+	}
+	retval.UserErrors = v.UserErrors
+	return &retval, nil
+}
+
+// CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError includes the requested fields of the GraphQL type FilesUserError.
+// The GraphQL type's documentation follows.
+//
+// An error that happens during the execution of a Files API query or mutation.
+type CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code FilesErrorCode `json:"code"`
+}
+
+// GetField returns CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError.Field, and is useful for accessing the field via an interface.
+func (v *CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError.Message, and is useful for accessing the field via an interface.
+func (v *CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError.Code, and is useful for accessing the field via an interface.
+func (v *CreateFilesFileCreateFileCreatePayloadUserErrorsFilesUserError) GetCode() FilesErrorCode {
+	return v.Code
+}
+
+// CreateFilesResponse is returned by CreateFiles on success.
+type CreateFilesResponse struct {
+	// Creates file assets using an external URL or for files that were previously uploaded using the
+	// [stagedUploadsCreate mutation](https://shopify.dev/api/admin-graphql/latest/mutations/stageduploadscreate).
+	// These files are added to the [Files page](https://shopify.com/admin/settings/files) in Shopify admin.
+	//
+	// Files are processed asynchronously. Some data is not available until processing is completed.
+	// Check [fileStatus](https://shopify.dev/api/admin-graphql/latest/interfaces/File#field-file-filestatus)
+	// to know when the files are READY or FAILED. See the [FileStatus](https://shopify.dev/api/admin-graphql/latest/enums/filestatus)
+	// for the complete set of possible fileStatus values.
+	//
+	// To get a list of all files, use the [files query](https://shopify.dev/api/admin-graphql/latest/queries/files).
+	FileCreate CreateFilesFileCreateFileCreatePayload `json:"fileCreate"`
+}
+
+// GetFileCreate returns CreateFilesResponse.FileCreate, and is useful for accessing the field via an interface.
+func (v *CreateFilesResponse) GetFileCreate() CreateFilesFileCreateFileCreatePayload {
+	return v.FileCreate
+}
+
+// CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayload includes the requested fields of the GraphQL type MetafieldDefinitionCreatePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metafieldDefinitionCreate` mutation.
+type CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayload struct {
+	// The metafield definition that was created.
+	CreatedDefinition CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadCreatedDefinitionMetafieldDefinition `json:"createdDefinition"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError `json:"userErrors"`
+}
+
+// GetCreatedDefinition returns CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayload.CreatedDefinition, and is useful for accessing the field via an interface.
+func (v *CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayload) GetCreatedDefinition() CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadCreatedDefinitionMetafieldDefinition {
+	return v.CreatedDefinition
+}
+
+// GetUserErrors returns CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayload) GetUserErrors() []CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError {
+	return v.UserErrors
+}
+
+// CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadCreatedDefinitionMetafieldDefinition includes the requested fields of the GraphQL type MetafieldDefinition.
+// The GraphQL type's documentation follows.
+//
+// Metafield definitions enable you to define additional validation constraints for metafields, and enable the
+// merchant to edit metafield values in context.
+type CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadCreatedDefinitionMetafieldDefinition struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadCreatedDefinitionMetafieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadCreatedDefinitionMetafieldDefinition) GetId() string {
+	return v.Id
+}
+
+// CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError includes the requested fields of the GraphQL type MetafieldDefinitionCreateUserError.
+// The GraphQL type's documentation follows.
+//
+// An error that occurs during the execution of `MetafieldDefinitionCreate`.
+type CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetafieldDefinitionCreateUserErrorCode `json:"code"`
+}
+
+// GetField returns CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError.Field, and is useful for accessing the field via an interface.
+func (v *CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError.Message, and is useful for accessing the field via an interface.
+func (v *CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError.Code, and is useful for accessing the field via an interface.
+func (v *CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayloadUserErrorsMetafieldDefinitionCreateUserError) GetCode() MetafieldDefinitionCreateUserErrorCode {
+	return v.Code
+}
+
+// CreateMetafieldDefinitionResponse is returned by CreateMetafieldDefinition on success.
+type CreateMetafieldDefinitionResponse struct {
+	// Creates a metafield definition. Any metafields existing under the same owner type, namespace, and key will be
+	// checked against this definition and will have their type updated accordingly. For metafields that are not
+	// valid, they will remain unchanged but any attempts to update them must align with this definition.
+	MetafieldDefinitionCreate CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayload `json:"metafieldDefinitionCreate"`
+}
+
+// GetMetafieldDefinitionCreate returns CreateMetafieldDefinitionResponse.MetafieldDefinitionCreate, and is useful for accessing the field via an interface.
+func (v *CreateMetafieldDefinitionResponse) GetMetafieldDefinitionCreate() CreateMetafieldDefinitionMetafieldDefinitionCreateMetafieldDefinitionCreatePayload {
+	return v.MetafieldDefinitionCreate
+}
+
+// CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload includes the requested fields of the GraphQL type MetaobjectDefinitionCreatePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metaobjectDefinitionCreate` mutation.
+type CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload struct {
+	// The created metaobject definition.
+	MetaobjectDefinition CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition `json:"metaobjectDefinition"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError `json:"userErrors"`
+}
+
+// GetMetaobjectDefinition returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload.MetaobjectDefinition, and is useful for accessing the field via an interface.
+func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload) GetMetaobjectDefinition() CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition {
+	return v.MetaobjectDefinition
+}
+
+// GetUserErrors returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload) GetUserErrors() []CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError {
+	return v.UserErrors
+}
+
+// CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition includes the requested fields of the GraphQL type MetaobjectDefinition.
+// The GraphQL type's documentation follows.
+//
+// Provides the definition of a generic object structure composed of metafields.
+type CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The type of the object definition. Defines the namespace of associated metafields.
+	Type string `json:"type"`
+}
+
+// GetId returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition.Id, and is useful for accessing the field via an interface.
+func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition) GetId() string {
+	return v.Id
+}
+
+// GetType returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition.Type, and is useful for accessing the field via an interface.
+func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadMetaobjectDefinition) GetType() string {
+	return v.Type
+}
+
+// CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError includes the requested fields of the GraphQL type MetaobjectUserError.
+// The GraphQL type's documentation follows.
+//
+// Defines errors encountered while managing metaobject resources.
+type CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetaobjectUserErrorCode `json:"code"`
+}
+
+// GetField returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError.Field, and is useful for accessing the field via an interface.
+func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError.Message, and is useful for accessing the field via an interface.
+func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError.Code, and is useful for accessing the field via an interface.
+func (v *CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayloadUserErrorsMetaobjectUserError) GetCode() MetaobjectUserErrorCode {
+	return v.Code
+}
+
+// CreateMetaobjectDefinitionResponse is returned by CreateMetaobjectDefinition on success.
+type CreateMetaobjectDefinitionResponse struct {
+	// Creates a new metaobject definition.
+	MetaobjectDefinitionCreate CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload `json:"metaobjectDefinitionCreate"`
+}
+
+// GetMetaobjectDefinitionCreate returns CreateMetaobjectDefinitionResponse.MetaobjectDefinitionCreate, and is useful for accessing the field via an interface.
+func (v *CreateMetaobjectDefinitionResponse) GetMetaobjectDefinitionCreate() CreateMetaobjectDefinitionMetaobjectDefinitionCreateMetaobjectDefinitionCreatePayload {
+	return v.MetaobjectDefinitionCreate
+}
+
+// CreateStagedUploadsResponse is returned by CreateStagedUploads on success.
+type CreateStagedUploadsResponse struct {
+	// Creates staged upload targets for each input. This is the first step in the upload process.
+	// The returned staged upload targets' URL and parameter fields can be used to send a request
+	// to upload the file described in the corresponding input.
+	//
+	// For more information on the upload process, refer to
+	// [Upload media to Shopify](https://shopify.dev/apps/online-store/media/products#step-1-upload-media-to-shopify).
+	StagedUploadsCreate CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayload `json:"stagedUploadsCreate"`
+}
+
+// GetStagedUploadsCreate returns CreateStagedUploadsResponse.StagedUploadsCreate, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsResponse) GetStagedUploadsCreate() CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayload {
+	return v.StagedUploadsCreate
+}
+
+// CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayload includes the requested fields of the GraphQL type StagedUploadsCreatePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `stagedUploadsCreate` mutation.
+type CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayload struct {
+	// The staged upload targets that were generated.
+	StagedTargets []CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget `json:"stagedTargets"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadUserErrorsUserError `json:"userErrors"`
+}
+
+// GetStagedTargets returns CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayload.StagedTargets, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayload) GetStagedTargets() []CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget {
+	return v.StagedTargets
+}
+
+// GetUserErrors returns CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayload) GetUserErrors() []CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadUserErrorsUserError {
+	return v.UserErrors
+}
+
+// CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget includes the requested fields of the GraphQL type StagedMediaUploadTarget.
+// The GraphQL type's documentation follows.
+//
+// Information about a staged upload target, which should be used to send a request to upload
+// the file.
+//
+// For more information on the upload process, refer to
+// [Upload media to Shopify](https://shopify.dev/apps/online-store/media/products#step-1-upload-media-to-shopify).
+type CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget struct {
+	// The URL to use when sending an request to upload the file. Should be used in conjunction with
+	// the parameters field.
+	Url string `json:"url"`
+	// The URL to be passed as `originalSource` in
+	// [CreateMediaInput](https://shopify.dev/api/admin-graphql/latest/input-objects/CreateMediaInput)
+	// and [FileCreateInput](https://shopify.dev/api/admin-graphql/2022-04/input-objects/FileCreateInput)
+	// for the [productCreateMedia](https://shopify.dev/api/admin-graphql/2022-04/mutations/productCreateMedia)
+	// and [fileCreate](https://shopify.dev/api/admin-graphql/2022-04/mutations/fileCreate)
+	// mutations.
+	ResourceUrl string `json:"resourceUrl"`
+	// Parameters needed to authenticate a request to upload the file.
+	Parameters []CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTargetParametersStagedUploadParameter `json:"parameters"`
+}
+
+// GetUrl returns CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget.Url, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget) GetUrl() string {
+	return v.Url
+}
+
+// GetResourceUrl returns CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget.ResourceUrl, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget) GetResourceUrl() string {
+	return v.ResourceUrl
+}
+
+// GetParameters returns CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget.Parameters, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTarget) GetParameters() []CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTargetParametersStagedUploadParameter {
+	return v.Parameters
+}
+
+// CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTargetParametersStagedUploadParameter includes the requested fields of the GraphQL type StagedUploadParameter.
+// The GraphQL type's documentation follows.
+//
+// The parameters required to authenticate a file upload request using a
+// [StagedMediaUploadTarget's url field](https://shopify.dev/api/admin-graphql/latest/objects/StagedMediaUploadTarget#field-stagedmediauploadtarget-url).
+//
+// For more information on the upload process, refer to
+// [Upload media to Shopify](https://shopify.dev/apps/online-store/media/products#step-1-upload-media-to-shopify).
+type CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTargetParametersStagedUploadParameter struct {
+	// The parameter's name.
+	Name string `json:"name"`
+	// The parameter's value.
+	Value string `json:"value"`
+}
+
+// GetName returns CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTargetParametersStagedUploadParameter.Name, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTargetParametersStagedUploadParameter) GetName() string {
+	return v.Name
+}
+
+// GetValue returns CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTargetParametersStagedUploadParameter.Value, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadStagedTargetsStagedMediaUploadTargetParametersStagedUploadParameter) GetValue() string {
+	return v.Value
+}
+
+// CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadUserErrorsUserError includes the requested fields of the GraphQL type UserError.
+// The GraphQL type's documentation follows.
+//
+// Represents an error in the input of a mutation.
+type CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadUserErrorsUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// GetField returns CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadUserErrorsUserError.Field, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadUserErrorsUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadUserErrorsUserError.Message, and is useful for accessing the field via an interface.
+func (v *CreateStagedUploadsStagedUploadsCreateStagedUploadsCreatePayloadUserErrorsUserError) GetMessage() string {
+	return v.Message
+}
+
+// DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayload includes the requested fields of the GraphQL type MetafieldsDeletePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metafieldsDelete` mutation.
+type DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayload struct {
+	// List of metafield identifiers that were deleted, null if the corresponding metafield isn't found.
+	DeletedMetafields []DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadDeletedMetafieldsMetafieldIdentifier `json:"deletedMetafields"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadUserErrorsUserError `json:"userErrors"`
+}
+
+// GetDeletedMetafields returns DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayload.DeletedMetafields, and is useful for accessing the field via an interface.
+func (v *DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayload) GetDeletedMetafields() []DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadDeletedMetafieldsMetafieldIdentifier {
+	return v.DeletedMetafields
+}
+
+// GetUserErrors returns DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayload) GetUserErrors() []DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadUserErrorsUserError {
+	return v.UserErrors
+}
+
+// DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadDeletedMetafieldsMetafieldIdentifier includes the requested fields of the GraphQL type MetafieldIdentifier.
+// The GraphQL type's documentation follows.
+//
+// Identifies a metafield by its owner resource, namespace, and key.
+type DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadDeletedMetafieldsMetafieldIdentifier struct {
+	// The key of the metafield.
+	Key string `json:"key"`
+}
+
+// GetKey returns DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadDeletedMetafieldsMetafieldIdentifier.Key, and is useful for accessing the field via an interface.
+func (v *DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadDeletedMetafieldsMetafieldIdentifier) GetKey() string {
+	return v.Key
+}
+
+// DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadUserErrorsUserError includes the requested fields of the GraphQL type UserError.
+// The GraphQL type's documentation follows.
+//
+// Represents an error in the input of a mutation.
+type DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadUserErrorsUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+// GetField returns DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadUserErrorsUserError.Field, and is useful for accessing the field via an interface.
+func (v *DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadUserErrorsUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadUserErrorsUserError.Message, and is useful for accessing the field via an interface.
+func (v *DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayloadUserErrorsUserError) GetMessage() string {
+	return v.Message
+}
+
+// DeleteMetafieldsResponse is returned by DeleteMetafields on success.
+type DeleteMetafieldsResponse struct {
+	// Deletes multiple metafields in bulk.
+	MetafieldsDelete DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayload `json:"metafieldsDelete"`
+}
+
+// GetMetafieldsDelete returns DeleteMetafieldsResponse.MetafieldsDelete, and is useful for accessing the field via an interface.
+func (v *DeleteMetafieldsResponse) GetMetafieldsDelete() DeleteMetafieldsMetafieldsDeleteMetafieldsDeletePayload {
+	return v.MetafieldsDelete
+}
+
+// DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayload includes the requested fields of the GraphQL type MetaobjectDeletePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metaobjectDelete` mutation.
+type DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayload struct {
+	// The ID of the deleted metaobject.
+	DeletedId string `json:"deletedId"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError `json:"userErrors"`
+}
+
+// GetDeletedId returns DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayload.DeletedId, and is useful for accessing the field via an interface.
+func (v *DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayload) GetDeletedId() string {
+	return v.DeletedId
+}
+
+// GetUserErrors returns DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayload) GetUserErrors() []DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError {
+	return v.UserErrors
+}
+
+// DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError includes the requested fields of the GraphQL type MetaobjectUserError.
+// The GraphQL type's documentation follows.
+//
+// Defines errors encountered while managing metaobject resources.
+type DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetaobjectUserErrorCode `json:"code"`
+}
+
+// GetField returns DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError.Field, and is useful for accessing the field via an interface.
+func (v *DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError.Message, and is useful for accessing the field via an interface.
+func (v *DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError.Code, and is useful for accessing the field via an interface.
+func (v *DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayloadUserErrorsMetaobjectUserError) GetCode() MetaobjectUserErrorCode {
+	return v.Code
+}
+
+// DeleteMetaobjectResponse is returned by DeleteMetaobject on success.
+type DeleteMetaobjectResponse struct {
+	// Deletes the specified metaobject and its associated metafields.
+	MetaobjectDelete DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayload `json:"metaobjectDelete"`
+}
+
+// GetMetaobjectDelete returns DeleteMetaobjectResponse.MetaobjectDelete, and is useful for accessing the field via an interface.
+func (v *DeleteMetaobjectResponse) GetMetaobjectDelete() DeleteMetaobjectMetaobjectDeleteMetaobjectDeletePayload {
+	return v.MetaobjectDelete
+}
+
+// EnableStandardMetafieldDefinitionResponse is returned by EnableStandardMetafieldDefinition on success.
+type EnableStandardMetafieldDefinitionResponse struct {
+	// Activates the specified standard metafield definition from its template.
+	//
+	// Refer to the [list of standard metafield definition templates](https://shopify.dev/apps/metafields/definitions/standard-definitions).
+	StandardMetafieldDefinitionEnable EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayload `json:"standardMetafieldDefinitionEnable"`
+}
+
+// GetStandardMetafieldDefinitionEnable returns EnableStandardMetafieldDefinitionResponse.StandardMetafieldDefinitionEnable, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetafieldDefinitionResponse) GetStandardMetafieldDefinitionEnable() EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayload {
+	return v.StandardMetafieldDefinitionEnable
+}
+
+// EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayload includes the requested fields of the GraphQL type StandardMetafieldDefinitionEnablePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `standardMetafieldDefinitionEnable` mutation.
+type EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayload struct {
+	// The metafield definition that was created.
+	CreatedDefinition EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadCreatedDefinitionMetafieldDefinition `json:"createdDefinition"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError `json:"userErrors"`
+}
+
+// GetCreatedDefinition returns EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayload.CreatedDefinition, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayload) GetCreatedDefinition() EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadCreatedDefinitionMetafieldDefinition {
+	return v.CreatedDefinition
+}
+
+// GetUserErrors returns EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayload) GetUserErrors() []EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError {
+	return v.UserErrors
+}
+
+// EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadCreatedDefinitionMetafieldDefinition includes the requested fields of the GraphQL type MetafieldDefinition.
+// The GraphQL type's documentation follows.
+//
+// Metafield definitions enable you to define additional validation constraints for metafields, and enable the
+// merchant to edit metafield values in context.
+type EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadCreatedDefinitionMetafieldDefinition struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadCreatedDefinitionMetafieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadCreatedDefinitionMetafieldDefinition) GetId() string {
+	return v.Id
+}
+
+// EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError includes the requested fields of the GraphQL type StandardMetafieldDefinitionEnableUserError.
+// The GraphQL type's documentation follows.
+//
+// An error that occurs during the execution of `StandardMetafieldDefinitionEnable`.
+type EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code StandardMetafieldDefinitionEnableUserErrorCode `json:"code"`
+}
+
+// GetField returns EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError.Field, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError.Message, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError.Code, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetafieldDefinitionStandardMetafieldDefinitionEnableStandardMetafieldDefinitionEnablePayloadUserErrorsStandardMetafieldDefinitionEnableUserError) GetCode() StandardMetafieldDefinitionEnableUserErrorCode {
+	return v.Code
+}
+
+// EnableStandardMetaobjectDefinitionResponse is returned by EnableStandardMetaobjectDefinition on success.
+type EnableStandardMetaobjectDefinitionResponse struct {
+	// Enables the specified standard metaobject definition from its template.
+	StandardMetaobjectDefinitionEnable EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayload `json:"standardMetaobjectDefinitionEnable"`
+}
+
+// GetStandardMetaobjectDefinitionEnable returns EnableStandardMetaobjectDefinitionResponse.StandardMetaobjectDefinitionEnable, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetaobjectDefinitionResponse) GetStandardMetaobjectDefinitionEnable() EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayload {
+	return v.StandardMetaobjectDefinitionEnable
+}
+
+// EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayload includes the requested fields of the GraphQL type StandardMetaobjectDefinitionEnablePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `standardMetaobjectDefinitionEnable` mutation.
+type EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayload struct {
+	// The metaobject definition that was enabled using the standard template.
+	MetaobjectDefinition EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadMetaobjectDefinition `json:"metaobjectDefinition"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError `json:"userErrors"`
+}
+
+// GetMetaobjectDefinition returns EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayload.MetaobjectDefinition, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayload) GetMetaobjectDefinition() EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadMetaobjectDefinition {
+	return v.MetaobjectDefinition
+}
+
+// GetUserErrors returns EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayload) GetUserErrors() []EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError {
+	return v.UserErrors
+}
+
+// EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadMetaobjectDefinition includes the requested fields of the GraphQL type MetaobjectDefinition.
+// The GraphQL type's documentation follows.
+//
+// Provides the definition of a generic object structure composed of metafields.
+type EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadMetaobjectDefinition struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The type of the object definition. Defines the namespace of associated metafields.
+	Type string `json:"type"`
+}
+
+// GetId returns EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadMetaobjectDefinition.Id, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadMetaobjectDefinition) GetId() string {
+	return v.Id
+}
+
+// GetType returns EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadMetaobjectDefinition.Type, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadMetaobjectDefinition) GetType() string {
+	return v.Type
+}
+
+// EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError includes the requested fields of the GraphQL type MetaobjectUserError.
+// The GraphQL type's documentation follows.
+//
+// Defines errors encountered while managing metaobject resources.
+type EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetaobjectUserErrorCode `json:"code"`
+}
+
+// GetField returns EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError.Field, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError.Message, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError.Code, and is useful for accessing the field via an interface.
+func (v *EnableStandardMetaobjectDefinitionStandardMetaobjectDefinitionEnableStandardMetaobjectDefinitionEnablePayloadUserErrorsMetaobjectUserError) GetCode() MetaobjectUserErrorCode {
+	return v.Code
+}
+
+// The possible content types for a file object.
+type FileContentType string
+
+const (
+	// A Shopify-hosted image.
+	FileContentTypeImage FileContentType = "IMAGE"
+	// A Shopify-hosted generic file.
+	FileContentTypeFile FileContentType = "FILE"
+	// A Shopify-hosted video file. It's recommended to use this type for all video files.
+	FileContentTypeVideo FileContentType = "VIDEO"
+	// An externally hosted video.
+	FileContentTypeExternalVideo FileContentType = "EXTERNAL_VIDEO"
+	// A Shopify-hosted 3D model.
+	FileContentTypeModel3d FileContentType = "MODEL_3D"
+)
+
+var AllFileContentType = []FileContentType{
+	FileContentTypeImage,
+	FileContentTypeFile,
+	FileContentTypeVideo,
+	FileContentTypeExternalVideo,
+	FileContentTypeModel3d,
+}
+
+// The input fields that are required to create a file object.
+type FileCreateInput struct {
+	// When provided, the file will be created with the given filename,
+	// otherwise the filename in the originalSource will be used.
+	Filename string `json:"filename,omitempty"`
+	// The file content type. If omitted, then Shopify will attempt to determine the content type during file processing.
+	ContentType FileContentType `json:"contentType,omitempty"`
+	// The alternative text description of the file.
+	Alt string `json:"alt,omitempty"`
+	// How to handle if filename is already in use.
+	DuplicateResolutionMode FileCreateInputDuplicateResolutionMode `json:"duplicateResolutionMode,omitempty"`
+	// An external URL (for images only) or a
+	// [staged upload URL](https://shopify.dev/api/admin-graphql/latest/mutations/stageduploadscreate).
+	OriginalSource string `json:"originalSource"`
+}
+
+// GetFilename returns FileCreateInput.Filename, and is useful for accessing the field via an interface.
+func (v *FileCreateInput) GetFilename() string { return v.Filename }
+
+// GetContentType returns FileCreateInput.ContentType, and is useful for accessing the field via an interface.
+func (v *FileCreateInput) GetContentType() FileContentType { return v.ContentType }
+
+// GetAlt returns FileCreateInput.Alt, and is useful for accessing the field via an interface.
+func (v *FileCreateInput) GetAlt() string { return v.Alt }
+
+// GetDuplicateResolutionMode returns FileCreateInput.DuplicateResolutionMode, and is useful for accessing the field via an interface.
+func (v *FileCreateInput) GetDuplicateResolutionMode() FileCreateInputDuplicateResolutionMode {
+	return v.DuplicateResolutionMode
+}
+
+// GetOriginalSource returns FileCreateInput.OriginalSource, and is useful for accessing the field via an interface.
+func (v *FileCreateInput) GetOriginalSource() string { return v.OriginalSource }
+
+// The input fields for handling if filename is already in use.
+type FileCreateInputDuplicateResolutionMode string
+
+const (
+	// Append a UUID if filename is already in use.
+	FileCreateInputDuplicateResolutionModeAppendUuid FileCreateInputDuplicateResolutionMode = "APPEND_UUID"
+	// Raise an error if filename is already in use.
+	FileCreateInputDuplicateResolutionModeRaiseError FileCreateInputDuplicateResolutionMode = "RAISE_ERROR"
+	// Replace the existing file if filename is already in use.
+	FileCreateInputDuplicateResolutionModeReplace FileCreateInputDuplicateResolutionMode = "REPLACE"
+)
+
+var AllFileCreateInputDuplicateResolutionMode = []FileCreateInputDuplicateResolutionMode{
+	FileCreateInputDuplicateResolutionModeAppendUuid,
+	FileCreateInputDuplicateResolutionModeRaiseError,
+	FileCreateInputDuplicateResolutionModeReplace,
+}
+
+// The possible statuses for a file object.
+type FileStatus string
+
+const (
+	// File has been uploaded but hasn't been processed.
+	FileStatusUploaded FileStatus = "UPLOADED"
+	// File is being processed.
+	FileStatusProcessing FileStatus = "PROCESSING"
+	// File is ready to be displayed.
+	FileStatusReady FileStatus = "READY"
+	// File processing has failed.
+	FileStatusFailed FileStatus = "FAILED"
+)
+
+var AllFileStatus = []FileStatus{
+	FileStatusUploaded,
+	FileStatusProcessing,
+	FileStatusReady,
+	FileStatusFailed,
+}
+
+// Possible error codes that can be returned by `FilesUserError`.
+type FilesErrorCode string
+
+const (
+	// The input value is invalid.
+	FilesErrorCodeInvalid FilesErrorCode = "INVALID"
+	// File does not exist.
+	FilesErrorCodeFileDoesNotExist FilesErrorCode = "FILE_DOES_NOT_EXIST"
+	// File has a pending operation.
+	FilesErrorCodeFileLocked FilesErrorCode = "FILE_LOCKED"
+	// Filename update is only supported on Image and GenericFile.
+	FilesErrorCodeUnsupportedMediaTypeForFilenameUpdate FilesErrorCode = "UNSUPPORTED_MEDIA_TYPE_FOR_FILENAME_UPDATE"
+	// Specify one argument: search, IDs, or deleteAll.
+	FilesErrorCodeTooManyArguments FilesErrorCode = "TOO_MANY_ARGUMENTS"
+	// The search term must not be blank.
+	FilesErrorCodeBlankSearch FilesErrorCode = "BLANK_SEARCH"
+	// At least one argument is required.
+	FilesErrorCodeMissingArguments FilesErrorCode = "MISSING_ARGUMENTS"
+	// Search query isn't supported.
+	FilesErrorCodeInvalidQuery FilesErrorCode = "INVALID_QUERY"
+	// One or more associated products are suspended.
+	FilesErrorCodeProductSuspended FilesErrorCode = "PRODUCT_SUSPENDED"
+	// Invalid filename extension.
+	FilesErrorCodeInvalidFilenameExtension FilesErrorCode = "INVALID_FILENAME_EXTENSION"
+	// The provided filename is invalid.
+	FilesErrorCodeInvalidFilename FilesErrorCode = "INVALID_FILENAME"
+	// The provided filename already exists.
+	FilesErrorCodeFilenameAlreadyExists FilesErrorCode = "FILENAME_ALREADY_EXISTS"
+	// The file is not supported on trial accounts that have not validated their email. Either select a plan or verify the shop owner email to upload this file.
+	FilesErrorCodeUnacceptableUnverifiedTrialAsset FilesErrorCode = "UNACCEPTABLE_UNVERIFIED_TRIAL_ASSET"
+	// The file type is not supported.
+	FilesErrorCodeUnacceptableAsset FilesErrorCode = "UNACCEPTABLE_ASSET"
+	// The file is not supported on trial accounts. Select a plan to upload this file.
+	FilesErrorCodeUnacceptableTrialAsset FilesErrorCode = "UNACCEPTABLE_TRIAL_ASSET"
+	// The alt value exceeds the maximum limit of 512 characters.
+	FilesErrorCodeAltValueLimitExceeded FilesErrorCode = "ALT_VALUE_LIMIT_EXCEEDED"
+	// The file is not in the READY state.
+	FilesErrorCodeNonReadyState FilesErrorCode = "NON_READY_STATE"
+	// Exceeded the limit of non-image media per shop.
+	FilesErrorCodeNonImageMediaPerShopLimitExceeded FilesErrorCode = "NON_IMAGE_MEDIA_PER_SHOP_LIMIT_EXCEEDED"
+	// Cannot create file with custom filename which does not match original source extension.
+	FilesErrorCodeMismatchedFilenameAndOriginalSource FilesErrorCode = "MISMATCHED_FILENAME_AND_ORIGINAL_SOURCE"
+	// Duplicate resolution mode is not supported for this file type.
+	FilesErrorCodeInvalidDuplicateModeForType FilesErrorCode = "INVALID_DUPLICATE_MODE_FOR_TYPE"
+	// Invalid image source url value provided.
+	FilesErrorCodeInvalidImageSourceUrl FilesErrorCode = "INVALID_IMAGE_SOURCE_URL"
+	// Duplicate resolution mode REPLACE cannot be used without specifying filename.
+	FilesErrorCodeMissingFilenameForDuplicateModeReplace FilesErrorCode = "MISSING_FILENAME_FOR_DUPLICATE_MODE_REPLACE"
+	// Exceeded the limit of media per product.
+	FilesErrorCodeProductMediaLimitExceeded FilesErrorCode = "PRODUCT_MEDIA_LIMIT_EXCEEDED"
+	// The file type is not supported for referencing.
+	FilesErrorCodeUnsupportedFileReference FilesErrorCode = "UNSUPPORTED_FILE_REFERENCE"
+	// The target resource does not exist.
+	FilesErrorCodeReferenceTargetDoesNotExist FilesErrorCode = "REFERENCE_TARGET_DOES_NOT_EXIST"
+	// Cannot add more than 10000 references to a file.
+	FilesErrorCodeTooManyFileReference FilesErrorCode = "TOO_MANY_FILE_REFERENCE"
+)
+
+var AllFilesErrorCode = []FilesErrorCode{
+	FilesErrorCodeInvalid,
+	FilesErrorCodeFileDoesNotExist,
+	FilesErrorCodeFileLocked,
+	FilesErrorCodeUnsupportedMediaTypeForFilenameUpdate,
+	FilesErrorCodeTooManyArguments,
+	FilesErrorCodeBlankSearch,
+	FilesErrorCodeMissingArguments,
+	FilesErrorCodeInvalidQuery,
+	FilesErrorCodeProductSuspended,
+	FilesErrorCodeInvalidFilenameExtension,
+	FilesErrorCodeInvalidFilename,
+	FilesErrorCodeFilenameAlreadyExists,
+	FilesErrorCodeUnacceptableUnverifiedTrialAsset,
+	FilesErrorCodeUnacceptableAsset,
+	FilesErrorCodeUnacceptableTrialAsset,
+	FilesErrorCodeAltValueLimitExceeded,
+	FilesErrorCodeNonReadyState,
+	FilesErrorCodeNonImageMediaPerShopLimitExceeded,
+	FilesErrorCodeMismatchedFilenameAndOriginalSource,
+	FilesErrorCodeInvalidDuplicateModeForType,
+	FilesErrorCodeInvalidImageSourceUrl,
+	FilesErrorCodeMissingFilenameForDuplicateModeReplace,
+	FilesErrorCodeProductMediaLimitExceeded,
+	FilesErrorCodeUnsupportedFileReference,
+	FilesErrorCodeReferenceTargetDoesNotExist,
+	FilesErrorCodeTooManyFileReference,
+}
+
+// FindFilesFilesFileConnection includes the requested fields of the GraphQL type FileConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple Files.
+type FindFilesFilesFileConnection struct {
+	// A list of nodes that are contained in FileEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []Cli_File `json:"-"`
+}
+
+// GetNodes returns FindFilesFilesFileConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *FindFilesFilesFileConnection) GetNodes() []Cli_File { return v.Nodes }
+
+func (v *FindFilesFilesFileConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*FindFilesFilesFileConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.FindFilesFilesFileConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]Cli_File,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalCli_File(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal FindFilesFilesFileConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalFindFilesFilesFileConnection struct {
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *FindFilesFilesFileConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *FindFilesFilesFileConnection) __premarshalJSON() (*__premarshalFindFilesFilesFileConnection, error) {
+	var retval __premarshalFindFilesFilesFileConnection
+
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalCli_File(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal FindFilesFilesFileConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// FindFilesResponse is returned by FindFiles on success.
+type FindFilesResponse struct {
+	// Returns a paginated list of files that have been uploaded to Shopify.
+	Files FindFilesFilesFileConnection `json:"files"`
+}
+
+// GetFiles returns FindFilesResponse.Files, and is useful for accessing the field via an interface.
+func (v *FindFilesResponse) GetFiles() FindFilesFilesFileConnection { return v.Files }
+
+// FindProductVariantsProductVariantsProductVariantConnection includes the requested fields of the GraphQL type ProductVariantConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple ProductVariants.
+type FindProductVariantsProductVariantsProductVariantConnection struct {
+	// A list of nodes that are contained in ProductVariantEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []FindProductVariantsProductVariantsProductVariantConnectionNodesProductVariant `json:"nodes"`
+}
+
+// GetNodes returns FindProductVariantsProductVariantsProductVariantConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *FindProductVariantsProductVariantsProductVariantConnection) GetNodes() []FindProductVariantsProductVariantsProductVariantConnectionNodesProductVariant {
+	return v.Nodes
+}
+
+// FindProductVariantsProductVariantsProductVariantConnectionNodesProductVariant includes the requested fields of the GraphQL type ProductVariant.
+// The GraphQL type's documentation follows.
+//
+// Represents a product variant.
+type FindProductVariantsProductVariantsProductVariantConnectionNodesProductVariant struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// A case-sensitive identifier for the product variant in the shop.
+	// Required in order to connect to a fulfillment service.
+	Sku string `json:"sku"`
+}
+
+// GetId returns FindProductVariantsProductVariantsProductVariantConnectionNodesProductVariant.Id, and is useful for accessing the field via an interface.
+func (v *FindProductVariantsProductVariantsProductVariantConnectionNodesProductVariant) GetId() string {
+	return v.Id
+}
+
+// GetSku returns FindProductVariantsProductVariantsProductVariantConnectionNodesProductVariant.Sku, and is useful for accessing the field via an interface.
+func (v *FindProductVariantsProductVariantsProductVariantConnectionNodesProductVariant) GetSku() string {
+	return v.Sku
+}
+
+// FindProductVariantsResponse is returned by FindProductVariants on success.
+type FindProductVariantsResponse struct {
+	// Returns a list of product variants.
+	ProductVariants FindProductVariantsProductVariantsProductVariantConnection `json:"productVariants"`
+}
+
+// GetProductVariants returns FindProductVariantsResponse.ProductVariants, and is useful for accessing the field via an interface.
+func (v *FindProductVariantsResponse) GetProductVariants() FindProductVariantsProductVariantsProductVariantConnection {
+	return v.ProductVariants
+}
+
+// GetCollectionByHandleCollectionByIdentifierCollection includes the requested fields of the GraphQL type Collection.
+// The GraphQL type's documentation follows.
+//
+// Represents a group of products that can be displayed in online stores and other sales channels in categories, which makes it easy for customers to find them. For example, an athletics store might create different collections for running attire, shoes, and accessories.
+//
+// Collections can be defined by conditions, such as whether they match certain product tags. These are called smart or automated collections.
+//
+// Collections can also be created for a custom group of products. These are called custom or manual collections.
+type GetCollectionByHandleCollectionByIdentifierCollection struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns GetCollectionByHandleCollectionByIdentifierCollection.Id, and is useful for accessing the field via an interface.
+func (v *GetCollectionByHandleCollectionByIdentifierCollection) GetId() string { return v.Id }
+
+// GetCollectionByHandleResponse is returned by GetCollectionByHandle on success.
+type GetCollectionByHandleResponse struct {
+	// Return a collection by an identifier.
+	CollectionByIdentifier GetCollectionByHandleCollectionByIdentifierCollection `json:"collectionByIdentifier"`
+}
+
+// GetCollectionByIdentifier returns GetCollectionByHandleResponse.CollectionByIdentifier, and is useful for accessing the field via an interface.
+func (v *GetCollectionByHandleResponse) GetCollectionByIdentifier() GetCollectionByHandleCollectionByIdentifierCollection {
+	return v.CollectionByIdentifier
+}
+
+// GetCollectionMetafieldsCollection includes the requested fields of the GraphQL type Collection.
+// The GraphQL type's documentation follows.
+//
+// Represents a group of products that can be displayed in online stores and other sales channels in categories, which makes it easy for customers to find them. For example, an athletics store might create different collections for running attire, shoes, and accessories.
+//
+// Collections can be defined by conditions, such as whether they match certain product tags. These are called smart or automated collections.
+//
+// Collections can also be created for a custom group of products. These are called custom or manual collections.
+type GetCollectionMetafieldsCollection struct {
+	// A list of [custom fields](https://shopify.dev/docs/apps/build/custom-data)
+	// that a merchant associates with a Shopify resource.
+	Metafields Cli_MetafieldPage `json:"metafields"`
+}
+
+// GetMetafields returns GetCollectionMetafieldsCollection.Metafields, and is useful for accessing the field via an interface.
+func (v *GetCollectionMetafieldsCollection) GetMetafields() Cli_MetafieldPage { return v.Metafields }
+
+// GetCollectionMetafieldsResponse is returned by GetCollectionMetafields on success.
+type GetCollectionMetafieldsResponse struct {
+	// Returns a Collection resource by ID.
+	Collection GetCollectionMetafieldsCollection `json:"collection"`
+}
+
+// GetCollection returns GetCollectionMetafieldsResponse.Collection, and is useful for accessing the field via an interface.
+func (v *GetCollectionMetafieldsResponse) GetCollection() GetCollectionMetafieldsCollection {
+	return v.Collection
+}
+
+// GetCurrentBulkMutationResponse is returned by GetCurrentBulkMutation on success.
+type GetCurrentBulkMutationResponse struct {
+	// Returns the current app's most recent BulkOperation. Apps can run one bulk query and one bulk mutation operation at a time, by shop.
+	CurrentBulkOperation Cli_BulkOperation `json:"currentBulkOperation"`
+}
+
+// GetCurrentBulkOperation returns GetCurrentBulkMutationResponse.CurrentBulkOperation, and is useful for accessing the field via an interface.
+func (v *GetCurrentBulkMutationResponse) GetCurrentBulkOperation() Cli_BulkOperation {
+	return v.CurrentBulkOperation
+}
+
+// GetJobJob includes the requested fields of the GraphQL type Job.
+// The GraphQL type's documentation follows.
+//
+// A job corresponds to some long running task that the client should poll for status.
+type GetJobJob struct {
+	// A globally-unique ID that's returned when running an asynchronous mutation.
+	Id string `json:"id"`
+	// This indicates if the job is still queued or has been run.
+	Done bool `json:"done"`
+}
+
+// GetId returns GetJobJob.Id, and is useful for accessing the field via an interface.
+func (v *GetJobJob) GetId() string { return v.Id }
+
+// GetDone returns GetJobJob.Done, and is useful for accessing the field via an interface.
+func (v *GetJobJob) GetDone() bool { return v.Done }
+
+// GetJobResponse is returned by GetJob on success.
+type GetJobResponse struct {
+	// Returns a Job resource by ID. Used to check the status of internal jobs and any applicable changes.
+	Job GetJobJob `json:"job"`
+}
+
+// GetJob returns GetJobResponse.Job, and is useful for accessing the field via an interface.
+func (v *GetJobResponse) GetJob() GetJobJob { return v.Job }
+
+// GetMetaobjectByHandleMetaobjectByHandleMetaobject includes the requested fields of the GraphQL type Metaobject.
+// The GraphQL type's documentation follows.
+//
+// Provides an object instance represented by a MetaobjectDefinition.
+type GetMetaobjectByHandleMetaobjectByHandleMetaobject struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns GetMetaobjectByHandleMetaobjectByHandleMetaobject.Id, and is useful for accessing the field via an interface.
+func (v *GetMetaobjectByHandleMetaobjectByHandleMetaobject) GetId() string { return v.Id }
+
+// GetMetaobjectByHandleResponse is returned by GetMetaobjectByHandle on success.
+type GetMetaobjectByHandleResponse struct {
+	// Retrieves a metaobject by handle.
+	MetaobjectByHandle GetMetaobjectByHandleMetaobjectByHandleMetaobject `json:"metaobjectByHandle"`
+}
+
+// GetMetaobjectByHandle returns GetMetaobjectByHandleResponse.MetaobjectByHandle, and is useful for accessing the field via an interface.
+func (v *GetMetaobjectByHandleResponse) GetMetaobjectByHandle() GetMetaobjectByHandleMetaobjectByHandleMetaobject {
+	return v.MetaobjectByHandle
+}
+
+// GetMetaobjectDefinitionByTypeResponse is returned by GetMetaobjectDefinitionByType on success.
+type GetMetaobjectDefinitionByTypeResponse struct {
+	// Finds a metaobject definition by type.
+	MetaobjectDefinitionByType Cli_MetaobjectDefinition `json:"metaobjectDefinitionByType"`
+}
+
+// GetMetaobjectDefinitionByType returns GetMetaobjectDefinitionByTypeResponse.MetaobjectDefinitionByType, and is useful for accessing the field via an interface.
+func (v *GetMetaobjectDefinitionByTypeResponse) GetMetaobjectDefinitionByType() Cli_MetaobjectDefinition {
+	return v.MetaobjectDefinitionByType
+}
+
+// GetMetaobjectReferencedByMetaobject includes the requested fields of the GraphQL type Metaobject.
+// The GraphQL type's documentation follows.
+//
+// Provides an object instance represented by a MetaobjectDefinition.
+type GetMetaobjectReferencedByMetaobject struct {
+	// List of back references metafields that belong to the resource.
+	ReferencedBy GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnection `json:"referencedBy"`
+}
+
+// GetReferencedBy returns GetMetaobjectReferencedByMetaobject.ReferencedBy, and is useful for accessing the field via an interface.
+func (v *GetMetaobjectReferencedByMetaobject) GetReferencedBy() GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnection {
+	return v.ReferencedBy
+}
+
+// GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnection includes the requested fields of the GraphQL type MetafieldRelationConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple MetafieldRelations.
+type GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnection struct {
+	// A list of nodes that are contained in MetafieldRelationEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation `json:"nodes"`
+}
+
+// GetNodes returns GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnection) GetNodes() []GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation {
+	return v.Nodes
+}
+
+// GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation includes the requested fields of the GraphQL type MetafieldRelation.
+// The GraphQL type's documentation follows.
+//
+// Defines a relation between two resources via a reference metafield.
+// The referencer owns the joining field with a given namespace and key,
+// while the target is referenced by the field.
+type GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation struct {
+	// The namespace of the metafield making the reference, or type of the metaobject.
+	Namespace string `json:"namespace"`
+	// The key of the field making the reference.
+	Key string `json:"key"`
+	// The resource making the reference.
+	Referencer Cli_Referencer `json:"-"`
+}
+
+// GetNamespace returns GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation.Namespace, and is useful for accessing the field via an interface.
+func (v *GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation) GetNamespace() string {
+	return v.Namespace
+}
+
+// GetKey returns GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation.Key, and is useful for accessing the field via an interface.
+func (v *GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation) GetKey() string {
+	return v.Key
+}
+
+// GetReferencer returns GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation.Referencer, and is useful for accessing the field via an interface.
+func (v *GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation) GetReferencer() Cli_Referencer {
+	return v.Referencer
+}
+
+func (v *GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation
+		Referencer json.RawMessage `json:"referencer"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Referencer
+		src := firstPass.Referencer
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalCli_Referencer(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation.Referencer: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation struct {
+	Namespace string `json:"namespace"`
+
+	Key string `json:"key"`
+
+	Referencer json.RawMessage `json:"referencer"`
+}
+
+func (v *GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation) __premarshalJSON() (*__premarshalGetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation, error) {
+	var retval __premarshalGetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation
+
+	retval.Namespace = v.Namespace
+	retval.Key = v.Key
+	{
+
+		dst := &retval.Referencer
+		src := v.Referencer
+		var err error
+		*dst, err = __marshalCli_Referencer(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetMetaobjectReferencedByMetaobjectReferencedByMetafieldRelationConnectionNodesMetafieldRelation.Referencer: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetMetaobjectReferencedByResponse is returned by GetMetaobjectReferencedBy on success.
+type GetMetaobjectReferencedByResponse struct {
+	// Retrieves a metaobject by ID.
+	Metaobject GetMetaobjectReferencedByMetaobject `json:"metaobject"`
+}
+
+// GetMetaobject returns GetMetaobjectReferencedByResponse.Metaobject, and is useful for accessing the field via an interface.
+func (v *GetMetaobjectReferencedByResponse) GetMetaobject() GetMetaobjectReferencedByMetaobject {
+	return v.Metaobject
+}
+
+// GetProductByHandleProductByIdentifierProduct includes the requested fields of the GraphQL type Product.
+// The GraphQL type's documentation follows.
+//
+// The `Product` object lets you manage products in a merchant’s store.
+//
+// Products are the goods and services that merchants offer to customers. They can include various details such as title, description, price, images, and options such as size or color.
+// You can use [product variants](https://shopify.dev/docs/api/admin-graphql/latest/objects/productvariant) to create or update different versions of the same product.
+// You can also add or update product [media](https://shopify.dev/docs/api/admin-graphql/latest/interfaces/media).
+// Products can be organized by grouping them into a [collection](https://shopify.dev/docs/api/admin-graphql/latest/objects/collection).
+//
+// Learn more about working with [Shopify's product model](https://shopify.dev/docs/apps/build/graphql/migrate/new-product-model/product-model-components),
+// including limitations and considerations.
+type GetProductByHandleProductByIdentifierProduct struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns GetProductByHandleProductByIdentifierProduct.Id, and is useful for accessing the field via an interface.
+func (v *GetProductByHandleProductByIdentifierProduct) GetId() string { return v.Id }
+
+// GetProductByHandleResponse is returned by GetProductByHandle on success.
+type GetProductByHandleResponse struct {
+	// Return a product by an identifier.
+	ProductByIdentifier GetProductByHandleProductByIdentifierProduct `json:"productByIdentifier"`
+}
+
+// GetProductByIdentifier returns GetProductByHandleResponse.ProductByIdentifier, and is useful for accessing the field via an interface.
+func (v *GetProductByHandleResponse) GetProductByIdentifier() GetProductByHandleProductByIdentifierProduct {
+	return v.ProductByIdentifier
+}
+
+// GetProductMetafieldsProduct includes the requested fields of the GraphQL type Product.
+// The GraphQL type's documentation follows.
+//
+// The `Product` object lets you manage products in a merchant’s store.
+//
+// Products are the goods and services that merchants offer to customers. They can include various details such as title, description, price, images, and options such as size or color.
+// You can use [product variants](https://shopify.dev/docs/api/admin-graphql/latest/objects/productvariant) to create or update different versions of the same product.
+// You can also add or update product [media](https://shopify.dev/docs/api/admin-graphql/latest/interfaces/media).
+// Products can be organized by grouping them into a [collection](https://shopify.dev/docs/api/admin-graphql/latest/objects/collection).
+//
+// Learn more about working with [Shopify's product model](https://shopify.dev/docs/apps/build/graphql/migrate/new-product-model/product-model-components),
+// including limitations and considerations.
+type GetProductMetafieldsProduct struct {
+	// A list of [custom fields](https://shopify.dev/docs/apps/build/custom-data)
+	// that a merchant associates with a Shopify resource.
+	Metafields Cli_MetafieldPage `json:"metafields"`
+}
+
+// GetMetafields returns GetProductMetafieldsProduct.Metafields, and is useful for accessing the field via an interface.
+func (v *GetProductMetafieldsProduct) GetMetafields() Cli_MetafieldPage { return v.Metafields }
+
+// GetProductMetafieldsResponse is returned by GetProductMetafields on success.
+type GetProductMetafieldsResponse struct {
+	// Returns a Product resource by ID.
+	Product GetProductMetafieldsProduct `json:"product"`
+}
+
+// GetProduct returns GetProductMetafieldsResponse.Product, and is useful for accessing the field via an interface.
+func (v *GetProductMetafieldsResponse) GetProduct() GetProductMetafieldsProduct { return v.Product }
+
+// GetReferencesResponse is returned by GetReferences on success.
+type GetReferencesResponse struct {
+	// Returns the list of nodes (any objects that implement the
+	// [Node](https://shopify.dev/api/admin-graphql/latest/interfaces/Node)
+	// interface) with the given IDs, in accordance with the
+	// [Relay specification](https://relay.dev/docs/guides/graphql-server-specification/#object-identification).
+	Nodes []*ReferenceNode `json:"nodes"`
+}
+
+// GetNodes returns GetReferencesResponse.Nodes, and is useful for accessing the field via an interface.
+func (v *GetReferencesResponse) GetNodes() []*ReferenceNode { return v.Nodes }
+
+// ListCollectionMetafieldsCollectionsCollectionConnection includes the requested fields of the GraphQL type CollectionConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple Collections.
+type ListCollectionMetafieldsCollectionsCollectionConnection struct {
+	// A list of nodes that are contained in CollectionEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection `json:"nodes"`
+	// An object that’s used to retrieve [cursor information](https://shopify.dev/api/usage/pagination-graphql) about the current page.
+	PageInfo ListCollectionMetafieldsCollectionsCollectionConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns ListCollectionMetafieldsCollectionsCollectionConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListCollectionMetafieldsCollectionsCollectionConnection) GetNodes() []ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListCollectionMetafieldsCollectionsCollectionConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListCollectionMetafieldsCollectionsCollectionConnection) GetPageInfo() ListCollectionMetafieldsCollectionsCollectionConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection includes the requested fields of the GraphQL type Collection.
+// The GraphQL type's documentation follows.
+//
+// Represents a group of products that can be displayed in online stores and other sales channels in categories, which makes it easy for customers to find them. For example, an athletics store might create different collections for running attire, shoes, and accessories.
+//
+// Collections can be defined by conditions, such as whether they match certain product tags. These are called smart or automated collections.
+//
+// Collections can also be created for a custom group of products. These are called custom or manual collections.
+type ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// A unique string that identifies the collection. If a handle isn't specified when a collection is created, it's automatically generated from the collection's original title, and typically includes words from the title separated by hyphens. For example, a collection that was created with the title `Summer Catalog 2022` might have the handle `summer-catalog-2022`.
+	//
+	// If the title is changed, the handle doesn't automatically change.
+	//
+	// The handle can be used in themes by the Liquid templating language to refer to the collection, but using the ID is preferred because it never changes.
+	Handle string `json:"handle"`
+	// A list of [custom fields](https://shopify.dev/docs/apps/build/custom-data)
+	// that a merchant associates with a Shopify resource.
+	Metafields Cli_MetafieldPage `json:"metafields"`
+}
+
+// GetId returns ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection.Id, and is useful for accessing the field via an interface.
+func (v *ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection) GetId() string {
+	return v.Id
+}
+
+// GetHandle returns ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection.Handle, and is useful for accessing the field via an interface.
+func (v *ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection) GetHandle() string {
+	return v.Handle
+}
+
+// GetMetafields returns ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection.Metafields, and is useful for accessing the field via an interface.
+func (v *ListCollectionMetafieldsCollectionsCollectionConnectionNodesCollection) GetMetafields() Cli_MetafieldPage {
+	return v.Metafields
+}
+
+// ListCollectionMetafieldsCollectionsCollectionConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Returns information about pagination in a connection, in accordance with the
+// [Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
+// For more information, please read our [GraphQL Pagination Usage Guide](https://shopify.dev/api/usage/pagination-graphql).
+type ListCollectionMetafieldsCollectionsCollectionConnectionPageInfo struct {
+	// Whether there are more pages to fetch following the current page.
+	HasNextPage bool `json:"hasNextPage"`
+	// The cursor corresponding to the last node in edges.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListCollectionMetafieldsCollectionsCollectionConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListCollectionMetafieldsCollectionsCollectionConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListCollectionMetafieldsCollectionsCollectionConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListCollectionMetafieldsCollectionsCollectionConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// ListCollectionMetafieldsResponse is returned by ListCollectionMetafields on success.
+type ListCollectionMetafieldsResponse struct {
+	// Returns a list of collections.
+	Collections ListCollectionMetafieldsCollectionsCollectionConnection `json:"collections"`
+}
+
+// GetCollections returns ListCollectionMetafieldsResponse.Collections, and is useful for accessing the field via an interface.
+func (v *ListCollectionMetafieldsResponse) GetCollections() ListCollectionMetafieldsCollectionsCollectionConnection {
+	return v.Collections
+}
+
+// ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnection includes the requested fields of the GraphQL type MetafieldDefinitionConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple MetafieldDefinitions.
+type ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnection struct {
+	// A list of nodes that are contained in MetafieldDefinitionEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []Cli_MetafieldDefinition `json:"nodes"`
+	// An object that’s used to retrieve [cursor information](https://shopify.dev/api/usage/pagination-graphql) about the current page.
+	PageInfo ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnection) GetNodes() []Cli_MetafieldDefinition {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnection) GetPageInfo() ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Returns information about pagination in a connection, in accordance with the
+// [Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
+// For more information, please read our [GraphQL Pagination Usage Guide](https://shopify.dev/api/usage/pagination-graphql).
+type ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnectionPageInfo struct {
+	// Whether there are more pages to fetch following the current page.
+	HasNextPage bool `json:"hasNextPage"`
+	// The cursor corresponding to the last node in edges.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// ListMetafieldDefinitionsResponse is returned by ListMetafieldDefinitions on success.
+type ListMetafieldDefinitionsResponse struct {
+	// Returns a list of metafield definitions.
+	MetafieldDefinitions ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnection `json:"metafieldDefinitions"`
+}
+
+// GetMetafieldDefinitions returns ListMetafieldDefinitionsResponse.MetafieldDefinitions, and is useful for accessing the field via an interface.
+func (v *ListMetafieldDefinitionsResponse) GetMetafieldDefinitions() ListMetafieldDefinitionsMetafieldDefinitionsMetafieldDefinitionConnection {
+	return v.MetafieldDefinitions
+}
+
+// ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection includes the requested fields of the GraphQL type MetaobjectDefinitionConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple MetaobjectDefinitions.
+type ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection struct {
+	// A list of nodes that are contained in MetaobjectDefinitionEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []Cli_MetaobjectDefinition `json:"nodes"`
+	// An object that’s used to retrieve [cursor information](https://shopify.dev/api/usage/pagination-graphql) about the current page.
+	PageInfo ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection) GetNodes() []Cli_MetaobjectDefinition {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection) GetPageInfo() ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Returns information about pagination in a connection, in accordance with the
+// [Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
+// For more information, please read our [GraphQL Pagination Usage Guide](https://shopify.dev/api/usage/pagination-graphql).
+type ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo struct {
+	// Whether there are more pages to fetch following the current page.
+	HasNextPage bool `json:"hasNextPage"`
+	// The cursor corresponding to the last node in edges.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// ListMetaobjectDefinitionsResponse is returned by ListMetaobjectDefinitions on success.
+type ListMetaobjectDefinitionsResponse struct {
+	// All metaobject definitions.
+	MetaobjectDefinitions ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection `json:"metaobjectDefinitions"`
+}
+
+// GetMetaobjectDefinitions returns ListMetaobjectDefinitionsResponse.MetaobjectDefinitions, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectDefinitionsResponse) GetMetaobjectDefinitions() ListMetaobjectDefinitionsMetaobjectDefinitionsMetaobjectDefinitionConnection {
+	return v.MetaobjectDefinitions
+}
+
+// ListMetaobjectFieldValuesMetaobjectsMetaobjectConnection includes the requested fields of the GraphQL type MetaobjectConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple Metaobjects.
+type ListMetaobjectFieldValuesMetaobjectsMetaobjectConnection struct {
+	// A list of nodes that are contained in MetaobjectEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []Cli_MetaobjectFieldValues `json:"nodes"`
+	// An object that’s used to retrieve [cursor information](https://shopify.dev/api/usage/pagination-graphql) about the current page.
+	PageInfo ListMetaobjectFieldValuesMetaobjectsMetaobjectConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns ListMetaobjectFieldValuesMetaobjectsMetaobjectConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectFieldValuesMetaobjectsMetaobjectConnection) GetNodes() []Cli_MetaobjectFieldValues {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListMetaobjectFieldValuesMetaobjectsMetaobjectConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectFieldValuesMetaobjectsMetaobjectConnection) GetPageInfo() ListMetaobjectFieldValuesMetaobjectsMetaobjectConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListMetaobjectFieldValuesMetaobjectsMetaobjectConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Returns information about pagination in a connection, in accordance with the
+// [Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
+// For more information, please read our [GraphQL Pagination Usage Guide](https://shopify.dev/api/usage/pagination-graphql).
+type ListMetaobjectFieldValuesMetaobjectsMetaobjectConnectionPageInfo struct {
+	// Whether there are more pages to fetch following the current page.
+	HasNextPage bool `json:"hasNextPage"`
+	// The cursor corresponding to the last node in edges.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListMetaobjectFieldValuesMetaobjectsMetaobjectConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectFieldValuesMetaobjectsMetaobjectConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListMetaobjectFieldValuesMetaobjectsMetaobjectConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectFieldValuesMetaobjectsMetaobjectConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// ListMetaobjectFieldValuesResponse is returned by ListMetaobjectFieldValues on success.
+type ListMetaobjectFieldValuesResponse struct {
+	// All metaobjects for the shop.
+	Metaobjects ListMetaobjectFieldValuesMetaobjectsMetaobjectConnection `json:"metaobjects"`
+}
+
+// GetMetaobjects returns ListMetaobjectFieldValuesResponse.Metaobjects, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectFieldValuesResponse) GetMetaobjects() ListMetaobjectFieldValuesMetaobjectsMetaobjectConnection {
+	return v.Metaobjects
+}
+
+// ListMetaobjectsMetaobjectsMetaobjectConnection includes the requested fields of the GraphQL type MetaobjectConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple Metaobjects.
+type ListMetaobjectsMetaobjectsMetaobjectConnection struct {
+	// A list of nodes that are contained in MetaobjectEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []Cli_Metaobject `json:"nodes"`
+	// An object that’s used to retrieve [cursor information](https://shopify.dev/api/usage/pagination-graphql) about the current page.
+	PageInfo ListMetaobjectsMetaobjectsMetaobjectConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns ListMetaobjectsMetaobjectsMetaobjectConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectsMetaobjectsMetaobjectConnection) GetNodes() []Cli_Metaobject { return v.Nodes }
+
+// GetPageInfo returns ListMetaobjectsMetaobjectsMetaobjectConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectsMetaobjectsMetaobjectConnection) GetPageInfo() ListMetaobjectsMetaobjectsMetaobjectConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListMetaobjectsMetaobjectsMetaobjectConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Returns information about pagination in a connection, in accordance with the
+// [Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
+// For more information, please read our [GraphQL Pagination Usage Guide](https://shopify.dev/api/usage/pagination-graphql).
+type ListMetaobjectsMetaobjectsMetaobjectConnectionPageInfo struct {
+	// Whether there are more pages to fetch following the current page.
+	HasNextPage bool `json:"hasNextPage"`
+	// The cursor corresponding to the last node in edges.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListMetaobjectsMetaobjectsMetaobjectConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectsMetaobjectsMetaobjectConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListMetaobjectsMetaobjectsMetaobjectConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectsMetaobjectsMetaobjectConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// ListMetaobjectsResponse is returned by ListMetaobjects on success.
+type ListMetaobjectsResponse struct {
+	// All metaobjects for the shop.
+	Metaobjects ListMetaobjectsMetaobjectsMetaobjectConnection `json:"metaobjects"`
+}
+
+// GetMetaobjects returns ListMetaobjectsResponse.Metaobjects, and is useful for accessing the field via an interface.
+func (v *ListMetaobjectsResponse) GetMetaobjects() ListMetaobjectsMetaobjectsMetaobjectConnection {
+	return v.Metaobjects
+}
+
+// ListProductMetafieldsProductsProductConnection includes the requested fields of the GraphQL type ProductConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple Products.
+type ListProductMetafieldsProductsProductConnection struct {
+	// A list of nodes that are contained in ProductEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []ListProductMetafieldsProductsProductConnectionNodesProduct `json:"nodes"`
+	// An object that’s used to retrieve [cursor information](https://shopify.dev/api/usage/pagination-graphql) about the current page.
+	PageInfo ListProductMetafieldsProductsProductConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns ListProductMetafieldsProductsProductConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListProductMetafieldsProductsProductConnection) GetNodes() []ListProductMetafieldsProductsProductConnectionNodesProduct {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListProductMetafieldsProductsProductConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListProductMetafieldsProductsProductConnection) GetPageInfo() ListProductMetafieldsProductsProductConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListProductMetafieldsProductsProductConnectionNodesProduct includes the requested fields of the GraphQL type Product.
+// The GraphQL type's documentation follows.
+//
+// The `Product` object lets you manage products in a merchant’s store.
+//
+// Products are the goods and services that merchants offer to customers. They can include various details such as title, description, price, images, and options such as size or color.
+// You can use [product variants](https://shopify.dev/docs/api/admin-graphql/latest/objects/productvariant) to create or update different versions of the same product.
+// You can also add or update product [media](https://shopify.dev/docs/api/admin-graphql/latest/interfaces/media).
+// Products can be organized by grouping them into a [collection](https://shopify.dev/docs/api/admin-graphql/latest/objects/collection).
+//
+// Learn more about working with [Shopify's product model](https://shopify.dev/docs/apps/build/graphql/migrate/new-product-model/product-model-components),
+// including limitations and considerations.
+type ListProductMetafieldsProductsProductConnectionNodesProduct struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// A unique, human-readable string of the product's title. A handle can contain letters, hyphens (`-`), and numbers, but no spaces.
+	// The handle is used in the online store URL for the product.
+	Handle string `json:"handle"`
+	// A list of [custom fields](https://shopify.dev/docs/apps/build/custom-data)
+	// that a merchant associates with a Shopify resource.
+	Metafields Cli_MetafieldPage `json:"metafields"`
+}
+
+// GetId returns ListProductMetafieldsProductsProductConnectionNodesProduct.Id, and is useful for accessing the field via an interface.
+func (v *ListProductMetafieldsProductsProductConnectionNodesProduct) GetId() string { return v.Id }
+
+// GetHandle returns ListProductMetafieldsProductsProductConnectionNodesProduct.Handle, and is useful for accessing the field via an interface.
+func (v *ListProductMetafieldsProductsProductConnectionNodesProduct) GetHandle() string {
+	return v.Handle
+}
+
+// GetMetafields returns ListProductMetafieldsProductsProductConnectionNodesProduct.Metafields, and is useful for accessing the field via an interface.
+func (v *ListProductMetafieldsProductsProductConnectionNodesProduct) GetMetafields() Cli_MetafieldPage {
+	return v.Metafields
+}
+
+// ListProductMetafieldsProductsProductConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Returns information about pagination in a connection, in accordance with the
+// [Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
+// For more information, please read our [GraphQL Pagination Usage Guide](https://shopify.dev/api/usage/pagination-graphql).
+type ListProductMetafieldsProductsProductConnectionPageInfo struct {
+	// Whether there are more pages to fetch following the current page.
+	HasNextPage bool `json:"hasNextPage"`
+	// The cursor corresponding to the last node in edges.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListProductMetafieldsProductsProductConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListProductMetafieldsProductsProductConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListProductMetafieldsProductsProductConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListProductMetafieldsProductsProductConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// ListProductMetafieldsResponse is returned by ListProductMetafields on success.
+type ListProductMetafieldsResponse struct {
+	// Returns a list of products.
+	Products ListProductMetafieldsProductsProductConnection `json:"products"`
+}
+
+// GetProducts returns ListProductMetafieldsResponse.Products, and is useful for accessing the field via an interface.
+func (v *ListProductMetafieldsResponse) GetProducts() ListProductMetafieldsProductsProductConnection {
+	return v.Products
+}
+
+// ListShopLocalesResponse is returned by ListShopLocales on success.
+type ListShopLocalesResponse struct {
+	// A list of locales available on a shop.
+	ShopLocales []ListShopLocalesShopLocalesShopLocale `json:"shopLocales"`
+}
+
+// GetShopLocales returns ListShopLocalesResponse.ShopLocales, and is useful for accessing the field via an interface.
+func (v *ListShopLocalesResponse) GetShopLocales() []ListShopLocalesShopLocalesShopLocale {
+	return v.ShopLocales
+}
+
+// ListShopLocalesShopLocalesShopLocale includes the requested fields of the GraphQL type ShopLocale.
+// The GraphQL type's documentation follows.
+//
+// A locale that's been enabled on a shop.
+type ListShopLocalesShopLocalesShopLocale struct {
+	// The locale ISO code.
+	Locale string `json:"locale"`
+	// Whether the locale is the default locale for the shop.
+	Primary bool `json:"primary"`
+	// Whether the locale is visible to buyers.
+	Published bool `json:"published"`
+}
+
+// GetLocale returns ListShopLocalesShopLocalesShopLocale.Locale, and is useful for accessing the field via an interface.
+func (v *ListShopLocalesShopLocalesShopLocale) GetLocale() string { return v.Locale }
+
+// GetPrimary returns ListShopLocalesShopLocalesShopLocale.Primary, and is useful for accessing the field via an interface.
+func (v *ListShopLocalesShopLocalesShopLocale) GetPrimary() bool { return v.Primary }
+
+// GetPublished returns ListShopLocalesShopLocalesShopLocale.Published, and is useful for accessing the field via an interface.
+func (v *ListShopLocalesShopLocalesShopLocale) GetPublished() bool { return v.Published }
+
+// ListStandardMetafieldDefinitionTemplatesResponse is returned by ListStandardMetafieldDefinitionTemplates on success.
+type ListStandardMetafieldDefinitionTemplatesResponse struct {
+	// Standard metafield definitions are intended for specific, common use cases. Their namespace and keys reflect these use cases and are reserved.
+	//
+	// Refer to all available [`Standard Metafield Definition Templates`](https://shopify.dev/api/admin-graphql/latest/objects/StandardMetafieldDefinitionTemplate).
+	StandardMetafieldDefinitionTemplates ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnection `json:"standardMetafieldDefinitionTemplates"`
+}
+
+// GetStandardMetafieldDefinitionTemplates returns ListStandardMetafieldDefinitionTemplatesResponse.StandardMetafieldDefinitionTemplates, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesResponse) GetStandardMetafieldDefinitionTemplates() ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnection {
+	return v.StandardMetafieldDefinitionTemplates
+}
+
+// ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnection includes the requested fields of the GraphQL type StandardMetafieldDefinitionTemplateConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple StandardMetafieldDefinitionTemplates.
+type ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnection struct {
+	// A list of nodes that are contained in StandardMetafieldDefinitionTemplateEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate `json:"nodes"`
+	// An object that’s used to retrieve [cursor information](https://shopify.dev/api/usage/pagination-graphql) about the current page.
+	PageInfo ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnection) GetNodes() []ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnection) GetPageInfo() ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate includes the requested fields of the GraphQL type StandardMetafieldDefinitionTemplate.
+// The GraphQL type's documentation follows.
+//
+// Standard metafield definition templates provide preset configurations to create metafield definitions.
+// Each template has a specific namespace and key that we've reserved to have specific meanings for common use cases.
+//
+// Refer to the [list of standard metafield definitions](https://shopify.dev/apps/metafields/definitions/standard-definitions).
+type ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The namespace owned by the definition after the definition has been activated.
+	Namespace string `json:"namespace"`
+	// The key owned by the definition after the definition has been activated.
+	Key string `json:"key"`
+	// The human-readable name for the standard metafield definition.
+	Name string `json:"name"`
+	// The description of the standard metafield definition.
+	Description string `json:"description"`
+	// The list of resource types that the standard metafield definition can be applied to.
+	OwnerTypes []MetafieldOwnerType `json:"ownerTypes"`
+	// The associated [metafield definition type](https://shopify.dev/apps/metafields/definitions/types) that the metafield stores.
+	Type ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplateTypeMetafieldDefinitionType `json:"type"`
+}
+
+// GetId returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate.Id, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate) GetId() string {
+	return v.Id
+}
+
+// GetNamespace returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate.Namespace, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate) GetNamespace() string {
+	return v.Namespace
+}
+
+// GetKey returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate.Key, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate) GetKey() string {
+	return v.Key
+}
+
+// GetName returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate.Name, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate.Description, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate) GetDescription() string {
+	return v.Description
+}
+
+// GetOwnerTypes returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate.OwnerTypes, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate) GetOwnerTypes() []MetafieldOwnerType {
+	return v.OwnerTypes
+}
+
+// GetType returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate.Type, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplate) GetType() ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplateTypeMetafieldDefinitionType {
+	return v.Type
+}
+
+// ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplateTypeMetafieldDefinitionType includes the requested fields of the GraphQL type MetafieldDefinitionType.
+// The GraphQL type's documentation follows.
+//
+// A metafield definition type provides basic foundation and validation for a metafield.
+type ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplateTypeMetafieldDefinitionType struct {
+	// The name of the type for the metafield definition.
+	// See the list of [supported types](https://shopify.dev/apps/metafields/types).
+	Name string `json:"name"`
+}
+
+// GetName returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplateTypeMetafieldDefinitionType.Name, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionNodesStandardMetafieldDefinitionTemplateTypeMetafieldDefinitionType) GetName() string {
+	return v.Name
+}
+
+// ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Returns information about pagination in a connection, in accordance with the
+// [Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
+// For more information, please read our [GraphQL Pagination Usage Guide](https://shopify.dev/api/usage/pagination-graphql).
+type ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionPageInfo struct {
+	// Whether there are more pages to fetch following the current page.
+	HasNextPage bool `json:"hasNextPage"`
+	// The cursor corresponding to the last node in edges.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplatesStandardMetafieldDefinitionTemplateConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// ListTranslatableResourcesResponse is returned by ListTranslatableResources on success.
+type ListTranslatableResourcesResponse struct {
+	// Resources that can have localized values for different languages.
+	TranslatableResourcesByIds ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnection `json:"translatableResourcesByIds"`
+}
+
+// GetTranslatableResourcesByIds returns ListTranslatableResourcesResponse.TranslatableResourcesByIds, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesResponse) GetTranslatableResourcesByIds() ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnection {
+	return v.TranslatableResourcesByIds
+}
+
+// ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnection includes the requested fields of the GraphQL type TranslatableResourceConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple TranslatableResources.
+type ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnection struct {
+	// A list of nodes that are contained in TranslatableResourceEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource `json:"nodes"`
+}
+
+// GetNodes returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnection) GetNodes() []ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource {
+	return v.Nodes
+}
+
+// ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource includes the requested fields of the GraphQL type TranslatableResource.
+// The GraphQL type's documentation follows.
+//
+// A resource that has translatable fields.
+type ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource struct {
+	// GID of the resource.
+	ResourceId string `json:"resourceId"`
+	// Translatable content.
+	TranslatableContent []ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent `json:"translatableContent"`
+	// Translatable content translations (includes unpublished locales).
+	Translations []ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation `json:"translations"`
+}
+
+// GetResourceId returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource.ResourceId, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource) GetResourceId() string {
+	return v.ResourceId
+}
+
+// GetTranslatableContent returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource.TranslatableContent, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource) GetTranslatableContent() []ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent {
+	return v.TranslatableContent
+}
+
+// GetTranslations returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource.Translations, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResource) GetTranslations() []ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation {
+	return v.Translations
+}
+
+// ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent includes the requested fields of the GraphQL type TranslatableContent.
+// The GraphQL type's documentation follows.
+//
+// Translatable content of a resource's field.
+type ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent struct {
+	// The resource field that's being translated.
+	Key string `json:"key"`
+	// Content value.
+	Value string `json:"value"`
+	// Hash digest representation of the content value.
+	Digest string `json:"digest"`
+}
+
+// GetKey returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent.Key, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent) GetKey() string {
+	return v.Key
+}
+
+// GetValue returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent.Value, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent) GetValue() string {
+	return v.Value
+}
+
+// GetDigest returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent.Digest, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslatableContent) GetDigest() string {
+	return v.Digest
+}
+
+// ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation includes the requested fields of the GraphQL type Translation.
+// The GraphQL type's documentation follows.
+//
+// Translation of a field of a resource.
+type ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation struct {
+	// On the resource that this translation belongs to, the reference to the value being translated.
+	Key string `json:"key"`
+	// Translation value.
+	Value string `json:"value"`
+	// Whether the original content has changed since this translation was updated.
+	Outdated bool `json:"outdated"`
+}
+
+// GetKey returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation.Key, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation) GetKey() string {
+	return v.Key
+}
+
+// GetValue returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation.Value, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation) GetValue() string {
+	return v.Value
+}
+
+// GetOutdated returns ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation.Outdated, and is useful for accessing the field via an interface.
+func (v *ListTranslatableResourcesTranslatableResourcesByIdsTranslatableResourceConnectionNodesTranslatableResourceTranslationsTranslation) GetOutdated() bool {
+	return v.Outdated
+}
+
+// The input fields that set access permissions for the definition's metafields.
+type MetafieldAccessInput struct {
+	// The access permitted on the Admin API.
+	Admin MetafieldAdminAccessInput `json:"admin,omitempty"`
+	// The access permitted on the Storefront API.
+	Storefront MetafieldStorefrontAccessInput `json:"storefront,omitempty"`
+	// The access permitted on the Customer Account API.
+	CustomerAccount MetafieldCustomerAccountAccessInput `json:"customerAccount,omitempty"`
+}
+
+// GetAdmin returns MetafieldAccessInput.Admin, and is useful for accessing the field via an interface.
+func (v *MetafieldAccessInput) GetAdmin() MetafieldAdminAccessInput { return v.Admin }
+
+// GetStorefront returns MetafieldAccessInput.Storefront, and is useful for accessing the field via an interface.
+func (v *MetafieldAccessInput) GetStorefront() MetafieldStorefrontAccessInput { return v.Storefront }
+
+// GetCustomerAccount returns MetafieldAccessInput.CustomerAccount, and is useful for accessing the field via an interface.
+func (v *MetafieldAccessInput) GetCustomerAccount() MetafieldCustomerAccountAccessInput {
+	return v.CustomerAccount
+}
+
+// The input fields for the access settings for the metafields under the definition.
+type MetafieldAccessUpdateInput struct {
+	// The admin access setting to use for the metafields under this definition.
+	Admin MetafieldAdminAccessInput `json:"admin,omitempty"`
+	// The storefront access setting to use for the metafields under this definition.
+	Storefront MetafieldStorefrontAccessInput `json:"storefront,omitempty"`
+	// The Customer Account API access setting to use for the metafields under this definition.
+	CustomerAccount MetafieldCustomerAccountAccessInput `json:"customerAccount,omitempty"`
+}
+
+// GetAdmin returns MetafieldAccessUpdateInput.Admin, and is useful for accessing the field via an interface.
+func (v *MetafieldAccessUpdateInput) GetAdmin() MetafieldAdminAccessInput { return v.Admin }
+
+// GetStorefront returns MetafieldAccessUpdateInput.Storefront, and is useful for accessing the field via an interface.
+func (v *MetafieldAccessUpdateInput) GetStorefront() MetafieldStorefrontAccessInput {
+	return v.Storefront
+}
+
+// GetCustomerAccount returns MetafieldAccessUpdateInput.CustomerAccount, and is useful for accessing the field via an interface.
+func (v *MetafieldAccessUpdateInput) GetCustomerAccount() MetafieldCustomerAccountAccessInput {
+	return v.CustomerAccount
+}
+
+// Metafield access permissions for the Admin API.
+type MetafieldAdminAccess string
+
+const (
+	// The merchant and other apps have no access.
+	MetafieldAdminAccessPrivate MetafieldAdminAccess = "PRIVATE"
+	// The merchant and other apps have read-only access.
+	MetafieldAdminAccessPublicRead MetafieldAdminAccess = "PUBLIC_READ"
+	// The merchant and other apps have read and write access.
+	MetafieldAdminAccessPublicReadWrite MetafieldAdminAccess = "PUBLIC_READ_WRITE"
+	// The merchant has read-only access. No other apps have access.
+	MetafieldAdminAccessMerchantRead MetafieldAdminAccess = "MERCHANT_READ"
+	// The merchant has read and write access. No other apps have access.
+	MetafieldAdminAccessMerchantReadWrite MetafieldAdminAccess = "MERCHANT_READ_WRITE"
+)
+
+var AllMetafieldAdminAccess = []MetafieldAdminAccess{
+	MetafieldAdminAccessPrivate,
+	MetafieldAdminAccessPublicRead,
+	MetafieldAdminAccessPublicReadWrite,
+	MetafieldAdminAccessMerchantRead,
+	MetafieldAdminAccessMerchantReadWrite,
+}
+
+// Metafield access permissions for the Admin API.
+type MetafieldAdminAccessInput string
+
+const (
+	// The merchant has read-only access. No other apps have access.
+	MetafieldAdminAccessInputMerchantRead MetafieldAdminAccessInput = "MERCHANT_READ"
+	// The merchant has read and write access. No other apps have access.
+	MetafieldAdminAccessInputMerchantReadWrite MetafieldAdminAccessInput = "MERCHANT_READ_WRITE"
+)
+
+var AllMetafieldAdminAccessInput = []MetafieldAdminAccessInput{
+	MetafieldAdminAccessInputMerchantRead,
+	MetafieldAdminAccessInputMerchantReadWrite,
+}
+
+// The input fields for enabling and disabling the admin filterable capability.
+type MetafieldCapabilityAdminFilterableInput struct {
+	// Indicates whether the capability should be enabled or disabled.
+	Enabled bool `json:"enabled"`
+}
+
+// GetEnabled returns MetafieldCapabilityAdminFilterableInput.Enabled, and is useful for accessing the field via an interface.
+func (v *MetafieldCapabilityAdminFilterableInput) GetEnabled() bool { return v.Enabled }
+
+// The input fields for creating a metafield capability.
+type MetafieldCapabilityCreateInput struct {
+	// The input for updating the smart collection condition capability.
+	SmartCollectionCondition *MetafieldCapabilitySmartCollectionConditionInput `json:"smartCollectionCondition,omitempty"`
+	// The input for updating the admin filterable capability.
+	AdminFilterable *MetafieldCapabilityAdminFilterableInput `json:"adminFilterable,omitempty"`
+	// The input for updating the unique values capability.
+	UniqueValues *MetafieldCapabilityUniqueValuesInput `json:"uniqueValues,omitempty"`
+}
+
+// GetSmartCollectionCondition returns MetafieldCapabilityCreateInput.SmartCollectionCondition, and is useful for accessing the field via an interface.
+func (v *MetafieldCapabilityCreateInput) GetSmartCollectionCondition() *MetafieldCapabilitySmartCollectionConditionInput {
+	return v.SmartCollectionCondition
+}
+
+// GetAdminFilterable returns MetafieldCapabilityCreateInput.AdminFilterable, and is useful for accessing the field via an interface.
+func (v *MetafieldCapabilityCreateInput) GetAdminFilterable() *MetafieldCapabilityAdminFilterableInput {
+	return v.AdminFilterable
+}
+
+// GetUniqueValues returns MetafieldCapabilityCreateInput.UniqueValues, and is useful for accessing the field via an interface.
+func (v *MetafieldCapabilityCreateInput) GetUniqueValues() *MetafieldCapabilityUniqueValuesInput {
+	return v.UniqueValues
+}
+
+// The input fields for enabling and disabling the smart collection condition capability.
+type MetafieldCapabilitySmartCollectionConditionInput struct {
+	// Indicates whether the capability should be enabled or disabled.
+	Enabled bool `json:"enabled"`
+}
+
+// GetEnabled returns MetafieldCapabilitySmartCollectionConditionInput.Enabled, and is useful for accessing the field via an interface.
+func (v *MetafieldCapabilitySmartCollectionConditionInput) GetEnabled() bool { return v.Enabled }
+
+// The input fields for enabling and disabling the unique values capability.
+type MetafieldCapabilityUniqueValuesInput struct {
+	// Indicates whether the capability should be enabled or disabled.
+	Enabled bool `json:"enabled"`
+}
+
+// GetEnabled returns MetafieldCapabilityUniqueValuesInput.Enabled, and is useful for accessing the field via an interface.
+func (v *MetafieldCapabilityUniqueValuesInput) GetEnabled() bool { return v.Enabled }
+
+// The input fields for updating a metafield capability.
+type MetafieldCapabilityUpdateInput struct {
+	// The input for updating the smart collection condition capability.
+	SmartCollectionCondition *MetafieldCapabilitySmartCollectionConditionInput `json:"smartCollectionCondition,omitempty"`
+	// The input for updating the admin filterable capability.
+	AdminFilterable *MetafieldCapabilityAdminFilterableInput `json:"adminFilterable,omitempty"`
+	// The input for updating the unique values capability.
+	UniqueValues *MetafieldCapabilityUniqueValuesInput `json:"uniqueValues,omitempty"`
+}
+
+// GetSmartCollectionCondition returns MetafieldCapabilityUpdateInput.SmartCollectionCondition, and is useful for accessing the field via an interface.
+func (v *MetafieldCapabilityUpdateInput) GetSmartCollectionCondition() *MetafieldCapabilitySmartCollectionConditionInput {
+	return v.SmartCollectionCondition
+}
+
+// GetAdminFilterable returns MetafieldCapabilityUpdateInput.AdminFilterable, and is useful for accessing the field via an interface.
+func (v *MetafieldCapabilityUpdateInput) GetAdminFilterable() *MetafieldCapabilityAdminFilterableInput {
+	return v.AdminFilterable
+}
+
+// GetUniqueValues returns MetafieldCapabilityUpdateInput.UniqueValues, and is useful for accessing the field via an interface.
+func (v *MetafieldCapabilityUpdateInput) GetUniqueValues() *MetafieldCapabilityUniqueValuesInput {
+	return v.UniqueValues
+}
+
+// Metafield access permissions for the Customer Account API.
+type MetafieldCustomerAccountAccess string
+
+const (
+	// Read and write access.
+	MetafieldCustomerAccountAccessReadWrite MetafieldCustomerAccountAccess = "READ_WRITE"
+	// Read-only access.
+	MetafieldCustomerAccountAccessRead MetafieldCustomerAccountAccess = "READ"
+	// No access.
+	MetafieldCustomerAccountAccessNone MetafieldCustomerAccountAccess = "NONE"
+)
+
+var AllMetafieldCustomerAccountAccess = []MetafieldCustomerAccountAccess{
+	MetafieldCustomerAccountAccessReadWrite,
+	MetafieldCustomerAccountAccessRead,
+	MetafieldCustomerAccountAccessNone,
+}
+
+// Metafield access permissions for the Customer Account API.
+type MetafieldCustomerAccountAccessInput string
+
+const (
+	// Read and write access.
+	MetafieldCustomerAccountAccessInputReadWrite MetafieldCustomerAccountAccessInput = "READ_WRITE"
+	// Read-only access.
+	MetafieldCustomerAccountAccessInputRead MetafieldCustomerAccountAccessInput = "READ"
+	// No access.
+	MetafieldCustomerAccountAccessInputNone MetafieldCustomerAccountAccessInput = "NONE"
+)
+
+var AllMetafieldCustomerAccountAccessInput = []MetafieldCustomerAccountAccessInput{
+	MetafieldCustomerAccountAccessInputReadWrite,
+	MetafieldCustomerAccountAccessInputRead,
+	MetafieldCustomerAccountAccessInputNone,
+}
+
+// The inputs fields for modifying a metafield definition's constraint subtype values.
+// Exactly one option is required.
+type MetafieldDefinitionConstraintValueUpdateInput struct {
+	// The constraint subtype value to create.
+	Create string `json:"create,omitempty"`
+	// The constraint subtype value to delete.
+	Delete string `json:"delete,omitempty"`
+}
+
+// GetCreate returns MetafieldDefinitionConstraintValueUpdateInput.Create, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionConstraintValueUpdateInput) GetCreate() string { return v.Create }
+
+// GetDelete returns MetafieldDefinitionConstraintValueUpdateInput.Delete, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionConstraintValueUpdateInput) GetDelete() string { return v.Delete }
+
+// The input fields required to create metafield definition [constraints](https://shopify.dev/apps/build/custom-data/metafields/conditional-metafield-definitions).
+// Each constraint applies a metafield definition to a subtype of a resource.
+type MetafieldDefinitionConstraintsInput struct {
+	// The category of resource subtypes that the definition applies to.
+	Key string `json:"key"`
+	// The specific constraint subtype values that the definition applies to.
+	Values []string `json:"values"`
+}
+
+// GetKey returns MetafieldDefinitionConstraintsInput.Key, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionConstraintsInput) GetKey() string { return v.Key }
+
+// GetValues returns MetafieldDefinitionConstraintsInput.Values, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionConstraintsInput) GetValues() []string { return v.Values }
+
+// The input fields required to update metafield definition [constraints](https://shopify.dev/apps/build/custom-data/metafields/conditional-metafield-definitions).
+// Each constraint applies a metafield definition to a subtype of a resource.
+type MetafieldDefinitionConstraintsUpdatesInput struct {
+	// The category of resource subtypes that the definition applies to.
+	// If omitted and the definition is already constrained, the existing constraint key will be used.
+	// If set to `null`, all constraints will be removed.
+	Key string `json:"key"`
+	// The specific constraint subtype values to create or delete.
+	Values []MetafieldDefinitionConstraintValueUpdateInput `json:"values"`
+}
+
+// GetKey returns MetafieldDefinitionConstraintsUpdatesInput.Key, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionConstraintsUpdatesInput) GetKey() string { return v.Key }
+
+// GetValues returns MetafieldDefinitionConstraintsUpdatesInput.Values, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionConstraintsUpdatesInput) GetValues() []MetafieldDefinitionConstraintValueUpdateInput {
+	return v.Values
+}
+
+// Possible error codes that can be returned by `MetafieldDefinitionCreateUserError`.
+type MetafieldDefinitionCreateUserErrorCode string
+
+const (
+	// The input value is invalid.
+	MetafieldDefinitionCreateUserErrorCodeInvalid MetafieldDefinitionCreateUserErrorCode = "INVALID"
+	// The input value isn't included in the list.
+	MetafieldDefinitionCreateUserErrorCodeInclusion MetafieldDefinitionCreateUserErrorCode = "INCLUSION"
+	// The input value needs to be blank.
+	MetafieldDefinitionCreateUserErrorCodePresent MetafieldDefinitionCreateUserErrorCode = "PRESENT"
+	// The input value is already taken.
+	MetafieldDefinitionCreateUserErrorCodeTaken MetafieldDefinitionCreateUserErrorCode = "TAKEN"
+	// The input value is too long.
+	MetafieldDefinitionCreateUserErrorCodeTooLong MetafieldDefinitionCreateUserErrorCode = "TOO_LONG"
+	// The input value is too short.
+	MetafieldDefinitionCreateUserErrorCodeTooShort MetafieldDefinitionCreateUserErrorCode = "TOO_SHORT"
+	// A capability is required for the definition type but is disabled.
+	MetafieldDefinitionCreateUserErrorCodeCapabilityRequiredButDisabled MetafieldDefinitionCreateUserErrorCode = "CAPABILITY_REQUIRED_BUT_DISABLED"
+	// The definition limit per owner type has exceeded.
+	MetafieldDefinitionCreateUserErrorCodeResourceTypeLimitExceeded MetafieldDefinitionCreateUserErrorCode = "RESOURCE_TYPE_LIMIT_EXCEEDED"
+	// The maximum limit of definitions per owner type has exceeded.
+	MetafieldDefinitionCreateUserErrorCodeLimitExceeded MetafieldDefinitionCreateUserErrorCode = "LIMIT_EXCEEDED"
+	// An invalid option.
+	MetafieldDefinitionCreateUserErrorCodeInvalidOption MetafieldDefinitionCreateUserErrorCode = "INVALID_OPTION"
+	// A duplicate option.
+	MetafieldDefinitionCreateUserErrorCodeDuplicateOption MetafieldDefinitionCreateUserErrorCode = "DUPLICATE_OPTION"
+	// This namespace and key combination is reserved for standard definitions.
+	MetafieldDefinitionCreateUserErrorCodeReservedNamespaceKey MetafieldDefinitionCreateUserErrorCode = "RESERVED_NAMESPACE_KEY"
+	// The pinned limit has been reached for the owner type.
+	MetafieldDefinitionCreateUserErrorCodePinnedLimitReached MetafieldDefinitionCreateUserErrorCode = "PINNED_LIMIT_REACHED"
+	// This namespace and key combination is already in use for a set of your metafields.
+	MetafieldDefinitionCreateUserErrorCodeUnstructuredAlreadyExists MetafieldDefinitionCreateUserErrorCode = "UNSTRUCTURED_ALREADY_EXISTS"
+	// The metafield definition does not support pinning.
+	MetafieldDefinitionCreateUserErrorCodeUnsupportedPinning MetafieldDefinitionCreateUserErrorCode = "UNSUPPORTED_PINNING"
+	// A field contains an invalid character.
+	MetafieldDefinitionCreateUserErrorCodeInvalidCharacter MetafieldDefinitionCreateUserErrorCode = "INVALID_CHARACTER"
+	// The definition type is not eligible to be used as collection condition.
+	MetafieldDefinitionCreateUserErrorCodeTypeNotAllowedForConditions MetafieldDefinitionCreateUserErrorCode = "TYPE_NOT_ALLOWED_FOR_CONDITIONS"
+	// You have reached the maximum allowed definitions for automated collections.
+	MetafieldDefinitionCreateUserErrorCodeOwnerTypeLimitExceededForAutomatedCollections MetafieldDefinitionCreateUserErrorCode = "OWNER_TYPE_LIMIT_EXCEEDED_FOR_AUTOMATED_COLLECTIONS"
+	// The metafield definition constraints are invalid.
+	MetafieldDefinitionCreateUserErrorCodeInvalidConstraints MetafieldDefinitionCreateUserErrorCode = "INVALID_CONSTRAINTS"
+	// The maximum limit of grants per definition type has been exceeded.
+	MetafieldDefinitionCreateUserErrorCodeGrantLimitExceeded MetafieldDefinitionCreateUserErrorCode = "GRANT_LIMIT_EXCEEDED"
+	// The input combination is invalid.
+	MetafieldDefinitionCreateUserErrorCodeInvalidInputCombination MetafieldDefinitionCreateUserErrorCode = "INVALID_INPUT_COMBINATION"
+	// The metafield definition capability is invalid.
+	MetafieldDefinitionCreateUserErrorCodeInvalidCapability MetafieldDefinitionCreateUserErrorCode = "INVALID_CAPABILITY"
+	// Admin access can only be specified for app-owned metafield definitions.
+	MetafieldDefinitionCreateUserErrorCodeAdminAccessInputNotAllowed MetafieldDefinitionCreateUserErrorCode = "ADMIN_ACCESS_INPUT_NOT_ALLOWED"
+)
+
+var AllMetafieldDefinitionCreateUserErrorCode = []MetafieldDefinitionCreateUserErrorCode{
+	MetafieldDefinitionCreateUserErrorCodeInvalid,
+	MetafieldDefinitionCreateUserErrorCodeInclusion,
+	MetafieldDefinitionCreateUserErrorCodePresent,
+	MetafieldDefinitionCreateUserErrorCodeTaken,
+	MetafieldDefinitionCreateUserErrorCodeTooLong,
+	MetafieldDefinitionCreateUserErrorCodeTooShort,
+	MetafieldDefinitionCreateUserErrorCodeCapabilityRequiredButDisabled,
+	MetafieldDefinitionCreateUserErrorCodeResourceTypeLimitExceeded,
+	MetafieldDefinitionCreateUserErrorCodeLimitExceeded,
+	MetafieldDefinitionCreateUserErrorCodeInvalidOption,
+	MetafieldDefinitionCreateUserErrorCodeDuplicateOption,
+	MetafieldDefinitionCreateUserErrorCodeReservedNamespaceKey,
+	MetafieldDefinitionCreateUserErrorCodePinnedLimitReached,
+	MetafieldDefinitionCreateUserErrorCodeUnstructuredAlreadyExists,
+	MetafieldDefinitionCreateUserErrorCodeUnsupportedPinning,
+	MetafieldDefinitionCreateUserErrorCodeInvalidCharacter,
+	MetafieldDefinitionCreateUserErrorCodeTypeNotAllowedForConditions,
+	MetafieldDefinitionCreateUserErrorCodeOwnerTypeLimitExceededForAutomatedCollections,
+	MetafieldDefinitionCreateUserErrorCodeInvalidConstraints,
+	MetafieldDefinitionCreateUserErrorCodeGrantLimitExceeded,
+	MetafieldDefinitionCreateUserErrorCodeInvalidInputCombination,
+	MetafieldDefinitionCreateUserErrorCodeInvalidCapability,
+	MetafieldDefinitionCreateUserErrorCodeAdminAccessInputNotAllowed,
+}
+
+// The input fields that identify metafield definitions.
+type MetafieldDefinitionIdentifierInput struct {
+	// The resource type that the metafield definition is attached to.
+	OwnerType MetafieldOwnerType `json:"ownerType"`
+	// The container for a group of metafields that the metafield definition will be associated with. If omitted, the
+	// app-reserved namespace will be used.
+	Namespace string `json:"namespace"`
+	// The unique identifier for the metafield definition within its namespace.
+	Key string `json:"key"`
+}
+
+// GetOwnerType returns MetafieldDefinitionIdentifierInput.OwnerType, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionIdentifierInput) GetOwnerType() MetafieldOwnerType { return v.OwnerType }
+
+// GetNamespace returns MetafieldDefinitionIdentifierInput.Namespace, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionIdentifierInput) GetNamespace() string { return v.Namespace }
+
+// GetKey returns MetafieldDefinitionIdentifierInput.Key, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionIdentifierInput) GetKey() string { return v.Key }
+
+// The input fields required to create a metafield definition.
+type MetafieldDefinitionInput struct {
+	// The container for a group of metafields that the metafield definition will be associated with. If omitted, the
+	// app-reserved namespace will be used.
+	//
+	// Must be 3-255 characters long and only contain alphanumeric, hyphen, and underscore characters.
+	Namespace string `json:"namespace"`
+	// The unique identifier for the metafield definition within its namespace.
+	//
+	// Must be 2-64 characters long and only contain alphanumeric, hyphen, and underscore characters.
+	Key string `json:"key"`
+	// The human-readable name for the metafield definition.
+	Name string `json:"name"`
+	// The description for the metafield definition.
+	Description string `json:"description,omitempty"`
+	// The resource type that the metafield definition is attached to.
+	OwnerType MetafieldOwnerType `json:"ownerType"`
+	// The type of data that each of the metafields that belong to the metafield definition will store.
+	// Refer to the list of [supported types](https://shopify.dev/apps/metafields/types).
+	Type string `json:"type"`
+	// A list of [validation options](https://shopify.dev/apps/metafields/definitions/validation) for
+	// the metafields that belong to the metafield definition. For example, for a metafield definition with the
+	// type `date`, you can set a minimum date validation so that each of the metafields that belong to it can only
+	// store dates after the specified minimum.
+	Validations []MetafieldDefinitionValidationInput `json:"validations"`
+	// Whether to [pin](https://help.shopify.com/manual/custom-data/metafields/pinning-metafield-definitions)
+	// the metafield definition.
+	Pin *bool `json:"pin,omitempty"`
+	// The access settings that apply to each of the metafields that belong to the metafield definition.
+	Access *MetafieldAccessInput `json:"access,omitempty"`
+	// The [constraints](https://shopify.dev/apps/build/custom-data/metafields/conditional-metafield-definitions)
+	// that determine what resources a metafield definition applies to.
+	Constraints *MetafieldDefinitionConstraintsInput `json:"constraints,omitempty"`
+	// The capabilities of the metafield definition.
+	Capabilities *MetafieldCapabilityCreateInput `json:"capabilities,omitempty"`
+}
+
+// GetNamespace returns MetafieldDefinitionInput.Namespace, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetNamespace() string { return v.Namespace }
+
+// GetKey returns MetafieldDefinitionInput.Key, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetKey() string { return v.Key }
+
+// GetName returns MetafieldDefinitionInput.Name, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetName() string { return v.Name }
+
+// GetDescription returns MetafieldDefinitionInput.Description, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetDescription() string { return v.Description }
+
+// GetOwnerType returns MetafieldDefinitionInput.OwnerType, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetOwnerType() MetafieldOwnerType { return v.OwnerType }
+
+// GetType returns MetafieldDefinitionInput.Type, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetType() string { return v.Type }
+
+// GetValidations returns MetafieldDefinitionInput.Validations, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetValidations() []MetafieldDefinitionValidationInput {
+	return v.Validations
+}
+
+// GetPin returns MetafieldDefinitionInput.Pin, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetPin() *bool { return v.Pin }
+
+// GetAccess returns MetafieldDefinitionInput.Access, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetAccess() *MetafieldAccessInput { return v.Access }
+
+// GetConstraints returns MetafieldDefinitionInput.Constraints, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetConstraints() *MetafieldDefinitionConstraintsInput {
+	return v.Constraints
+}
+
+// GetCapabilities returns MetafieldDefinitionInput.Capabilities, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionInput) GetCapabilities() *MetafieldCapabilityCreateInput {
+	return v.Capabilities
+}
+
+// Possible error codes that can be returned by `MetafieldDefinitionPinUserError`.
+type MetafieldDefinitionPinUserErrorCode string
+
+const (
+	// The metafield definition was not found.
+	MetafieldDefinitionPinUserErrorCodeNotFound MetafieldDefinitionPinUserErrorCode = "NOT_FOUND"
+	// The pinned limit has been reached for owner type.
+	MetafieldDefinitionPinUserErrorCodePinnedLimitReached MetafieldDefinitionPinUserErrorCode = "PINNED_LIMIT_REACHED"
+	// The metafield definition is already pinned.
+	MetafieldDefinitionPinUserErrorCodeAlreadyPinned MetafieldDefinitionPinUserErrorCode = "ALREADY_PINNED"
+	// An internal error occurred.
+	MetafieldDefinitionPinUserErrorCodeInternalError MetafieldDefinitionPinUserErrorCode = "INTERNAL_ERROR"
+	// The metafield definition does not support pinning.
+	MetafieldDefinitionPinUserErrorCodeUnsupportedPinning MetafieldDefinitionPinUserErrorCode = "UNSUPPORTED_PINNING"
+	// Owner type can't be used in this mutation.
+	MetafieldDefinitionPinUserErrorCodeDisallowedOwnerType MetafieldDefinitionPinUserErrorCode = "DISALLOWED_OWNER_TYPE"
+)
+
+var AllMetafieldDefinitionPinUserErrorCode = []MetafieldDefinitionPinUserErrorCode{
+	MetafieldDefinitionPinUserErrorCodeNotFound,
+	MetafieldDefinitionPinUserErrorCodePinnedLimitReached,
+	MetafieldDefinitionPinUserErrorCodeAlreadyPinned,
+	MetafieldDefinitionPinUserErrorCodeInternalError,
+	MetafieldDefinitionPinUserErrorCodeUnsupportedPinning,
+	MetafieldDefinitionPinUserErrorCodeDisallowedOwnerType,
+}
+
+// Possible error codes that can be returned by `MetafieldDefinitionUnpinUserError`.
+type MetafieldDefinitionUnpinUserErrorCode string
+
+const (
+	// The metafield definition was not found.
+	MetafieldDefinitionUnpinUserErrorCodeNotFound MetafieldDefinitionUnpinUserErrorCode = "NOT_FOUND"
+	// The metafield definition isn't pinned.
+	MetafieldDefinitionUnpinUserErrorCodeNotPinned MetafieldDefinitionUnpinUserErrorCode = "NOT_PINNED"
+	// An internal error occurred.
+	MetafieldDefinitionUnpinUserErrorCodeInternalError MetafieldDefinitionUnpinUserErrorCode = "INTERNAL_ERROR"
+	// Owner type can't be used in this mutation.
+	MetafieldDefinitionUnpinUserErrorCodeDisallowedOwnerType MetafieldDefinitionUnpinUserErrorCode = "DISALLOWED_OWNER_TYPE"
+)
+
+var AllMetafieldDefinitionUnpinUserErrorCode = []MetafieldDefinitionUnpinUserErrorCode{
+	MetafieldDefinitionUnpinUserErrorCodeNotFound,
+	MetafieldDefinitionUnpinUserErrorCodeNotPinned,
+	MetafieldDefinitionUnpinUserErrorCodeInternalError,
+	MetafieldDefinitionUnpinUserErrorCodeDisallowedOwnerType,
+}
+
+// The input fields required to update a metafield definition.
+type MetafieldDefinitionUpdateInput struct {
+	// The container for a group of metafields that the metafield definition is associated with. Used to help identify
+	// the metafield definition, but cannot be updated itself. If omitted, the app-reserved namespace will be used.
+	Namespace string `json:"namespace"`
+	// The unique identifier for the metafield definition within its namespace. Used to help identify the metafield
+	// definition, but can't be updated itself.
+	Key string `json:"key"`
+	// The human-readable name for the metafield definition.
+	Name string `json:"name,omitempty"`
+	// The description for the metafield definition.
+	Description string `json:"description"`
+	// The resource type that the metafield definition is attached to. Used to help identify the metafield definition,
+	// but can't be updated itself.
+	OwnerType MetafieldOwnerType `json:"ownerType"`
+	// A list of [validation options](https://shopify.dev/apps/metafields/definitions/validation) for
+	// the metafields that belong to the metafield definition. For example, for a metafield definition with the
+	// type `date`, you can set a minimum date validation so that each of the metafields that belong to it can only
+	// store dates after the specified minimum.
+	Validations []MetafieldDefinitionValidationInput `json:"validations"`
+	// Whether to pin the metafield definition.
+	Pin *bool `json:"pin,omitempty"`
+	// The access settings that apply to each of the metafields that belong to the metafield definition.
+	Access *MetafieldAccessUpdateInput `json:"access,omitempty"`
+	// The [constraints](https://shopify.dev/apps/build/custom-data/metafields/conditional-metafield-definitions)
+	// that determine what resources a metafield definition applies to.
+	ConstraintsUpdates *MetafieldDefinitionConstraintsUpdatesInput `json:"constraintsUpdates,omitempty"`
+	// The capabilities of the metafield definition.
+	Capabilities *MetafieldCapabilityUpdateInput `json:"capabilities,omitempty"`
+}
+
+// GetNamespace returns MetafieldDefinitionUpdateInput.Namespace, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetNamespace() string { return v.Namespace }
+
+// GetKey returns MetafieldDefinitionUpdateInput.Key, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetKey() string { return v.Key }
+
+// GetName returns MetafieldDefinitionUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetName() string { return v.Name }
+
+// GetDescription returns MetafieldDefinitionUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetDescription() string { return v.Description }
+
+// GetOwnerType returns MetafieldDefinitionUpdateInput.OwnerType, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetOwnerType() MetafieldOwnerType { return v.OwnerType }
+
+// GetValidations returns MetafieldDefinitionUpdateInput.Validations, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetValidations() []MetafieldDefinitionValidationInput {
+	return v.Validations
+}
+
+// GetPin returns MetafieldDefinitionUpdateInput.Pin, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetPin() *bool { return v.Pin }
+
+// GetAccess returns MetafieldDefinitionUpdateInput.Access, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetAccess() *MetafieldAccessUpdateInput { return v.Access }
+
+// GetConstraintsUpdates returns MetafieldDefinitionUpdateInput.ConstraintsUpdates, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetConstraintsUpdates() *MetafieldDefinitionConstraintsUpdatesInput {
+	return v.ConstraintsUpdates
+}
+
+// GetCapabilities returns MetafieldDefinitionUpdateInput.Capabilities, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionUpdateInput) GetCapabilities() *MetafieldCapabilityUpdateInput {
+	return v.Capabilities
+}
+
+// Possible error codes that can be returned by `MetafieldDefinitionUpdateUserError`.
+type MetafieldDefinitionUpdateUserErrorCode string
+
+const (
+	// The input value needs to be blank.
+	MetafieldDefinitionUpdateUserErrorCodePresent MetafieldDefinitionUpdateUserErrorCode = "PRESENT"
+	// The input value is too long.
+	MetafieldDefinitionUpdateUserErrorCodeTooLong MetafieldDefinitionUpdateUserErrorCode = "TOO_LONG"
+	// The metafield definition wasn't found.
+	MetafieldDefinitionUpdateUserErrorCodeNotFound MetafieldDefinitionUpdateUserErrorCode = "NOT_FOUND"
+	// An invalid input.
+	MetafieldDefinitionUpdateUserErrorCodeInvalidInput MetafieldDefinitionUpdateUserErrorCode = "INVALID_INPUT"
+	// A capability is required for the definition type but is disabled.
+	MetafieldDefinitionUpdateUserErrorCodeCapabilityRequiredButDisabled MetafieldDefinitionUpdateUserErrorCode = "CAPABILITY_REQUIRED_BUT_DISABLED"
+	// The pinned limit has been reached for the owner type.
+	MetafieldDefinitionUpdateUserErrorCodePinnedLimitReached MetafieldDefinitionUpdateUserErrorCode = "PINNED_LIMIT_REACHED"
+	// An internal error occurred.
+	MetafieldDefinitionUpdateUserErrorCodeInternalError MetafieldDefinitionUpdateUserErrorCode = "INTERNAL_ERROR"
+	// The metafield definition does not support pinning.
+	MetafieldDefinitionUpdateUserErrorCodeUnsupportedPinning MetafieldDefinitionUpdateUserErrorCode = "UNSUPPORTED_PINNING"
+	// The definition type is not eligible to be used as collection condition.
+	MetafieldDefinitionUpdateUserErrorCodeTypeNotAllowedForConditions MetafieldDefinitionUpdateUserErrorCode = "TYPE_NOT_ALLOWED_FOR_CONDITIONS"
+	// Action cannot proceed. Definition is currently in use.
+	MetafieldDefinitionUpdateUserErrorCodeMetafieldDefinitionInUse MetafieldDefinitionUpdateUserErrorCode = "METAFIELD_DEFINITION_IN_USE"
+	// You have reached the maximum allowed definitions for automated collections.
+	MetafieldDefinitionUpdateUserErrorCodeOwnerTypeLimitExceededForAutomatedCollections MetafieldDefinitionUpdateUserErrorCode = "OWNER_TYPE_LIMIT_EXCEEDED_FOR_AUTOMATED_COLLECTIONS"
+	// You cannot change the metaobject definition pointed to by a metaobject reference metafield definition.
+	MetafieldDefinitionUpdateUserErrorCodeMetaobjectDefinitionChanged MetafieldDefinitionUpdateUserErrorCode = "METAOBJECT_DEFINITION_CHANGED"
+	// The maximum limit of grants per definition type has been exceeded.
+	MetafieldDefinitionUpdateUserErrorCodeGrantLimitExceeded MetafieldDefinitionUpdateUserErrorCode = "GRANT_LIMIT_EXCEEDED"
+	// The input combination is invalid.
+	MetafieldDefinitionUpdateUserErrorCodeInvalidInputCombination MetafieldDefinitionUpdateUserErrorCode = "INVALID_INPUT_COMBINATION"
+	// The metafield definition constraints are invalid.
+	MetafieldDefinitionUpdateUserErrorCodeInvalidConstraints MetafieldDefinitionUpdateUserErrorCode = "INVALID_CONSTRAINTS"
+	// The metafield definition capability is invalid.
+	MetafieldDefinitionUpdateUserErrorCodeInvalidCapability MetafieldDefinitionUpdateUserErrorCode = "INVALID_CAPABILITY"
+	// The metafield definition capability cannot be disabled.
+	MetafieldDefinitionUpdateUserErrorCodeCapabilityCannotBeDisabled MetafieldDefinitionUpdateUserErrorCode = "CAPABILITY_CANNOT_BE_DISABLED"
+	// Admin access can only be specified for app-owned metafield definitions.
+	MetafieldDefinitionUpdateUserErrorCodeAdminAccessInputNotAllowed MetafieldDefinitionUpdateUserErrorCode = "ADMIN_ACCESS_INPUT_NOT_ALLOWED"
+)
+
+var AllMetafieldDefinitionUpdateUserErrorCode = []MetafieldDefinitionUpdateUserErrorCode{
+	MetafieldDefinitionUpdateUserErrorCodePresent,
+	MetafieldDefinitionUpdateUserErrorCodeTooLong,
+	MetafieldDefinitionUpdateUserErrorCodeNotFound,
+	MetafieldDefinitionUpdateUserErrorCodeInvalidInput,
+	MetafieldDefinitionUpdateUserErrorCodeCapabilityRequiredButDisabled,
+	MetafieldDefinitionUpdateUserErrorCodePinnedLimitReached,
+	MetafieldDefinitionUpdateUserErrorCodeInternalError,
+	MetafieldDefinitionUpdateUserErrorCodeUnsupportedPinning,
+	MetafieldDefinitionUpdateUserErrorCodeTypeNotAllowedForConditions,
+	MetafieldDefinitionUpdateUserErrorCodeMetafieldDefinitionInUse,
+	MetafieldDefinitionUpdateUserErrorCodeOwnerTypeLimitExceededForAutomatedCollections,
+	MetafieldDefinitionUpdateUserErrorCodeMetaobjectDefinitionChanged,
+	MetafieldDefinitionUpdateUserErrorCodeGrantLimitExceeded,
+	MetafieldDefinitionUpdateUserErrorCodeInvalidInputCombination,
+	MetafieldDefinitionUpdateUserErrorCodeInvalidConstraints,
+	MetafieldDefinitionUpdateUserErrorCodeInvalidCapability,
+	MetafieldDefinitionUpdateUserErrorCodeCapabilityCannotBeDisabled,
+	MetafieldDefinitionUpdateUserErrorCodeAdminAccessInputNotAllowed,
+}
+
+// The name and value for a metafield definition validation.
+//
+// For example, for a metafield definition of `single_line_text_field` type, you can set a validation with the name `min` and a value of `10`.
+// This validation will ensure that the value of the metafield is at least 10 characters.
+//
+// Refer to the [list of supported validations](https://shopify.dev/api/admin/graphql/reference/common-objects/metafieldDefinitionTypes#examples-Fetch_all_metafield_definition_types).
+type MetafieldDefinitionValidationInput struct {
+	// The name for the metafield definition validation.
+	Name string `json:"name"`
+	// The value for the metafield definition validation.
+	Value string `json:"value"`
+}
+
+// GetName returns MetafieldDefinitionValidationInput.Name, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionValidationInput) GetName() string { return v.Name }
+
+// GetValue returns MetafieldDefinitionValidationInput.Value, and is useful for accessing the field via an interface.
+func (v *MetafieldDefinitionValidationInput) GetValue() string { return v.Value }
+
+// The input fields that identify metafields.
+type MetafieldIdentifierInput struct {
+	// The unique ID of the resource that the metafield is attached to.
+	OwnerId string `json:"ownerId"`
+	// The namespace of the metafield.
+	Namespace string `json:"namespace"`
+	// The key of the metafield.
+	Key string `json:"key"`
+}
+
+// GetOwnerId returns MetafieldIdentifierInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *MetafieldIdentifierInput) GetOwnerId() string { return v.OwnerId }
+
+// GetNamespace returns MetafieldIdentifierInput.Namespace, and is useful for accessing the field via an interface.
+func (v *MetafieldIdentifierInput) GetNamespace() string { return v.Namespace }
+
+// GetKey returns MetafieldIdentifierInput.Key, and is useful for accessing the field via an interface.
+func (v *MetafieldIdentifierInput) GetKey() string { return v.Key }
+
+// Possible types of a metafield's owner resource.
+type MetafieldOwnerType string
+
+const (
+	// The Api Permission metafield owner type.
+	MetafieldOwnerTypeApiPermission MetafieldOwnerType = "API_PERMISSION"
+	// The Company metafield owner type.
+	MetafieldOwnerTypeCompany MetafieldOwnerType = "COMPANY"
+	// The Company Location metafield owner type.
+	MetafieldOwnerTypeCompanyLocation MetafieldOwnerType = "COMPANY_LOCATION"
+	// The Payment Customization metafield owner type.
+	MetafieldOwnerTypePaymentCustomization MetafieldOwnerType = "PAYMENT_CUSTOMIZATION"
+	// The Validation metafield owner type.
+	MetafieldOwnerTypeValidation MetafieldOwnerType = "VALIDATION"
+	// The Customer metafield owner type.
+	MetafieldOwnerTypeCustomer MetafieldOwnerType = "CUSTOMER"
+	// The Delivery Customization metafield owner type.
+	MetafieldOwnerTypeDeliveryCustomization MetafieldOwnerType = "DELIVERY_CUSTOMIZATION"
+	// The draft order metafield owner type.
+	MetafieldOwnerTypeDraftorder MetafieldOwnerType = "DRAFTORDER"
+	// The GiftCardTransaction metafield owner type.
+	MetafieldOwnerTypeGiftCardTransaction MetafieldOwnerType = "GIFT_CARD_TRANSACTION"
+	// The Market metafield owner type.
+	MetafieldOwnerTypeMarket MetafieldOwnerType = "MARKET"
+	// The Cart Transform metafield owner type.
+	MetafieldOwnerTypeCarttransform MetafieldOwnerType = "CARTTRANSFORM"
+	// The Collection metafield owner type.
+	MetafieldOwnerTypeCollection MetafieldOwnerType = "COLLECTION"
+	// The Media Image metafield owner type.
+	MetafieldOwnerTypeMediaImage MetafieldOwnerType = "MEDIA_IMAGE"
+	// The Product metafield owner type.
+	MetafieldOwnerTypeProduct MetafieldOwnerType = "PRODUCT"
+	// The Product Variant metafield owner type.
+	MetafieldOwnerTypeProductvariant MetafieldOwnerType = "PRODUCTVARIANT"
+	// The Selling Plan metafield owner type.
+	MetafieldOwnerTypeSellingPlan MetafieldOwnerType = "SELLING_PLAN"
+	// The Article metafield owner type.
+	MetafieldOwnerTypeArticle MetafieldOwnerType = "ARTICLE"
+	// The Blog metafield owner type.
+	MetafieldOwnerTypeBlog MetafieldOwnerType = "BLOG"
+	// The Page metafield owner type.
+	MetafieldOwnerTypePage MetafieldOwnerType = "PAGE"
+	// The Fulfillment Constraint Rule metafield owner type.
+	MetafieldOwnerTypeFulfillmentConstraintRule MetafieldOwnerType = "FULFILLMENT_CONSTRAINT_RULE"
+	// The Order Routing Location Rule metafield owner type.
+	MetafieldOwnerTypeOrderRoutingLocationRule MetafieldOwnerType = "ORDER_ROUTING_LOCATION_RULE"
+	// The Discount metafield owner type.
+	MetafieldOwnerTypeDiscount MetafieldOwnerType = "DISCOUNT"
+	// The Order metafield owner type.
+	MetafieldOwnerTypeOrder MetafieldOwnerType = "ORDER"
+	// The Location metafield owner type.
+	MetafieldOwnerTypeLocation MetafieldOwnerType = "LOCATION"
+	// The Shop metafield owner type.
+	MetafieldOwnerTypeShop MetafieldOwnerType = "SHOP"
+)
+
+var AllMetafieldOwnerType = []MetafieldOwnerType{
+	MetafieldOwnerTypeApiPermission,
+	MetafieldOwnerTypeCompany,
+	MetafieldOwnerTypeCompanyLocation,
+	MetafieldOwnerTypePaymentCustomization,
+	MetafieldOwnerTypeValidation,
+	MetafieldOwnerTypeCustomer,
+	MetafieldOwnerTypeDeliveryCustomization,
+	MetafieldOwnerTypeDraftorder,
+	MetafieldOwnerTypeGiftCardTransaction,
+	MetafieldOwnerTypeMarket,
+	MetafieldOwnerTypeCarttransform,
+	MetafieldOwnerTypeCollection,
+	MetafieldOwnerTypeMediaImage,
+	MetafieldOwnerTypeProduct,
+	MetafieldOwnerTypeProductvariant,
+	MetafieldOwnerTypeSellingPlan,
+	MetafieldOwnerTypeArticle,
+	MetafieldOwnerTypeBlog,
+	MetafieldOwnerTypePage,
+	MetafieldOwnerTypeFulfillmentConstraintRule,
+	MetafieldOwnerTypeOrderRoutingLocationRule,
+	MetafieldOwnerTypeDiscount,
+	MetafieldOwnerTypeOrder,
+	MetafieldOwnerTypeLocation,
+	MetafieldOwnerTypeShop,
+}
+
+// Metafield access permissions for the Storefront API.
+type MetafieldStorefrontAccess string
+
+const (
+	// Read-only access.
+	MetafieldStorefrontAccessPublicRead MetafieldStorefrontAccess = "PUBLIC_READ"
+	// No access.
+	MetafieldStorefrontAccessNone MetafieldStorefrontAccess = "NONE"
+)
+
+var AllMetafieldStorefrontAccess = []MetafieldStorefrontAccess{
+	MetafieldStorefrontAccessPublicRead,
+	MetafieldStorefrontAccessNone,
+}
+
+// Metafield access permissions for the Storefront API.
+type MetafieldStorefrontAccessInput string
+
+const (
+	// Read-only access.
+	MetafieldStorefrontAccessInputPublicRead MetafieldStorefrontAccessInput = "PUBLIC_READ"
+	// No access.
+	MetafieldStorefrontAccessInputNone MetafieldStorefrontAccessInput = "NONE"
+)
+
+var AllMetafieldStorefrontAccessInput = []MetafieldStorefrontAccessInput{
+	MetafieldStorefrontAccessInputPublicRead,
+	MetafieldStorefrontAccessInputNone,
+}
+
+// The input fields for a metafield value to set.
+type MetafieldsSetInput struct {
+	// The unique ID of the resource that the metafield is attached to.
+	OwnerId string `json:"ownerId"`
+	// The container for a group of metafields that the metafield is or will be associated with. Used in tandem
+	// with `key` to lookup a metafield on a resource, preventing conflicts with other metafields with the
+	// same `key`. If omitted the app-reserved namespace will be used.
+	//
+	// Must be 3-255 characters long and can contain alphanumeric, hyphen, and underscore characters.
+	Namespace string `json:"namespace,omitempty"`
+	// The unique identifier for a metafield within its namespace.
+	//
+	// Must be 2-64 characters long and can contain alphanumeric, hyphen, and underscore characters.
+	Key string `json:"key"`
+	// The data stored in the metafield. Always stored as a string, regardless of the metafield's type.
+	Value string `json:"value"`
+	// The `compareDigest` value obtained from a previous query. Provide this with updates to ensure the metafield is modified safely.
+	CompareDigest *string `json:"compareDigest,omitempty"`
+	// The type of data that is stored in the metafield.
+	// The type must be one of the [supported types](https://shopify.dev/apps/metafields/types).
+	//
+	// Required when there is no corresponding definition for the given `namespace`, `key`, and
+	// owner resource type (derived from `ownerId`).
+	Type string `json:"type,omitempty"`
+}
+
+// GetOwnerId returns MetafieldsSetInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *MetafieldsSetInput) GetOwnerId() string { return v.OwnerId }
+
+// GetNamespace returns MetafieldsSetInput.Namespace, and is useful for accessing the field via an interface.
+func (v *MetafieldsSetInput) GetNamespace() string { return v.Namespace }
+
+// GetKey returns MetafieldsSetInput.Key, and is useful for accessing the field via an interface.
+func (v *MetafieldsSetInput) GetKey() string { return v.Key }
+
+// GetValue returns MetafieldsSetInput.Value, and is useful for accessing the field via an interface.
+func (v *MetafieldsSetInput) GetValue() string { return v.Value }
+
+// GetCompareDigest returns MetafieldsSetInput.CompareDigest, and is useful for accessing the field via an interface.
+func (v *MetafieldsSetInput) GetCompareDigest() *string { return v.CompareDigest }
+
+// GetType returns MetafieldsSetInput.Type, and is useful for accessing the field via an interface.
+func (v *MetafieldsSetInput) GetType() string { return v.Type }
+
+// Possible error codes that can be returned by `MetafieldsSetUserError`.
+type MetafieldsSetUserErrorCode string
+
+const (
+	// The metafield violates a capability restriction.
+	MetafieldsSetUserErrorCodeCapabilityViolation MetafieldsSetUserErrorCode = "CAPABILITY_VIOLATION"
+	// The metafield has been modified since it was loaded.
+	MetafieldsSetUserErrorCodeStaleObject MetafieldsSetUserErrorCode = "STALE_OBJECT"
+	// The compareDigest is invalid.
+	MetafieldsSetUserErrorCodeInvalidCompareDigest MetafieldsSetUserErrorCode = "INVALID_COMPARE_DIGEST"
+	// The type is invalid.
+	MetafieldsSetUserErrorCodeInvalidType MetafieldsSetUserErrorCode = "INVALID_TYPE"
+	// The value is invalid for the metafield type or for the definition options.
+	MetafieldsSetUserErrorCodeInvalidValue MetafieldsSetUserErrorCode = "INVALID_VALUE"
+	// ApiPermission metafields can only be created or updated by the app owner.
+	MetafieldsSetUserErrorCodeAppNotAuthorized MetafieldsSetUserErrorCode = "APP_NOT_AUTHORIZED"
+	// The input value isn't included in the list.
+	MetafieldsSetUserErrorCodeInclusion MetafieldsSetUserErrorCode = "INCLUSION"
+	// The input value is already taken.
+	MetafieldsSetUserErrorCodeTaken MetafieldsSetUserErrorCode = "TAKEN"
+	// The input value needs to be blank.
+	MetafieldsSetUserErrorCodePresent MetafieldsSetUserErrorCode = "PRESENT"
+	// The input value is blank.
+	MetafieldsSetUserErrorCodeBlank MetafieldsSetUserErrorCode = "BLANK"
+	// The input value is too long.
+	MetafieldsSetUserErrorCodeTooLong MetafieldsSetUserErrorCode = "TOO_LONG"
+	// The input value is too short.
+	MetafieldsSetUserErrorCodeTooShort MetafieldsSetUserErrorCode = "TOO_SHORT"
+	// The input value should be less than or equal to the maximum value allowed.
+	MetafieldsSetUserErrorCodeLessThanOrEqualTo MetafieldsSetUserErrorCode = "LESS_THAN_OR_EQUAL_TO"
+	// An internal error occurred.
+	MetafieldsSetUserErrorCodeInternalError MetafieldsSetUserErrorCode = "INTERNAL_ERROR"
+)
+
+var AllMetafieldsSetUserErrorCode = []MetafieldsSetUserErrorCode{
+	MetafieldsSetUserErrorCodeCapabilityViolation,
+	MetafieldsSetUserErrorCodeStaleObject,
+	MetafieldsSetUserErrorCodeInvalidCompareDigest,
+	MetafieldsSetUserErrorCodeInvalidType,
+	MetafieldsSetUserErrorCodeInvalidValue,
+	MetafieldsSetUserErrorCodeAppNotAuthorized,
+	MetafieldsSetUserErrorCodeInclusion,
+	MetafieldsSetUserErrorCodeTaken,
+	MetafieldsSetUserErrorCodePresent,
+	MetafieldsSetUserErrorCodeBlank,
+	MetafieldsSetUserErrorCodeTooLong,
+	MetafieldsSetUserErrorCodeTooShort,
+	MetafieldsSetUserErrorCodeLessThanOrEqualTo,
+	MetafieldsSetUserErrorCodeInternalError,
+}
+
+// Metaobject access permissions for the Admin API. When the metaobject is app-owned, the owning app always has
+// full access.
+type MetaobjectAdminAccess string
+
+const (
+	// The merchant and other apps have no access.
+	MetaobjectAdminAccessPrivate MetaobjectAdminAccess = "PRIVATE"
+	// The merchant has read-only access. No other apps have access.
+	MetaobjectAdminAccessMerchantRead MetaobjectAdminAccess = "MERCHANT_READ"
+	// The merchant has read and write access. No other apps have access.
+	MetaobjectAdminAccessMerchantReadWrite MetaobjectAdminAccess = "MERCHANT_READ_WRITE"
+	// The merchant and other apps have read-only access.
+	MetaobjectAdminAccessPublicRead MetaobjectAdminAccess = "PUBLIC_READ"
+	// The merchant and other apps have read and write access.
+	MetaobjectAdminAccessPublicReadWrite MetaobjectAdminAccess = "PUBLIC_READ_WRITE"
+)
+
+var AllMetaobjectAdminAccess = []MetaobjectAdminAccess{
+	MetaobjectAdminAccessPrivate,
+	MetaobjectAdminAccessMerchantRead,
+	MetaobjectAdminAccessMerchantReadWrite,
+	MetaobjectAdminAccessPublicRead,
+	MetaobjectAdminAccessPublicReadWrite,
+}
+
+// The input fields for creating a metaobject capability.
+type MetaobjectCapabilityCreateInput struct {
+	// The input for enabling the publishable capability.
+	Publishable MetaobjectCapabilityPublishableInput `json:"publishable"`
+	// The input for enabling the translatable capability.
+	Translatable MetaobjectCapabilityTranslatableInput `json:"translatable"`
+	// The input for enabling the renderable capability.
+	Renderable MetaobjectCapabilityRenderableInput `json:"renderable"`
+	// The input for enabling the Online Store capability.
+	OnlineStore MetaobjectCapabilityOnlineStoreInput `json:"onlineStore"`
+}
+
+// GetPublishable returns MetaobjectCapabilityCreateInput.Publishable, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityCreateInput) GetPublishable() MetaobjectCapabilityPublishableInput {
+	return v.Publishable
+}
+
+// GetTranslatable returns MetaobjectCapabilityCreateInput.Translatable, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityCreateInput) GetTranslatable() MetaobjectCapabilityTranslatableInput {
+	return v.Translatable
+}
+
+// GetRenderable returns MetaobjectCapabilityCreateInput.Renderable, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityCreateInput) GetRenderable() MetaobjectCapabilityRenderableInput {
+	return v.Renderable
+}
+
+// GetOnlineStore returns MetaobjectCapabilityCreateInput.OnlineStore, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityCreateInput) GetOnlineStore() MetaobjectCapabilityOnlineStoreInput {
+	return v.OnlineStore
+}
+
+// The input fields for metaobject capabilities.
+type MetaobjectCapabilityDataInput struct {
+	// Publishable capability input.
+	Publishable *MetaobjectCapabilityDataPublishableInput `json:"publishable,omitempty"`
+	// Online Store capability input.
+	OnlineStore *MetaobjectCapabilityDataOnlineStoreInput `json:"onlineStore,omitempty"`
+}
+
+// GetPublishable returns MetaobjectCapabilityDataInput.Publishable, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityDataInput) GetPublishable() *MetaobjectCapabilityDataPublishableInput {
+	return v.Publishable
+}
+
+// GetOnlineStore returns MetaobjectCapabilityDataInput.OnlineStore, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityDataInput) GetOnlineStore() *MetaobjectCapabilityDataOnlineStoreInput {
+	return v.OnlineStore
+}
+
+// The input fields for the Online Store capability to control renderability on the Online Store.
+type MetaobjectCapabilityDataOnlineStoreInput struct {
+	// The theme template used when viewing the metaobject in a store.
+	TemplateSuffix string `json:"templateSuffix"`
+}
+
+// GetTemplateSuffix returns MetaobjectCapabilityDataOnlineStoreInput.TemplateSuffix, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityDataOnlineStoreInput) GetTemplateSuffix() string {
+	return v.TemplateSuffix
+}
+
+// The input fields for publishable capability to adjust visibility on channels.
+type MetaobjectCapabilityDataPublishableInput struct {
+	// The visibility status of this metaobject across all channels.
+	Status MetaobjectStatus `json:"status"`
+}
+
+// GetStatus returns MetaobjectCapabilityDataPublishableInput.Status, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityDataPublishableInput) GetStatus() MetaobjectStatus { return v.Status }
+
+// The input fields of the Online Store capability.
+type MetaobjectCapabilityDefinitionDataOnlineStoreInput struct {
+	// The URL handle for accessing pages of this metaobject type in the Online Store.
+	UrlHandle string `json:"urlHandle"`
+	// Whether to redirect published metaobjects automatically when the URL handle changes.
+	CreateRedirects bool `json:"createRedirects"`
+}
+
+// GetUrlHandle returns MetaobjectCapabilityDefinitionDataOnlineStoreInput.UrlHandle, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityDefinitionDataOnlineStoreInput) GetUrlHandle() string {
+	return v.UrlHandle
+}
+
+// GetCreateRedirects returns MetaobjectCapabilityDefinitionDataOnlineStoreInput.CreateRedirects, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityDefinitionDataOnlineStoreInput) GetCreateRedirects() bool {
+	return v.CreateRedirects
+}
+
+// The input fields of the renderable capability for SEO aliases.
+type MetaobjectCapabilityDefinitionDataRenderableInput struct {
+	// The metaobject field used as an alias for the SEO page title.
+	MetaTitleKey string `json:"metaTitleKey"`
+	// The metaobject field used as an alias for the SEO page description.
+	MetaDescriptionKey string `json:"metaDescriptionKey"`
+}
+
+// GetMetaTitleKey returns MetaobjectCapabilityDefinitionDataRenderableInput.MetaTitleKey, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityDefinitionDataRenderableInput) GetMetaTitleKey() string {
+	return v.MetaTitleKey
+}
+
+// GetMetaDescriptionKey returns MetaobjectCapabilityDefinitionDataRenderableInput.MetaDescriptionKey, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityDefinitionDataRenderableInput) GetMetaDescriptionKey() string {
+	return v.MetaDescriptionKey
+}
+
+// The input fields for enabling and disabling the Online Store capability.
+type MetaobjectCapabilityOnlineStoreInput struct {
+	// Indicates whether the capability should be enabled or disabled.
+	Enabled bool `json:"enabled"`
+	// The data associated with the Online Store capability.
+	Data MetaobjectCapabilityDefinitionDataOnlineStoreInput `json:"data"`
+}
+
+// GetEnabled returns MetaobjectCapabilityOnlineStoreInput.Enabled, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityOnlineStoreInput) GetEnabled() bool { return v.Enabled }
+
+// GetData returns MetaobjectCapabilityOnlineStoreInput.Data, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityOnlineStoreInput) GetData() MetaobjectCapabilityDefinitionDataOnlineStoreInput {
+	return v.Data
+}
+
+// The input fields for enabling and disabling the publishable capability.
+type MetaobjectCapabilityPublishableInput struct {
+	// Indicates whether the capability should be enabled or disabled.
+	Enabled bool `json:"enabled"`
+}
+
+// GetEnabled returns MetaobjectCapabilityPublishableInput.Enabled, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityPublishableInput) GetEnabled() bool { return v.Enabled }
+
+// The input fields for enabling and disabling the renderable capability.
+type MetaobjectCapabilityRenderableInput struct {
+	// Indicates whether the capability should be enabled or disabled.
+	Enabled bool `json:"enabled"`
+	// The data associated with the renderable capability.
+	Data MetaobjectCapabilityDefinitionDataRenderableInput `json:"data"`
+}
+
+// GetEnabled returns MetaobjectCapabilityRenderableInput.Enabled, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityRenderableInput) GetEnabled() bool { return v.Enabled }
+
+// GetData returns MetaobjectCapabilityRenderableInput.Data, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityRenderableInput) GetData() MetaobjectCapabilityDefinitionDataRenderableInput {
+	return v.Data
+}
+
+// The input fields for enabling and disabling the translatable capability.
+type MetaobjectCapabilityTranslatableInput struct {
+	// Indicates whether the capability should be enabled or disabled.
+	Enabled bool `json:"enabled"`
+}
+
+// GetEnabled returns MetaobjectCapabilityTranslatableInput.Enabled, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityTranslatableInput) GetEnabled() bool { return v.Enabled }
+
+// The input fields for updating a metaobject capability.
+type MetaobjectCapabilityUpdateInput struct {
+	// The input for updating the publishable capability.
+	Publishable MetaobjectCapabilityPublishableInput `json:"publishable"`
+	// The input for updating the translatable capability.
+	Translatable MetaobjectCapabilityTranslatableInput `json:"translatable"`
+	// The input for enabling the renderable capability.
+	Renderable MetaobjectCapabilityRenderableInput `json:"renderable"`
+	// The input for enabling the Online Store capability.
+	OnlineStore MetaobjectCapabilityOnlineStoreInput `json:"onlineStore"`
+}
+
+// GetPublishable returns MetaobjectCapabilityUpdateInput.Publishable, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityUpdateInput) GetPublishable() MetaobjectCapabilityPublishableInput {
+	return v.Publishable
+}
+
+// GetTranslatable returns MetaobjectCapabilityUpdateInput.Translatable, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityUpdateInput) GetTranslatable() MetaobjectCapabilityTranslatableInput {
+	return v.Translatable
+}
+
+// GetRenderable returns MetaobjectCapabilityUpdateInput.Renderable, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityUpdateInput) GetRenderable() MetaobjectCapabilityRenderableInput {
+	return v.Renderable
+}
+
+// GetOnlineStore returns MetaobjectCapabilityUpdateInput.OnlineStore, and is useful for accessing the field via an interface.
+func (v *MetaobjectCapabilityUpdateInput) GetOnlineStore() MetaobjectCapabilityOnlineStoreInput {
+	return v.OnlineStore
+}
+
+// The input fields for creating a metaobject definition.
+type MetaobjectDefinitionCreateInput struct {
+	// A human-readable name for the definition. This can be changed at any time.
+	Name string `json:"name,omitempty"`
+	// An administrative description of the definition.
+	Description string `json:"description"`
+	// The type of the metaobject definition. This can't be changed.
+	//
+	// Must be 3-255 characters long and only contain alphanumeric, hyphen, and underscore characters.
+	Type string `json:"type"`
+	// A set of field definitions to create on this metaobject definition.
+	FieldDefinitions []MetaobjectFieldDefinitionCreateInput `json:"fieldDefinitions"`
+	// Access configuration for the metaobjects created with this definition.
+	Access CustomMetaobjectAccessInput `json:"access"`
+	// The key of a field to reference as the display name for metaobjects of this type.
+	DisplayNameKey string `json:"displayNameKey,omitempty"`
+	// The capabilities of the metaobject definition.
+	Capabilities MetaobjectCapabilityCreateInput `json:"capabilities"`
+}
+
+// GetName returns MetaobjectDefinitionCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionCreateInput) GetName() string { return v.Name }
+
+// GetDescription returns MetaobjectDefinitionCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionCreateInput) GetDescription() string { return v.Description }
+
+// GetType returns MetaobjectDefinitionCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionCreateInput) GetType() string { return v.Type }
+
+// GetFieldDefinitions returns MetaobjectDefinitionCreateInput.FieldDefinitions, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionCreateInput) GetFieldDefinitions() []MetaobjectFieldDefinitionCreateInput {
+	return v.FieldDefinitions
+}
+
+// GetAccess returns MetaobjectDefinitionCreateInput.Access, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionCreateInput) GetAccess() CustomMetaobjectAccessInput { return v.Access }
+
+// GetDisplayNameKey returns MetaobjectDefinitionCreateInput.DisplayNameKey, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionCreateInput) GetDisplayNameKey() string { return v.DisplayNameKey }
+
+// GetCapabilities returns MetaobjectDefinitionCreateInput.Capabilities, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionCreateInput) GetCapabilities() MetaobjectCapabilityCreateInput {
+	return v.Capabilities
+}
+
+// The input fields for updating a metaobject definition.
+type MetaobjectDefinitionUpdateInput struct {
+	// A human-readable name for the definition.
+	Name string `json:"name,omitempty"`
+	// An administrative description of the definition.
+	Description string `json:"description"`
+	// A set of operations for modifying field definitions.
+	FieldDefinitions []CustomMetaobjectFieldDefinitionOperationInput `json:"fieldDefinitions"`
+	// Access configuration for the metaobjects created with this definition.
+	Access CustomMetaobjectAccessInput `json:"access"`
+	// The key of a metafield to reference as the display name for objects of this type.
+	DisplayNameKey string `json:"displayNameKey,omitempty"`
+	// Whether the field order should be reset while updating.
+	// If `true`, then the order is assigned based on submitted fields followed by alphabetized field omissions.
+	// If `false`, then no changes are made to the existing field order and new fields are appended at the end.
+	ResetFieldOrder bool `json:"resetFieldOrder"`
+	// The capabilities of the metaobject definition.
+	Capabilities MetaobjectCapabilityUpdateInput `json:"capabilities"`
+}
+
+// GetName returns MetaobjectDefinitionUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionUpdateInput) GetName() string { return v.Name }
+
+// GetDescription returns MetaobjectDefinitionUpdateInput.Description, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionUpdateInput) GetDescription() string { return v.Description }
+
+// GetFieldDefinitions returns MetaobjectDefinitionUpdateInput.FieldDefinitions, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionUpdateInput) GetFieldDefinitions() []CustomMetaobjectFieldDefinitionOperationInput {
+	return v.FieldDefinitions
+}
+
+// GetAccess returns MetaobjectDefinitionUpdateInput.Access, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionUpdateInput) GetAccess() CustomMetaobjectAccessInput { return v.Access }
+
+// GetDisplayNameKey returns MetaobjectDefinitionUpdateInput.DisplayNameKey, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionUpdateInput) GetDisplayNameKey() string { return v.DisplayNameKey }
+
+// GetResetFieldOrder returns MetaobjectDefinitionUpdateInput.ResetFieldOrder, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionUpdateInput) GetResetFieldOrder() bool { return v.ResetFieldOrder }
+
+// GetCapabilities returns MetaobjectDefinitionUpdateInput.Capabilities, and is useful for accessing the field via an interface.
+func (v *MetaobjectDefinitionUpdateInput) GetCapabilities() MetaobjectCapabilityUpdateInput {
+	return v.Capabilities
+}
+
+// The input fields for creating a metaobject field definition.
+type MetaobjectFieldDefinitionCreateInput struct {
+	// The key of the new field definition. This can't be changed.
+	//
+	// Must be 2-64 characters long and only contain alphanumeric, hyphen, and underscore characters.
+	Key string `json:"key"`
+	// The metafield type applied to values of the field.
+	Type string `json:"type"`
+	// A human-readable name for the field. This can be changed at any time.
+	Name string `json:"name"`
+	// An administrative description of the field.
+	Description string `json:"description"`
+	// Whether metaobjects require a saved value for the field.
+	Required bool `json:"required"`
+	// Custom validations that apply to values assigned to the field.
+	Validations []MetafieldDefinitionValidationInput `json:"validations"`
+}
+
+// GetKey returns MetaobjectFieldDefinitionCreateInput.Key, and is useful for accessing the field via an interface.
+func (v *MetaobjectFieldDefinitionCreateInput) GetKey() string { return v.Key }
+
+// GetType returns MetaobjectFieldDefinitionCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *MetaobjectFieldDefinitionCreateInput) GetType() string { return v.Type }
+
+// GetName returns MetaobjectFieldDefinitionCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *MetaobjectFieldDefinitionCreateInput) GetName() string { return v.Name }
+
+// GetDescription returns MetaobjectFieldDefinitionCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *MetaobjectFieldDefinitionCreateInput) GetDescription() string { return v.Description }
+
+// GetRequired returns MetaobjectFieldDefinitionCreateInput.Required, and is useful for accessing the field via an interface.
+func (v *MetaobjectFieldDefinitionCreateInput) GetRequired() bool { return v.Required }
+
+// GetValidations returns MetaobjectFieldDefinitionCreateInput.Validations, and is useful for accessing the field via an interface.
+func (v *MetaobjectFieldDefinitionCreateInput) GetValidations() []MetafieldDefinitionValidationInput {
+	return v.Validations
+}
+
+// The input fields for a metaobject field value.
+type MetaobjectFieldInput struct {
+	// The key of the field.
+	Key string `json:"key"`
+	// The value of the field.
+	Value string `json:"value"`
+}
+
+// GetKey returns MetaobjectFieldInput.Key, and is useful for accessing the field via an interface.
+func (v *MetaobjectFieldInput) GetKey() string { return v.Key }
+
+// GetValue returns MetaobjectFieldInput.Value, and is useful for accessing the field via an interface.
+func (v *MetaobjectFieldInput) GetValue() string { return v.Value }
+
+// The input fields for retrieving a metaobject by handle.
+type MetaobjectHandleInput struct {
+	// The type of the metaobject. Must match an existing metaobject definition type.
+	Type string `json:"type"`
+	// The handle of the metaobject to create or update.
+	Handle string `json:"handle"`
+}
+
+// GetType returns MetaobjectHandleInput.Type, and is useful for accessing the field via an interface.
+func (v *MetaobjectHandleInput) GetType() string { return v.Type }
+
+// GetHandle returns MetaobjectHandleInput.Handle, and is useful for accessing the field via an interface.
+func (v *MetaobjectHandleInput) GetHandle() string { return v.Handle }
+
+// Defines visibility status for metaobjects.
+type MetaobjectStatus string
+
+const (
+	// The metaobjects is an internal record.
+	MetaobjectStatusDraft MetaobjectStatus = "DRAFT"
+	// The metaobjects is active for public use.
+	MetaobjectStatusActive MetaobjectStatus = "ACTIVE"
+)
+
+var AllMetaobjectStatus = []MetaobjectStatus{
+	MetaobjectStatusDraft,
+	MetaobjectStatusActive,
+}
+
+// Metaobject access permissions for the Storefront API.
+type MetaobjectStorefrontAccess string
+
+const (
+	// No access.
+	MetaobjectStorefrontAccessNone MetaobjectStorefrontAccess = "NONE"
+	// Read-only access.
+	MetaobjectStorefrontAccessPublicRead MetaobjectStorefrontAccess = "PUBLIC_READ"
+)
+
+var AllMetaobjectStorefrontAccess = []MetaobjectStorefrontAccess{
+	MetaobjectStorefrontAccessNone,
+	MetaobjectStorefrontAccessPublicRead,
+}
+
+// The input fields for updating a metaobject.
+type MetaobjectUpdateInput struct {
+	// A unique handle for the metaobject.
+	Handle string `json:"handle,omitempty"`
+	// Values for fields. These are mapped by key to fields of the metaobject definition.
+	Fields []MetaobjectFieldInput `json:"fields,omitempty"`
+	// Capabilities for the metaobject.
+	Capabilities *MetaobjectCapabilityDataInput `json:"capabilities,omitempty"`
+	// Whether to create a redirect for the metaobject.
+	RedirectNewHandle bool `json:"redirectNewHandle,omitempty"`
+}
+
+// GetHandle returns MetaobjectUpdateInput.Handle, and is useful for accessing the field via an interface.
+func (v *MetaobjectUpdateInput) GetHandle() string { return v.Handle }
+
+// GetFields returns MetaobjectUpdateInput.Fields, and is useful for accessing the field via an interface.
+func (v *MetaobjectUpdateInput) GetFields() []MetaobjectFieldInput { return v.Fields }
+
+// GetCapabilities returns MetaobjectUpdateInput.Capabilities, and is useful for accessing the field via an interface.
+func (v *MetaobjectUpdateInput) GetCapabilities() *MetaobjectCapabilityDataInput {
+	return v.Capabilities
+}
+
+// GetRedirectNewHandle returns MetaobjectUpdateInput.RedirectNewHandle, and is useful for accessing the field via an interface.
+func (v *MetaobjectUpdateInput) GetRedirectNewHandle() bool { return v.RedirectNewHandle }
+
+// The input fields for upserting a metaobject.
+type MetaobjectUpsertInput struct {
+	// The handle of the metaobject.
+	Handle string `json:"handle,omitempty"`
+	// Values for fields. These are mapped by key to fields of the metaobject definition.
+	Fields []MetaobjectFieldInput `json:"fields"`
+	// Capabilities for the metaobject.
+	Capabilities *MetaobjectCapabilityDataInput `json:"capabilities,omitempty"`
+}
+
+// GetHandle returns MetaobjectUpsertInput.Handle, and is useful for accessing the field via an interface.
+func (v *MetaobjectUpsertInput) GetHandle() string { return v.Handle }
+
+// GetFields returns MetaobjectUpsertInput.Fields, and is useful for accessing the field via an interface.
+func (v *MetaobjectUpsertInput) GetFields() []MetaobjectFieldInput { return v.Fields }
+
+// GetCapabilities returns MetaobjectUpsertInput.Capabilities, and is useful for accessing the field via an interface.
+func (v *MetaobjectUpsertInput) GetCapabilities() *MetaobjectCapabilityDataInput {
+	return v.Capabilities
+}
+
+// Possible error codes that can be returned by `MetaobjectUserError`.
+type MetaobjectUserErrorCode string
+
+const (
+	// The input value is invalid.
+	MetaobjectUserErrorCodeInvalid MetaobjectUserErrorCode = "INVALID"
+	// The input value isn't included in the list.
+	MetaobjectUserErrorCodeInclusion MetaobjectUserErrorCode = "INCLUSION"
+	// The input value is already taken.
+	MetaobjectUserErrorCodeTaken MetaobjectUserErrorCode = "TAKEN"
+	// The input value is too long.
+	MetaobjectUserErrorCodeTooLong MetaobjectUserErrorCode = "TOO_LONG"
+	// The input value is too short.
+	MetaobjectUserErrorCodeTooShort MetaobjectUserErrorCode = "TOO_SHORT"
+	// The input value needs to be blank.
+	MetaobjectUserErrorCodePresent MetaobjectUserErrorCode = "PRESENT"
+	// The input value is blank.
+	MetaobjectUserErrorCodeBlank MetaobjectUserErrorCode = "BLANK"
+	// The metafield type is invalid.
+	MetaobjectUserErrorCodeInvalidType MetaobjectUserErrorCode = "INVALID_TYPE"
+	// The value is invalid for the metafield type or the definition options.
+	MetaobjectUserErrorCodeInvalidValue MetaobjectUserErrorCode = "INVALID_VALUE"
+	// The value for the metafield definition option was invalid.
+	MetaobjectUserErrorCodeInvalidOption MetaobjectUserErrorCode = "INVALID_OPTION"
+	// Duplicate inputs were provided for this field key.
+	MetaobjectUserErrorCodeDuplicateFieldInput MetaobjectUserErrorCode = "DUPLICATE_FIELD_INPUT"
+	// No metaobject definition found for this type.
+	MetaobjectUserErrorCodeUndefinedObjectType MetaobjectUserErrorCode = "UNDEFINED_OBJECT_TYPE"
+	// No field definition found for this key.
+	MetaobjectUserErrorCodeUndefinedObjectField MetaobjectUserErrorCode = "UNDEFINED_OBJECT_FIELD"
+	// The specified field key is already in use.
+	MetaobjectUserErrorCodeObjectFieldTaken MetaobjectUserErrorCode = "OBJECT_FIELD_TAKEN"
+	// Missing required fields were found for this object.
+	MetaobjectUserErrorCodeObjectFieldRequired MetaobjectUserErrorCode = "OBJECT_FIELD_REQUIRED"
+	// The requested record couldn't be found.
+	MetaobjectUserErrorCodeRecordNotFound MetaobjectUserErrorCode = "RECORD_NOT_FOUND"
+	// An unexpected error occurred.
+	MetaobjectUserErrorCodeInternalError MetaobjectUserErrorCode = "INTERNAL_ERROR"
+	// The maximum number of metaobjects definitions has been exceeded.
+	MetaobjectUserErrorCodeMaxDefinitionsExceeded MetaobjectUserErrorCode = "MAX_DEFINITIONS_EXCEEDED"
+	// The maximum number of metaobjects per shop has been exceeded.
+	MetaobjectUserErrorCodeMaxObjectsExceeded MetaobjectUserErrorCode = "MAX_OBJECTS_EXCEEDED"
+	// The targeted object cannot be modified.
+	MetaobjectUserErrorCodeImmutable MetaobjectUserErrorCode = "IMMUTABLE"
+	// Not authorized.
+	MetaobjectUserErrorCodeNotAuthorized MetaobjectUserErrorCode = "NOT_AUTHORIZED"
+	// The provided name is reserved for system use.
+	MetaobjectUserErrorCodeReservedName MetaobjectUserErrorCode = "RESERVED_NAME"
+	// The display name cannot be the same when using the metaobject as a product option.
+	MetaobjectUserErrorCodeDisplayNameConflict MetaobjectUserErrorCode = "DISPLAY_NAME_CONFLICT"
+	// Admin access can only be specified on metaobject definitions that have an app-reserved type.
+	MetaobjectUserErrorCodeAdminAccessInputNotAllowed MetaobjectUserErrorCode = "ADMIN_ACCESS_INPUT_NOT_ALLOWED"
+	// The capability you are using is not enabled.
+	MetaobjectUserErrorCodeCapabilityNotEnabled MetaobjectUserErrorCode = "CAPABILITY_NOT_ENABLED"
+	// The Online Store URL handle is already taken.
+	MetaobjectUserErrorCodeUrlHandleTaken MetaobjectUserErrorCode = "URL_HANDLE_TAKEN"
+	// The Online Store URL handle is invalid.
+	MetaobjectUserErrorCodeUrlHandleInvalid MetaobjectUserErrorCode = "URL_HANDLE_INVALID"
+	// The Online Store URL handle cannot be blank.
+	MetaobjectUserErrorCodeUrlHandleBlank MetaobjectUserErrorCode = "URL_HANDLE_BLANK"
+	// Renderable data input is referencing an invalid field.
+	MetaobjectUserErrorCodeFieldTypeInvalid MetaobjectUserErrorCode = "FIELD_TYPE_INVALID"
+	// The input is missing required keys.
+	MetaobjectUserErrorCodeMissingRequiredKeys MetaobjectUserErrorCode = "MISSING_REQUIRED_KEYS"
+	// The action cannot be completed because associated metaobjects are referenced by another resource.
+	MetaobjectUserErrorCodeReferenceExistsError MetaobjectUserErrorCode = "REFERENCE_EXISTS_ERROR"
+)
+
+var AllMetaobjectUserErrorCode = []MetaobjectUserErrorCode{
+	MetaobjectUserErrorCodeInvalid,
+	MetaobjectUserErrorCodeInclusion,
+	MetaobjectUserErrorCodeTaken,
+	MetaobjectUserErrorCodeTooLong,
+	MetaobjectUserErrorCodeTooShort,
+	MetaobjectUserErrorCodePresent,
+	MetaobjectUserErrorCodeBlank,
+	MetaobjectUserErrorCodeInvalidType,
+	MetaobjectUserErrorCodeInvalidValue,
+	MetaobjectUserErrorCodeInvalidOption,
+	MetaobjectUserErrorCodeDuplicateFieldInput,
+	MetaobjectUserErrorCodeUndefinedObjectType,
+	MetaobjectUserErrorCodeUndefinedObjectField,
+	MetaobjectUserErrorCodeObjectFieldTaken,
+	MetaobjectUserErrorCodeObjectFieldRequired,
+	MetaobjectUserErrorCodeRecordNotFound,
+	MetaobjectUserErrorCodeInternalError,
+	MetaobjectUserErrorCodeMaxDefinitionsExceeded,
+	MetaobjectUserErrorCodeMaxObjectsExceeded,
+	MetaobjectUserErrorCodeImmutable,
+	MetaobjectUserErrorCodeNotAuthorized,
+	MetaobjectUserErrorCodeReservedName,
+	MetaobjectUserErrorCodeDisplayNameConflict,
+	MetaobjectUserErrorCodeAdminAccessInputNotAllowed,
+	MetaobjectUserErrorCodeCapabilityNotEnabled,
+	MetaobjectUserErrorCodeUrlHandleTaken,
+	MetaobjectUserErrorCodeUrlHandleInvalid,
+	MetaobjectUserErrorCodeUrlHandleBlank,
+	MetaobjectUserErrorCodeFieldTypeInvalid,
+	MetaobjectUserErrorCodeMissingRequiredKeys,
+	MetaobjectUserErrorCodeReferenceExistsError,
+}
+
+// PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayload includes the requested fields of the GraphQL type MetafieldDefinitionPinPayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metafieldDefinitionPin` mutation.
+type PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayload struct {
+	// The metafield definition that was pinned.
+	PinnedDefinition PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadPinnedDefinitionMetafieldDefinition `json:"pinnedDefinition"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError `json:"userErrors"`
+}
+
+// GetPinnedDefinition returns PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayload.PinnedDefinition, and is useful for accessing the field via an interface.
+func (v *PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayload) GetPinnedDefinition() PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadPinnedDefinitionMetafieldDefinition {
+	return v.PinnedDefinition
+}
+
+// GetUserErrors returns PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayload) GetUserErrors() []PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError {
+	return v.UserErrors
+}
+
+// PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadPinnedDefinitionMetafieldDefinition includes the requested fields of the GraphQL type MetafieldDefinition.
+// The GraphQL type's documentation follows.
+//
+// Metafield definitions enable you to define additional validation constraints for metafields, and enable the
+// merchant to edit metafield values in context.
+type PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadPinnedDefinitionMetafieldDefinition struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadPinnedDefinitionMetafieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadPinnedDefinitionMetafieldDefinition) GetId() string {
+	return v.Id
+}
+
+// PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError includes the requested fields of the GraphQL type MetafieldDefinitionPinUserError.
+// The GraphQL type's documentation follows.
+//
+// An error that occurs during the execution of `MetafieldDefinitionPin`.
+type PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetafieldDefinitionPinUserErrorCode `json:"code"`
+}
+
+// GetField returns PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError.Field, and is useful for accessing the field via an interface.
+func (v *PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError.Message, and is useful for accessing the field via an interface.
+func (v *PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError.Code, and is useful for accessing the field via an interface.
+func (v *PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayloadUserErrorsMetafieldDefinitionPinUserError) GetCode() MetafieldDefinitionPinUserErrorCode {
+	return v.Code
+}
+
+// PinMetafieldDefinitionResponse is returned by PinMetafieldDefinition on success.
+type PinMetafieldDefinitionResponse struct {
+	// You can organize your metafields in your Shopify admin by pinning/unpinning metafield definitions.
+	// The order of your pinned metafield definitions determines the order in which your metafields are displayed
+	// on the corresponding pages in your Shopify admin. By default, only pinned metafields are automatically displayed.
+	MetafieldDefinitionPin PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayload `json:"metafieldDefinitionPin"`
+}
+
+// GetMetafieldDefinitionPin returns PinMetafieldDefinitionResponse.MetafieldDefinitionPin, and is useful for accessing the field via an interface.
+func (v *PinMetafieldDefinitionResponse) GetMetafieldDefinitionPin() PinMetafieldDefinitionMetafieldDefinitionPinMetafieldDefinitionPinPayload {
+	return v.MetafieldDefinitionPin
+}
+
+// RegisterTranslationsResponse is returned by RegisterTranslations on success.
+type RegisterTranslationsResponse struct {
+	// Creates or updates translations.
+	TranslationsRegister RegisterTranslationsTranslationsRegisterTranslationsRegisterPayload `json:"translationsRegister"`
+}
+
+// GetTranslationsRegister returns RegisterTranslationsResponse.TranslationsRegister, and is useful for accessing the field via an interface.
+func (v *RegisterTranslationsResponse) GetTranslationsRegister() RegisterTranslationsTranslationsRegisterTranslationsRegisterPayload {
+	return v.TranslationsRegister
+}
+
+// RegisterTranslationsTranslationsRegisterTranslationsRegisterPayload includes the requested fields of the GraphQL type TranslationsRegisterPayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `translationsRegister` mutation.
+type RegisterTranslationsTranslationsRegisterTranslationsRegisterPayload struct {
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError `json:"userErrors"`
+}
+
+// GetUserErrors returns RegisterTranslationsTranslationsRegisterTranslationsRegisterPayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *RegisterTranslationsTranslationsRegisterTranslationsRegisterPayload) GetUserErrors() []RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError {
+	return v.UserErrors
+}
+
+// RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError includes the requested fields of the GraphQL type TranslationUserError.
+// The GraphQL type's documentation follows.
+//
+// Represents an error that happens during the execution of a translation mutation.
+type RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code TranslationErrorCode `json:"code"`
+}
+
+// GetField returns RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError.Field, and is useful for accessing the field via an interface.
+func (v *RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError.Message, and is useful for accessing the field via an interface.
+func (v *RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError.Code, and is useful for accessing the field via an interface.
+func (v *RegisterTranslationsTranslationsRegisterTranslationsRegisterPayloadUserErrorsTranslationUserError) GetCode() TranslationErrorCode {
+	return v.Code
+}
+
+// RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayload includes the requested fields of the GraphQL type BulkOperationRunMutationPayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `bulkOperationRunMutation` mutation.
+type RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayload struct {
+	// The newly created bulk operation.
+	BulkOperation Cli_BulkOperation `json:"bulkOperation"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError `json:"userErrors"`
+}
+
+// GetBulkOperation returns RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayload.BulkOperation, and is useful for accessing the field via an interface.
+func (v *RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayload) GetBulkOperation() Cli_BulkOperation {
+	return v.BulkOperation
+}
+
+// GetUserErrors returns RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayload) GetUserErrors() []RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError {
+	return v.UserErrors
+}
+
+// RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError includes the requested fields of the GraphQL type BulkMutationUserError.
+// The GraphQL type's documentation follows.
+//
+// Represents an error that happens during execution of a bulk mutation.
+type RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code BulkMutationErrorCode `json:"code"`
+}
+
+// GetField returns RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError.Field, and is useful for accessing the field via an interface.
+func (v *RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError.Message, and is useful for accessing the field via an interface.
+func (v *RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError.Code, and is useful for accessing the field via an interface.
+func (v *RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayloadUserErrorsBulkMutationUserError) GetCode() BulkMutationErrorCode {
+	return v.Code
+}
+
+// RunBulkMutationResponse is returned by RunBulkMutation on success.
+type RunBulkMutationResponse struct {
+	// Creates and runs a bulk operation mutation.
+	//
+	// To learn how to bulk import large volumes of data asynchronously, refer to the
+	// [bulk import data guide](https://shopify.dev/api/usage/bulk-operations/imports).
+	BulkOperationRunMutation RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayload `json:"bulkOperationRunMutation"`
+}
+
+// GetBulkOperationRunMutation returns RunBulkMutationResponse.BulkOperationRunMutation, and is useful for accessing the field via an interface.
+func (v *RunBulkMutationResponse) GetBulkOperationRunMutation() RunBulkMutationBulkOperationRunMutationBulkOperationRunMutationPayload {
+	return v.BulkOperationRunMutation
+}
+
+// SearchTaxonomyCategoriesResponse is returned by SearchTaxonomyCategories on success.
+type SearchTaxonomyCategoriesResponse struct {
+	// The Taxonomy resource lets you access the categories, attributes and values of the loaded taxonomy tree.
+	Taxonomy SearchTaxonomyCategoriesTaxonomy `json:"taxonomy"`
+}
+
+// GetTaxonomy returns SearchTaxonomyCategoriesResponse.Taxonomy, and is useful for accessing the field via an interface.
+func (v *SearchTaxonomyCategoriesResponse) GetTaxonomy() SearchTaxonomyCategoriesTaxonomy {
+	return v.Taxonomy
+}
+
+// SearchTaxonomyCategoriesTaxonomy includes the requested fields of the GraphQL type Taxonomy.
+// The GraphQL type's documentation follows.
+//
+// The Taxonomy resource lets you access the categories, attributes and values of a taxonomy tree.
+type SearchTaxonomyCategoriesTaxonomy struct {
+	// Returns the categories of the product taxonomy based on the arguments provided.
+	// If a `search` argument is provided, then all categories that match the search query globally are returned.
+	// If a `children_of` argument is provided, then all children of the specified category are returned.
+	// If a `siblings_of` argument is provided, then all siblings of the specified category are returned.
+	// If a `decendents_of` argument is provided, then all descendents of the specified category are returned.
+	// If no arguments are provided, then all the top-level categories of the taxonomy are returned.
+	Categories SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnection `json:"categories"`
+}
+
+// GetCategories returns SearchTaxonomyCategoriesTaxonomy.Categories, and is useful for accessing the field via an interface.
+func (v *SearchTaxonomyCategoriesTaxonomy) GetCategories() SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnection {
+	return v.Categories
+}
+
+// SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnection includes the requested fields of the GraphQL type TaxonomyCategoryConnection.
+// The GraphQL type's documentation follows.
+//
+// An auto-generated type for paginating through multiple TaxonomyCategories.
+type SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnection struct {
+	// A list of nodes that are contained in TaxonomyCategoryEdge. You can fetch data about an individual node, or you can follow the edges to fetch data about a collection of related nodes. At each node, you specify the fields that you want to retrieve.
+	Nodes []SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory `json:"nodes"`
+}
+
+// GetNodes returns SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnection) GetNodes() []SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory {
+	return v.Nodes
+}
+
+// SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory includes the requested fields of the GraphQL type TaxonomyCategory.
+// The GraphQL type's documentation follows.
+//
+// The details of a specific product category within the [Shopify product taxonomy](https://shopify.github.io/product-taxonomy/releases/unstable/?categoryId=sg-4-17-2-17).
+type SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory struct {
+	// The globally-unique ID of the TaxonomyCategory.
+	Id string `json:"id"`
+	// The name of the taxonomy category. For example, Dog Beds.
+	Name string `json:"name"`
+	// The full name of the taxonomy category. For example, Animals & Pet Supplies > Pet Supplies > Dog Supplies > Dog Beds.
+	FullName string `json:"fullName"`
+}
+
+// GetId returns SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory.Id, and is useful for accessing the field via an interface.
+func (v *SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory) GetId() string {
+	return v.Id
+}
+
+// GetName returns SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory.Name, and is useful for accessing the field via an interface.
+func (v *SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory) GetName() string {
+	return v.Name
+}
+
+// GetFullName returns SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory.FullName, and is useful for accessing the field via an interface.
+func (v *SearchTaxonomyCategoriesTaxonomyCategoriesTaxonomyCategoryConnectionNodesTaxonomyCategory) GetFullName() string {
+	return v.FullName
+}
+
+// SetMetafieldsMetafieldsSetMetafieldsSetPayload includes the requested fields of the GraphQL type MetafieldsSetPayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metafieldsSet` mutation.
+type SetMetafieldsMetafieldsSetMetafieldsSetPayload struct {
+	// The list of metafields that were set.
+	Metafields []SetMetafieldsMetafieldsSetMetafieldsSetPayloadMetafieldsMetafield `json:"metafields"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError `json:"userErrors"`
+}
+
+// GetMetafields returns SetMetafieldsMetafieldsSetMetafieldsSetPayload.Metafields, and is useful for accessing the field via an interface.
+func (v *SetMetafieldsMetafieldsSetMetafieldsSetPayload) GetMetafields() []SetMetafieldsMetafieldsSetMetafieldsSetPayloadMetafieldsMetafield {
+	return v.Metafields
+}
+
+// GetUserErrors returns SetMetafieldsMetafieldsSetMetafieldsSetPayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *SetMetafieldsMetafieldsSetMetafieldsSetPayload) GetUserErrors() []SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError {
+	return v.UserErrors
+}
+
+// SetMetafieldsMetafieldsSetMetafieldsSetPayloadMetafieldsMetafield includes the requested fields of the GraphQL type Metafield.
+// The GraphQL type's documentation follows.
+//
+// Metafields enable you to attach additional information to a Shopify resource, such as a [Product](https://shopify.dev/api/admin-graphql/latest/objects/product) or a [Collection](https://shopify.dev/api/admin-graphql/latest/objects/collection).
+// For more information about where you can attach metafields refer to [HasMetafields](https://shopify.dev/api/admin/graphql/reference/common-objects/HasMetafields).
+// Some examples of the data that metafields enable you to store are specifications, size charts, downloadable documents, release dates, images, or part numbers.
+// Metafields are identified by an owner resource, namespace, and key. and store a value along with type information for that value.
+type SetMetafieldsMetafieldsSetMetafieldsSetPayloadMetafieldsMetafield struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns SetMetafieldsMetafieldsSetMetafieldsSetPayloadMetafieldsMetafield.Id, and is useful for accessing the field via an interface.
+func (v *SetMetafieldsMetafieldsSetMetafieldsSetPayloadMetafieldsMetafield) GetId() string {
+	return v.Id
+}
+
+// SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError includes the requested fields of the GraphQL type MetafieldsSetUserError.
+// The GraphQL type's documentation follows.
+//
+// An error that occurs during the execution of `MetafieldsSet`.
+type SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetafieldsSetUserErrorCode `json:"code"`
+	// The index of the array element that's causing the error.
+	ElementIndex int `json:"elementIndex"`
+}
+
+// GetField returns SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError.Field, and is useful for accessing the field via an interface.
+func (v *SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError.Message, and is useful for accessing the field via an interface.
+func (v *SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError.Code, and is useful for accessing the field via an interface.
+func (v *SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError) GetCode() MetafieldsSetUserErrorCode {
+	return v.Code
+}
+
+// GetElementIndex returns SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError.ElementIndex, and is useful for accessing the field via an interface.
+func (v *SetMetafieldsMetafieldsSetMetafieldsSetPayloadUserErrorsMetafieldsSetUserError) GetElementIndex() int {
+	return v.ElementIndex
+}
+
+// SetMetafieldsResponse is returned by SetMetafields on success.
+type SetMetafieldsResponse struct {
+	// Sets metafield values. Metafield values will be set regardless if they were previously created or not.
+	//
+	// Allows a maximum of 25 metafields to be set at a time.
+	//
+	// This operation is atomic, meaning no changes are persisted if an error is encountered.
+	//
+	// As of `2024-07`, this operation supports compare-and-set functionality to better handle concurrent requests.
+	// If `compareDigest` is set for any metafield, the mutation will only set that metafield if the persisted metafield value matches the digest used on `compareDigest`.
+	// If the metafield doesn't exist yet, but you want to guarantee that the operation will run in a safe manner, set `compareDigest` to `null`.
+	// The `compareDigest` value can be acquired by querying the metafield object and selecting `compareDigest` as a field.
+	// If the `compareDigest` value does not match the digest for the persisted value, the mutation will return an error.
+	// You can opt out of write guarantees by not sending `compareDigest` in the request.
+	MetafieldsSet SetMetafieldsMetafieldsSetMetafieldsSetPayload `json:"metafieldsSet"`
+}
+
+// GetMetafieldsSet returns SetMetafieldsResponse.MetafieldsSet, and is useful for accessing the field via an interface.
+func (v *SetMetafieldsResponse) GetMetafieldsSet() SetMetafieldsMetafieldsSetMetafieldsSetPayload {
+	return v.MetafieldsSet
+}
+
+// The possible HTTP methods that can be used when sending a request to upload a file using information from a
+// [StagedMediaUploadTarget](https://shopify.dev/api/admin-graphql/latest/objects/StagedMediaUploadTarget).
+type StagedUploadHttpMethodType string
+
+const (
+	// The POST HTTP method.
+	StagedUploadHttpMethodTypePost StagedUploadHttpMethodType = "POST"
+	// The PUT HTTP method.
+	StagedUploadHttpMethodTypePut StagedUploadHttpMethodType = "PUT"
+)
+
+var AllStagedUploadHttpMethodType = []StagedUploadHttpMethodType{
+	StagedUploadHttpMethodTypePost,
+	StagedUploadHttpMethodTypePut,
+}
+
+// The input fields for generating staged upload targets.
+type StagedUploadInput struct {
+	// The file's intended Shopify resource type.
+	Resource StagedUploadTargetGenerateUploadResource `json:"resource"`
+	// The file's name and extension.
+	Filename string `json:"filename"`
+	// The file's MIME type.
+	MimeType string `json:"mimeType"`
+	// The HTTP method to be used when sending a request to upload the file using the returned staged
+	// upload target.
+	HttpMethod StagedUploadHttpMethodType `json:"httpMethod"`
+	// The size of the file to upload, in bytes. This is required when the request's resource property is set to
+	// [VIDEO](https://shopify.dev/api/admin-graphql/latest/enums/StagedUploadTargetGenerateUploadResource#value-video)
+	// or [MODEL_3D](https://shopify.dev/api/admin-graphql/latest/enums/StagedUploadTargetGenerateUploadResource#value-model3d).
+	FileSize string `json:"fileSize,omitempty"`
+}
+
+// GetResource returns StagedUploadInput.Resource, and is useful for accessing the field via an interface.
+func (v *StagedUploadInput) GetResource() StagedUploadTargetGenerateUploadResource { return v.Resource }
+
+// GetFilename returns StagedUploadInput.Filename, and is useful for accessing the field via an interface.
+func (v *StagedUploadInput) GetFilename() string { return v.Filename }
+
+// GetMimeType returns StagedUploadInput.MimeType, and is useful for accessing the field via an interface.
+func (v *StagedUploadInput) GetMimeType() string { return v.MimeType }
+
+// GetHttpMethod returns StagedUploadInput.HttpMethod, and is useful for accessing the field via an interface.
+func (v *StagedUploadInput) GetHttpMethod() StagedUploadHttpMethodType { return v.HttpMethod }
+
+// GetFileSize returns StagedUploadInput.FileSize, and is useful for accessing the field via an interface.
+func (v *StagedUploadInput) GetFileSize() string { return v.FileSize }
+
+// The resource type to receive.
+type StagedUploadTargetGenerateUploadResource string
+
+const (
+	// An image associated with a collection.
+	//
+	// For example, after uploading an image, you can use the
+	// [collectionUpdate mutation](https://shopify.dev/api/admin-graphql/latest/mutations/collectionUpdate)
+	// to add the image to a collection.
+	StagedUploadTargetGenerateUploadResourceCollectionImage StagedUploadTargetGenerateUploadResource = "COLLECTION_IMAGE"
+	// Represents any file other than HTML.
+	//
+	// For example, after uploading the file, you can add the file to the
+	// [Files page](https://shopify.com/admin/settings/files) in Shopify admin using the
+	// [fileCreate mutation](https://shopify.dev/api/admin-graphql/latest/mutations/fileCreate).
+	StagedUploadTargetGenerateUploadResourceFile StagedUploadTargetGenerateUploadResource = "FILE"
+	// An image.
+	//
+	// For example, after uploading an image, you can add the image to a product using the
+	// [productCreateMedia mutation](https://shopify.dev/api/admin-graphql/latest/mutations/productCreateMedia)
+	// or to the [Files page](https://shopify.com/admin/settings/files) in Shopify admin using the
+	// [fileCreate mutation](https://shopify.dev/api/admin-graphql/latest/mutations/fileCreate).
+	StagedUploadTargetGenerateUploadResourceImage StagedUploadTargetGenerateUploadResource = "IMAGE"
+	// A Shopify hosted 3d model.
+	//
+	// For example, after uploading the 3d model, you can add the 3d model to a product using the
+	// [productCreateMedia mutation](https://shopify.dev/api/admin-graphql/latest/mutations/productCreateMedia).
+	StagedUploadTargetGenerateUploadResourceModel3d StagedUploadTargetGenerateUploadResource = "MODEL_3D"
+	// An image that's associated with a product.
+	//
+	// For example, after uploading the image, you can add the image to a product using the
+	// [productCreateMedia mutation](https://shopify.dev/api/admin-graphql/latest/mutations/productCreateMedia).
+	StagedUploadTargetGenerateUploadResourceProductImage StagedUploadTargetGenerateUploadResource = "PRODUCT_IMAGE"
+	// An image.
+	//
+	// For example, after uploading the image, you can add the image to the
+	// [Files page](https://shopify.com/admin/settings/files) in Shopify admin using the
+	// [fileCreate mutation](https://shopify.dev/api/admin-graphql/latest/mutations/fileCreate).
+	StagedUploadTargetGenerateUploadResourceShopImage StagedUploadTargetGenerateUploadResource = "SHOP_IMAGE"
+	// A Shopify-hosted video.
+	//
+	// For example, after uploading the video, you can add the video to a product using the
+	// [productCreateMedia mutation](https://shopify.dev/api/admin-graphql/latest/mutations/productCreateMedia)
+	// or to the [Files page](https://shopify.com/admin/settings/files) in Shopify admin using the
+	// [fileCreate mutation](https://shopify.dev/api/admin-graphql/latest/mutations/fileCreate).
+	StagedUploadTargetGenerateUploadResourceVideo StagedUploadTargetGenerateUploadResource = "VIDEO"
+	// Represents bulk mutation variables.
+	//
+	// For example, bulk mutation variables can be used for bulk operations using the
+	// [bulkOperationRunMutation mutation](https://shopify.dev/api/admin-graphql/latest/mutations/bulkOperationRunMutation).
+	StagedUploadTargetGenerateUploadResourceBulkMutationVariables StagedUploadTargetGenerateUploadResource = "BULK_MUTATION_VARIABLES"
+	// Represents a label associated with a return.
+	//
+	// For example, once uploaded, this resource can be used to [create a
+	// ReverseDelivery](https://shopify.dev/api/admin-graphql/unstable/mutations/reverseDeliveryCreateWithShipping).
+	StagedUploadTargetGenerateUploadResourceReturnLabel StagedUploadTargetGenerateUploadResource = "RETURN_LABEL"
+	// Represents a redirect CSV file.
+	//
+	// Example usage: This resource can be used for creating a
+	// [UrlRedirectImport](https://shopify.dev/api/admin-graphql/2022-04/objects/UrlRedirectImport)
+	// object for use in the
+	// [urlRedirectImportCreate mutation](https://shopify.dev/api/admin-graphql/latest/mutations/urlRedirectImportCreate).
+	StagedUploadTargetGenerateUploadResourceUrlRedirectImport StagedUploadTargetGenerateUploadResource = "URL_REDIRECT_IMPORT"
+)
+
+var AllStagedUploadTargetGenerateUploadResource = []StagedUploadTargetGenerateUploadResource{
+	StagedUploadTargetGenerateUploadResourceCollectionImage,
+	StagedUploadTargetGenerateUploadResourceFile,
+	StagedUploadTargetGenerateUploadResourceImage,
+	StagedUploadTargetGenerateUploadResourceModel3d,
+	StagedUploadTargetGenerateUploadResourceProductImage,
+	StagedUploadTargetGenerateUploadResourceShopImage,
+	StagedUploadTargetGenerateUploadResourceVideo,
+	StagedUploadTargetGenerateUploadResourceBulkMutationVariables,
+	StagedUploadTargetGenerateUploadResourceReturnLabel,
+	StagedUploadTargetGenerateUploadResourceUrlRedirectImport,
+}
+
+// Possible error codes that can be returned by `StandardMetafieldDefinitionEnableUserError`.
+type StandardMetafieldDefinitionEnableUserErrorCode string
+
+const (
+	// The input value is invalid.
+	StandardMetafieldDefinitionEnableUserErrorCodeInvalid StandardMetafieldDefinitionEnableUserErrorCode = "INVALID"
+	// The input value is already taken.
+	StandardMetafieldDefinitionEnableUserErrorCodeTaken StandardMetafieldDefinitionEnableUserErrorCode = "TAKEN"
+	// The standard metafield definition template was not found.
+	StandardMetafieldDefinitionEnableUserErrorCodeTemplateNotFound StandardMetafieldDefinitionEnableUserErrorCode = "TEMPLATE_NOT_FOUND"
+	// The maximum number of definitions per owner type has been exceeded.
+	StandardMetafieldDefinitionEnableUserErrorCodeLimitExceeded StandardMetafieldDefinitionEnableUserErrorCode = "LIMIT_EXCEEDED"
+	// The namespace and key is already in use for a set of your metafields.
+	StandardMetafieldDefinitionEnableUserErrorCodeUnstructuredAlreadyExists StandardMetafieldDefinitionEnableUserErrorCode = "UNSTRUCTURED_ALREADY_EXISTS"
+	// The definition type is not eligible to be used as collection condition.
+	StandardMetafieldDefinitionEnableUserErrorCodeTypeNotAllowedForConditions StandardMetafieldDefinitionEnableUserErrorCode = "TYPE_NOT_ALLOWED_FOR_CONDITIONS"
+	// The metafield definition capability is invalid.
+	StandardMetafieldDefinitionEnableUserErrorCodeInvalidCapability StandardMetafieldDefinitionEnableUserErrorCode = "INVALID_CAPABILITY"
+	// The metafield definition does not support pinning.
+	StandardMetafieldDefinitionEnableUserErrorCodeUnsupportedPinning StandardMetafieldDefinitionEnableUserErrorCode = "UNSUPPORTED_PINNING"
+	// Admin access can only be specified for app-owned metafield definitions.
+	StandardMetafieldDefinitionEnableUserErrorCodeAdminAccessInputNotAllowed StandardMetafieldDefinitionEnableUserErrorCode = "ADMIN_ACCESS_INPUT_NOT_ALLOWED"
+	// The input combination is invalid.
+	StandardMetafieldDefinitionEnableUserErrorCodeInvalidInputCombination StandardMetafieldDefinitionEnableUserErrorCode = "INVALID_INPUT_COMBINATION"
+)
+
+var AllStandardMetafieldDefinitionEnableUserErrorCode = []StandardMetafieldDefinitionEnableUserErrorCode{
+	StandardMetafieldDefinitionEnableUserErrorCodeInvalid,
+	StandardMetafieldDefinitionEnableUserErrorCodeTaken,
+	StandardMetafieldDefinitionEnableUserErrorCodeTemplateNotFound,
+	StandardMetafieldDefinitionEnableUserErrorCodeLimitExceeded,
+	StandardMetafieldDefinitionEnableUserErrorCodeUnstructuredAlreadyExists,
+	StandardMetafieldDefinitionEnableUserErrorCodeTypeNotAllowedForConditions,
+	StandardMetafieldDefinitionEnableUserErrorCodeInvalidCapability,
+	StandardMetafieldDefinitionEnableUserErrorCodeUnsupportedPinning,
+	StandardMetafieldDefinitionEnableUserErrorCodeAdminAccessInputNotAllowed,
+	StandardMetafieldDefinitionEnableUserErrorCodeInvalidInputCombination,
+}
+
+// Possible error codes that can be returned by `TranslationUserError`.
+type TranslationErrorCode string
+
+const (
+	// The input value is blank.
+	TranslationErrorCodeBlank TranslationErrorCode = "BLANK"
+	// The input value is invalid.
+	TranslationErrorCodeInvalid TranslationErrorCode = "INVALID"
+	// Resource does not exist.
+	TranslationErrorCodeResourceNotFound TranslationErrorCode = "RESOURCE_NOT_FOUND"
+	// Resource is not translatable.
+	TranslationErrorCodeResourceNotTranslatable TranslationErrorCode = "RESOURCE_NOT_TRANSLATABLE"
+	// Too many translation keys for the resource.
+	TranslationErrorCodeTooManyKeysForResource TranslationErrorCode = "TOO_MANY_KEYS_FOR_RESOURCE"
+	// Translation key is invalid.
+	TranslationErrorCodeInvalidKeyForModel TranslationErrorCode = "INVALID_KEY_FOR_MODEL"
+	// Translation value is invalid.
+	TranslationErrorCodeFailsResourceValidation TranslationErrorCode = "FAILS_RESOURCE_VALIDATION"
+	// Translatable content is invalid.
+	TranslationErrorCodeInvalidTranslatableContent TranslationErrorCode = "INVALID_TRANSLATABLE_CONTENT"
+	// Market localizable content is invalid.
+	TranslationErrorCodeInvalidMarketLocalizableContent TranslationErrorCode = "INVALID_MARKET_LOCALIZABLE_CONTENT"
+	// Locale is invalid for the shop.
+	TranslationErrorCodeInvalidLocaleForShop TranslationErrorCode = "INVALID_LOCALE_FOR_SHOP"
+	// Locale language code is invalid.
+	TranslationErrorCodeInvalidCode TranslationErrorCode = "INVALID_CODE"
+	// Locale code format is invalid.
+	TranslationErrorCodeInvalidFormat TranslationErrorCode = "INVALID_FORMAT"
+	// The shop isn't allowed to operate on market custom content.
+	TranslationErrorCodeMarketCustomContentNotAllowed TranslationErrorCode = "MARKET_CUSTOM_CONTENT_NOT_ALLOWED"
+	// The market corresponding to the `marketId` argument doesn't exist.
+	TranslationErrorCodeMarketDoesNotExist TranslationErrorCode = "MARKET_DOES_NOT_EXIST"
+	// The market override locale creation failed.
+	TranslationErrorCodeMarketLocaleCreationFailed TranslationErrorCode = "MARKET_LOCALE_CREATION_FAILED"
+	// The specified resource can't be customized for a market.
+	TranslationErrorCodeResourceNotMarketCustomizable TranslationErrorCode = "RESOURCE_NOT_MARKET_CUSTOMIZABLE"
+	// The locale is missing on the market corresponding to the `marketId` argument.
+	TranslationErrorCodeInvalidLocaleForMarket TranslationErrorCode = "INVALID_LOCALE_FOR_MARKET"
+	// The handle is already taken for this resource.
+	TranslationErrorCodeInvalidValueForHandleTranslation TranslationErrorCode = "INVALID_VALUE_FOR_HANDLE_TRANSLATION"
+)
+
+var AllTranslationErrorCode = []TranslationErrorCode{
+	TranslationErrorCodeBlank,
+	TranslationErrorCodeInvalid,
+	TranslationErrorCodeResourceNotFound,
+	TranslationErrorCodeResourceNotTranslatable,
+	TranslationErrorCodeTooManyKeysForResource,
+	TranslationErrorCodeInvalidKeyForModel,
+	TranslationErrorCodeFailsResourceValidation,
+	TranslationErrorCodeInvalidTranslatableContent,
+	TranslationErrorCodeInvalidMarketLocalizableContent,
+	TranslationErrorCodeInvalidLocaleForShop,
+	TranslationErrorCodeInvalidCode,
+	TranslationErrorCodeInvalidFormat,
+	TranslationErrorCodeMarketCustomContentNotAllowed,
+	TranslationErrorCodeMarketDoesNotExist,
+	TranslationErrorCodeMarketLocaleCreationFailed,
+	TranslationErrorCodeResourceNotMarketCustomizable,
+	TranslationErrorCodeInvalidLocaleForMarket,
+	TranslationErrorCodeInvalidValueForHandleTranslation,
+}
+
+// The input fields and values for creating or updating a translation.
+type TranslationInput struct {
+	// ISO code of the locale being translated into. Only locales returned in `shopLocales` are valid.
+	Locale string `json:"locale"`
+	// On the resource that this translation belongs to, the reference to the value being translated.
+	Key string `json:"key"`
+	// The value of the translation.
+	Value string `json:"value"`
+	// Hash digest representation of the content being translated.
+	TranslatableContentDigest string `json:"translatableContentDigest"`
+	// The ID of the market that the translation is specific to. Not specifying this field means that the translation will be available in all markets.
+	MarketId string `json:"marketId,omitempty"`
+}
+
+// GetLocale returns TranslationInput.Locale, and is useful for accessing the field via an interface.
+func (v *TranslationInput) GetLocale() string { return v.Locale }
+
+// GetKey returns TranslationInput.Key, and is useful for accessing the field via an interface.
+func (v *TranslationInput) GetKey() string { return v.Key }
+
+// GetValue returns TranslationInput.Value, and is useful for accessing the field via an interface.
+func (v *TranslationInput) GetValue() string { return v.Value }
+
+// GetTranslatableContentDigest returns TranslationInput.TranslatableContentDigest, and is useful for accessing the field via an interface.
+func (v *TranslationInput) GetTranslatableContentDigest() string { return v.TranslatableContentDigest }
+
+// GetMarketId returns TranslationInput.MarketId, and is useful for accessing the field via an interface.
+func (v *TranslationInput) GetMarketId() string { return v.MarketId }
+
+// UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayload includes the requested fields of the GraphQL type MetafieldDefinitionUnpinPayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metafieldDefinitionUnpin` mutation.
+type UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayload struct {
+	// The metafield definition that was unpinned.
+	UnpinnedDefinition UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUnpinnedDefinitionMetafieldDefinition `json:"unpinnedDefinition"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError `json:"userErrors"`
+}
+
+// GetUnpinnedDefinition returns UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayload.UnpinnedDefinition, and is useful for accessing the field via an interface.
+func (v *UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayload) GetUnpinnedDefinition() UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUnpinnedDefinitionMetafieldDefinition {
+	return v.UnpinnedDefinition
+}
+
+// GetUserErrors returns UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayload) GetUserErrors() []UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError {
+	return v.UserErrors
+}
+
+// UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUnpinnedDefinitionMetafieldDefinition includes the requested fields of the GraphQL type MetafieldDefinition.
+// The GraphQL type's documentation follows.
+//
+// Metafield definitions enable you to define additional validation constraints for metafields, and enable the
+// merchant to edit metafield values in context.
+type UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUnpinnedDefinitionMetafieldDefinition struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUnpinnedDefinitionMetafieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUnpinnedDefinitionMetafieldDefinition) GetId() string {
+	return v.Id
+}
+
+// UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError includes the requested fields of the GraphQL type MetafieldDefinitionUnpinUserError.
+// The GraphQL type's documentation follows.
+//
+// An error that occurs during the execution of `MetafieldDefinitionUnpin`.
+type UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetafieldDefinitionUnpinUserErrorCode `json:"code"`
+}
+
+// GetField returns UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError.Field, and is useful for accessing the field via an interface.
+func (v *UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError.Message, and is useful for accessing the field via an interface.
+func (v *UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError.Code, and is useful for accessing the field via an interface.
+func (v *UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayloadUserErrorsMetafieldDefinitionUnpinUserError) GetCode() MetafieldDefinitionUnpinUserErrorCode {
+	return v.Code
+}
+
+// UnpinMetafieldDefinitionResponse is returned by UnpinMetafieldDefinition on success.
+type UnpinMetafieldDefinitionResponse struct {
+	// You can organize your metafields in your Shopify admin by pinning/unpinning metafield definitions.
+	// The order of your pinned metafield definitions determines the order in which your metafields are displayed
+	// on the corresponding pages in your Shopify admin. By default, only pinned metafields are automatically displayed.
+	MetafieldDefinitionUnpin UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayload `json:"metafieldDefinitionUnpin"`
+}
+
+// GetMetafieldDefinitionUnpin returns UnpinMetafieldDefinitionResponse.MetafieldDefinitionUnpin, and is useful for accessing the field via an interface.
+func (v *UnpinMetafieldDefinitionResponse) GetMetafieldDefinitionUnpin() UnpinMetafieldDefinitionMetafieldDefinitionUnpinMetafieldDefinitionUnpinPayload {
+	return v.MetafieldDefinitionUnpin
+}
+
+// UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayload includes the requested fields of the GraphQL type MetafieldDefinitionUpdatePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metafieldDefinitionUpdate` mutation.
+type UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayload struct {
+	// The metafield definition that was updated.
+	UpdatedDefinition UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUpdatedDefinitionMetafieldDefinition `json:"updatedDefinition"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError `json:"userErrors"`
+}
+
+// GetUpdatedDefinition returns UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayload.UpdatedDefinition, and is useful for accessing the field via an interface.
+func (v *UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayload) GetUpdatedDefinition() UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUpdatedDefinitionMetafieldDefinition {
+	return v.UpdatedDefinition
+}
+
+// GetUserErrors returns UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayload) GetUserErrors() []UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError {
+	return v.UserErrors
+}
+
+// UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUpdatedDefinitionMetafieldDefinition includes the requested fields of the GraphQL type MetafieldDefinition.
+// The GraphQL type's documentation follows.
+//
+// Metafield definitions enable you to define additional validation constraints for metafields, and enable the
+// merchant to edit metafield values in context.
+type UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUpdatedDefinitionMetafieldDefinition struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUpdatedDefinitionMetafieldDefinition.Id, and is useful for accessing the field via an interface.
+func (v *UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUpdatedDefinitionMetafieldDefinition) GetId() string {
+	return v.Id
+}
+
+// UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError includes the requested fields of the GraphQL type MetafieldDefinitionUpdateUserError.
+// The GraphQL type's documentation follows.
+//
+// An error that occurs during the execution of `MetafieldDefinitionUpdate`.
+type UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetafieldDefinitionUpdateUserErrorCode `json:"code"`
+}
+
+// GetField returns UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError.Field, and is useful for accessing the field via an interface.
+func (v *UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError.Message, and is useful for accessing the field via an interface.
+func (v *UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError.Code, and is useful for accessing the field via an interface.
+func (v *UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayloadUserErrorsMetafieldDefinitionUpdateUserError) GetCode() MetafieldDefinitionUpdateUserErrorCode {
+	return v.Code
+}
+
+// UpdateMetafieldDefinitionResponse is returned by UpdateMetafieldDefinition on success.
+type UpdateMetafieldDefinitionResponse struct {
+	// Updates a metafield definition.
+	MetafieldDefinitionUpdate UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayload `json:"metafieldDefinitionUpdate"`
+}
+
+// GetMetafieldDefinitionUpdate returns UpdateMetafieldDefinitionResponse.MetafieldDefinitionUpdate, and is useful for accessing the field via an interface.
+func (v *UpdateMetafieldDefinitionResponse) GetMetafieldDefinitionUpdate() UpdateMetafieldDefinitionMetafieldDefinitionUpdateMetafieldDefinitionUpdatePayload {
+	return v.MetafieldDefinitionUpdate
+}
+
+// UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload includes the requested fields of the GraphQL type MetaobjectDefinitionUpdatePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metaobjectDefinitionUpdate` mutation.
+type UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload struct {
+	// The updated metaobject definition.
+	MetaobjectDefinition UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition `json:"metaobjectDefinition"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError `json:"userErrors"`
+}
+
+// GetMetaobjectDefinition returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload.MetaobjectDefinition, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload) GetMetaobjectDefinition() UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition {
+	return v.MetaobjectDefinition
+}
+
+// GetUserErrors returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload) GetUserErrors() []UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError {
+	return v.UserErrors
+}
+
+// UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition includes the requested fields of the GraphQL type MetaobjectDefinition.
+// The GraphQL type's documentation follows.
+//
+// Provides the definition of a generic object structure composed of metafields.
+type UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The type of the object definition. Defines the namespace of associated metafields.
+	Type string `json:"type"`
+}
+
+// GetId returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition.Id, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition) GetId() string {
+	return v.Id
+}
+
+// GetType returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition.Type, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadMetaobjectDefinition) GetType() string {
+	return v.Type
+}
+
+// UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError includes the requested fields of the GraphQL type MetaobjectUserError.
+// The GraphQL type's documentation follows.
+//
+// Defines errors encountered while managing metaobject resources.
+type UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetaobjectUserErrorCode `json:"code"`
+}
+
+// GetField returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError.Field, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError.Message, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError.Code, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayloadUserErrorsMetaobjectUserError) GetCode() MetaobjectUserErrorCode {
+	return v.Code
+}
+
+// UpdateMetaobjectDefinitionResponse is returned by UpdateMetaobjectDefinition on success.
+type UpdateMetaobjectDefinitionResponse struct {
+	// Updates a metaobject definition with new settings and metafield definitions.
+	MetaobjectDefinitionUpdate UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload `json:"metaobjectDefinitionUpdate"`
+}
+
+// GetMetaobjectDefinitionUpdate returns UpdateMetaobjectDefinitionResponse.MetaobjectDefinitionUpdate, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectDefinitionResponse) GetMetaobjectDefinitionUpdate() UpdateMetaobjectDefinitionMetaobjectDefinitionUpdateMetaobjectDefinitionUpdatePayload {
+	return v.MetaobjectDefinitionUpdate
+}
+
+// UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayload includes the requested fields of the GraphQL type MetaobjectUpdatePayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metaobjectUpdate` mutation.
+type UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayload struct {
+	// The updated metaobject.
+	Metaobject UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadMetaobject `json:"metaobject"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError `json:"userErrors"`
+}
+
+// GetMetaobject returns UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayload.Metaobject, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayload) GetMetaobject() UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadMetaobject {
+	return v.Metaobject
+}
+
+// GetUserErrors returns UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayload) GetUserErrors() []UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError {
+	return v.UserErrors
+}
+
+// UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadMetaobject includes the requested fields of the GraphQL type Metaobject.
+// The GraphQL type's documentation follows.
+//
+// Provides an object instance represented by a MetaobjectDefinition.
+type UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadMetaobject struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The unique handle of the object, useful as a custom ID.
+	Handle string `json:"handle"`
+}
+
+// GetId returns UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadMetaobject.Id, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadMetaobject) GetId() string {
+	return v.Id
+}
+
+// GetHandle returns UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadMetaobject.Handle, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadMetaobject) GetHandle() string {
+	return v.Handle
+}
+
+// UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError includes the requested fields of the GraphQL type MetaobjectUserError.
+// The GraphQL type's documentation follows.
+//
+// Defines errors encountered while managing metaobject resources.
+type UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetaobjectUserErrorCode `json:"code"`
+}
+
+// GetField returns UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError.Field, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError.Message, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError.Code, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayloadUserErrorsMetaobjectUserError) GetCode() MetaobjectUserErrorCode {
+	return v.Code
+}
+
+// UpdateMetaobjectResponse is returned by UpdateMetaobject on success.
+type UpdateMetaobjectResponse struct {
+	// Updates an existing metaobject.
+	MetaobjectUpdate UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayload `json:"metaobjectUpdate"`
+}
+
+// GetMetaobjectUpdate returns UpdateMetaobjectResponse.MetaobjectUpdate, and is useful for accessing the field via an interface.
+func (v *UpdateMetaobjectResponse) GetMetaobjectUpdate() UpdateMetaobjectMetaobjectUpdateMetaobjectUpdatePayload {
+	return v.MetaobjectUpdate
+}
+
+// UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayload includes the requested fields of the GraphQL type MetaobjectUpsertPayload.
+// The GraphQL type's documentation follows.
+//
+// Return type for `metaobjectUpsert` mutation.
+type UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayload struct {
+	// The created or updated metaobject.
+	Metaobject UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadMetaobject `json:"metaobject"`
+	// The list of errors that occurred from executing the mutation.
+	UserErrors []UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError `json:"userErrors"`
+}
+
+// GetMetaobject returns UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayload.Metaobject, and is useful for accessing the field via an interface.
+func (v *UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayload) GetMetaobject() UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadMetaobject {
+	return v.Metaobject
+}
+
+// GetUserErrors returns UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayload.UserErrors, and is useful for accessing the field via an interface.
+func (v *UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayload) GetUserErrors() []UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError {
+	return v.UserErrors
+}
+
+// UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadMetaobject includes the requested fields of the GraphQL type Metaobject.
+// The GraphQL type's documentation follows.
+//
+// Provides an object instance represented by a MetaobjectDefinition.
+type UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadMetaobject struct {
+	// A globally-unique ID.
+	Id string `json:"id"`
+	// The unique handle of the object, useful as a custom ID.
+	Handle string `json:"handle"`
+}
+
+// GetId returns UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadMetaobject.Id, and is useful for accessing the field via an interface.
+func (v *UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadMetaobject) GetId() string {
+	return v.Id
+}
+
+// GetHandle returns UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadMetaobject.Handle, and is useful for accessing the field via an interface.
+func (v *UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadMetaobject) GetHandle() string {
+	return v.Handle
+}
+
+// UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError includes the requested fields of the GraphQL type MetaobjectUserError.
+// The GraphQL type's documentation follows.
+//
+// Defines errors encountered while managing metaobject resources.
+type UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError struct {
+	// The path to the input field that caused the error.
+	Field []string `json:"field"`
+	// The error message.
+	Message string `json:"message"`
+	// The error code.
+	Code MetaobjectUserErrorCode `json:"code"`
+}
+
+// GetField returns UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError.Field, and is useful for accessing the field via an interface.
+func (v *UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError) GetField() []string {
+	return v.Field
+}
+
+// GetMessage returns UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError.Message, and is useful for accessing the field via an interface.
+func (v *UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError) GetMessage() string {
+	return v.Message
+}
+
+// GetCode returns UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError.Code, and is useful for accessing the field via an interface.
+func (v *UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayloadUserErrorsMetaobjectUserError) GetCode() MetaobjectUserErrorCode {
+	return v.Code
+}
+
+// UpsertMetaobjectResponse is returned by UpsertMetaobject on success.
+type UpsertMetaobjectResponse struct {
+	// Retrieves a metaobject by handle, then updates it with the provided input values.
+	// If no matching metaobject is found, a new metaobject is created with the provided input values.
+	MetaobjectUpsert UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayload `json:"metaobjectUpsert"`
+}
+
+// GetMetaobjectUpsert returns UpsertMetaobjectResponse.MetaobjectUpsert, and is useful for accessing the field via an interface.
+func (v *UpsertMetaobjectResponse) GetMetaobjectUpsert() UpsertMetaobjectMetaobjectUpsertMetaobjectUpsertPayload {
+	return v.MetaobjectUpsert
+}
+
+// __BulkDeleteMetaobjectsInput is used internally by genqlient
+type __BulkDeleteMetaobjectsInput struct {
+	Ids []string `json:"ids"`
+}
+
+// GetIds returns __BulkDeleteMetaobjectsInput.Ids, and is useful for accessing the field via an interface.
+func (v *__BulkDeleteMetaobjectsInput) GetIds() []string { return v.Ids }
+
+// __CreateFilesInput is used internally by genqlient
+type __CreateFilesInput struct {
+	Files []FileCreateInput `json:"files"`
+}
+
+// GetFiles returns __CreateFilesInput.Files, and is useful for accessing the field via an interface.
+func (v *__CreateFilesInput) GetFiles() []FileCreateInput { return v.Files }
+
+// __CreateMetafieldDefinitionInput is used internally by genqlient
+type __CreateMetafieldDefinitionInput struct {
+	Definition MetafieldDefinitionInput `json:"definition"`
+}
+
+// GetDefinition returns __CreateMetafieldDefinitionInput.Definition, and is useful for accessing the field via an interface.
+func (v *__CreateMetafieldDefinitionInput) GetDefinition() MetafieldDefinitionInput {
+	return v.Definition
+}
+
+// __CreateMetaobjectDefinitionInput is used internally by genqlient
+type __CreateMetaobjectDefinitionInput struct {
+	Definition MetaobjectDefinitionCreateInput `json:"definition"`
+}
+
+// GetDefinition returns __CreateMetaobjectDefinitionInput.Definition, and is useful for accessing the field via an interface.
+func (v *__CreateMetaobjectDefinitionInput) GetDefinition() MetaobjectDefinitionCreateInput {
+	return v.Definition
+}
+
+// __CreateStagedUploadsInput is used internally by genqlient
+type __CreateStagedUploadsInput struct {
+	Input []StagedUploadInput `json:"input"`
+}
+
+// GetInput returns __CreateStagedUploadsInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateStagedUploadsInput) GetInput() []StagedUploadInput { return v.Input }
+
+// __DeleteMetafieldsInput is used internally by genqlient
+type __DeleteMetafieldsInput struct {
+	Metafields []MetafieldIdentifierInput `json:"metafields"`
+}
+
+// GetMetafields returns __DeleteMetafieldsInput.Metafields, and is useful for accessing the field via an interface.
+func (v *__DeleteMetafieldsInput) GetMetafields() []MetafieldIdentifierInput { return v.Metafields }
+
+// __DeleteMetaobjectInput is used internally by genqlient
+type __DeleteMetaobjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __DeleteMetaobjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__DeleteMetaobjectInput) GetId() string { return v.Id }
+
+// __EnableStandardMetafieldDefinitionInput is used internally by genqlient
+type __EnableStandardMetafieldDefinitionInput struct {
+	OwnerType MetafieldOwnerType `json:"ownerType"`
+	Id        string             `json:"id"`
+}
+
+// GetOwnerType returns __EnableStandardMetafieldDefinitionInput.OwnerType, and is useful for accessing the field via an interface.
+func (v *__EnableStandardMetafieldDefinitionInput) GetOwnerType() MetafieldOwnerType {
+	return v.OwnerType
+}
+
+// GetId returns __EnableStandardMetafieldDefinitionInput.Id, and is useful for accessing the field via an interface.
+func (v *__EnableStandardMetafieldDefinitionInput) GetId() string { return v.Id }
+
+// __EnableStandardMetaobjectDefinitionInput is used internally by genqlient
+type __EnableStandardMetaobjectDefinitionInput struct {
+	DefType string `json:"defType"`
+}
+
+// GetDefType returns __EnableStandardMetaobjectDefinitionInput.DefType, and is useful for accessing the field via an interface.
+func (v *__EnableStandardMetaobjectDefinitionInput) GetDefType() string { return v.DefType }
+
+// __FindFilesInput is used internally by genqlient
+type __FindFilesInput struct {
+	Query string `json:"query"`
+}
+
+// GetQuery returns __FindFilesInput.Query, and is useful for accessing the field via an interface.
+func (v *__FindFilesInput) GetQuery() string { return v.Query }
+
+// __FindProductVariantsInput is used internally by genqlient
+type __FindProductVariantsInput struct {
+	Query string `json:"query"`
+}
+
+// GetQuery returns __FindProductVariantsInput.Query, and is useful for accessing the field via an interface.
+func (v *__FindProductVariantsInput) GetQuery() string { return v.Query }
+
+// __GetCollectionByHandleInput is used internally by genqlient
+type __GetCollectionByHandleInput struct {
+	Handle string `json:"handle"`
+}
+
+// GetHandle returns __GetCollectionByHandleInput.Handle, and is useful for accessing the field via an interface.
+func (v *__GetCollectionByHandleInput) GetHandle() string { return v.Handle }
+
+// __GetCollectionMetafieldsInput is used internally by genqlient
+type __GetCollectionMetafieldsInput struct {
+	Id    string `json:"id"`
+	After string `json:"after"`
+}
+
+// GetId returns __GetCollectionMetafieldsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetCollectionMetafieldsInput) GetId() string { return v.Id }
+
+// GetAfter returns __GetCollectionMetafieldsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetCollectionMetafieldsInput) GetAfter() string { return v.After }
+
+// __GetJobInput is used internally by genqlient
+type __GetJobInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetJobInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetJobInput) GetId() string { return v.Id }
+
+// __GetMetaobjectByHandleInput is used internally by genqlient
+type __GetMetaobjectByHandleInput struct {
+	Handle MetaobjectHandleInput `json:"handle"`
+}
+
+// GetHandle returns __GetMetaobjectByHandleInput.Handle, and is useful for accessing the field via an interface.
+func (v *__GetMetaobjectByHandleInput) GetHandle() MetaobjectHandleInput { return v.Handle }
+
+// __GetMetaobjectDefinitionByTypeInput is used internally by genqlient
+type __GetMetaobjectDefinitionByTypeInput struct {
+	DefType string `json:"defType"`
+}
+
+// GetDefType returns __GetMetaobjectDefinitionByTypeInput.DefType, and is useful for accessing the field via an interface.
+func (v *__GetMetaobjectDefinitionByTypeInput) GetDefType() string { return v.DefType }
+
+// __GetMetaobjectReferencedByInput is used internally by genqlient
+type __GetMetaobjectReferencedByInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetMetaobjectReferencedByInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetMetaobjectReferencedByInput) GetId() string { return v.Id }
+
+// __GetProductByHandleInput is used internally by genqlient
+type __GetProductByHandleInput struct {
+	Handle string `json:"handle"`
+}
+
+// GetHandle returns __GetProductByHandleInput.Handle, and is useful for accessing the field via an interface.
+func (v *__GetProductByHandleInput) GetHandle() string { return v.Handle }
+
+// __GetProductMetafieldsInput is used internally by genqlient
+type __GetProductMetafieldsInput struct {
+	Id    string `json:"id"`
+	After string `json:"after"`
+}
+
+// GetId returns __GetProductMetafieldsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetProductMetafieldsInput) GetId() string { return v.Id }
+
+// GetAfter returns __GetProductMetafieldsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetProductMetafieldsInput) GetAfter() string { return v.After }
+
+// __GetReferencesInput is used internally by genqlient
+type __GetReferencesInput struct {
+	Ids []string `json:"ids"`
+}
+
+// GetIds returns __GetReferencesInput.Ids, and is useful for accessing the field via an interface.
+func (v *__GetReferencesInput) GetIds() []string { return v.Ids }
+
+// __ListCollectionMetafieldsInput is used internally by genqlient
+type __ListCollectionMetafieldsInput struct {
+	First int    `json:"first"`
+	After string `json:"after,omitempty"`
+}
+
+// GetFirst returns __ListCollectionMetafieldsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListCollectionMetafieldsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListCollectionMetafieldsInput.After, and is useful for accessing the field via an interface.
+func (v *__ListCollectionMetafieldsInput) GetAfter() string { return v.After }
+
+// __ListMetafieldDefinitionsInput is used internally by genqlient
+type __ListMetafieldDefinitionsInput struct {
+	OwnerType MetafieldOwnerType `json:"ownerType"`
+	First     int                `json:"first"`
+	After     string             `json:"after,omitempty"`
+}
+
+// GetOwnerType returns __ListMetafieldDefinitionsInput.OwnerType, and is useful for accessing the field via an interface.
+func (v *__ListMetafieldDefinitionsInput) GetOwnerType() MetafieldOwnerType { return v.OwnerType }
+
+// GetFirst returns __ListMetafieldDefinitionsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListMetafieldDefinitionsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListMetafieldDefinitionsInput.After, and is useful for accessing the field via an interface.
+func (v *__ListMetafieldDefinitionsInput) GetAfter() string { return v.After }
+
+// __ListMetaobjectDefinitionsInput is used internally by genqlient
+type __ListMetaobjectDefinitionsInput struct {
+	First int `json:"first"`
+}
+
+// GetFirst returns __ListMetaobjectDefinitionsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListMetaobjectDefinitionsInput) GetFirst() int { return v.First }
+
+// __ListMetaobjectFieldValuesInput is used internally by genqlient
+type __ListMetaobjectFieldValuesInput struct {
+	DefType string `json:"defType"`
+	First   int    `json:"first"`
+	After   string `json:"after,omitempty"`
+}
+
+// GetDefType returns __ListMetaobjectFieldValuesInput.DefType, and is useful for accessing the field via an interface.
+func (v *__ListMetaobjectFieldValuesInput) GetDefType() string { return v.DefType }
+
+// GetFirst returns __ListMetaobjectFieldValuesInput.First, and is useful for accessing the field via an interface.
+func (v *__ListMetaobjectFieldValuesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListMetaobjectFieldValuesInput.After, and is useful for accessing the field via an interface.
+func (v *__ListMetaobjectFieldValuesInput) GetAfter() string { return v.After }
+
+// __ListMetaobjectsInput is used internally by genqlient
+type __ListMetaobjectsInput struct {
+	DefType string `json:"defType"`
+	First   int    `json:"first"`
+	After   string `json:"after,omitempty"`
+}
+
+// GetDefType returns __ListMetaobjectsInput.DefType, and is useful for accessing the field via an interface.
+func (v *__ListMetaobjectsInput) GetDefType() string { return v.DefType }
+
+// GetFirst returns __ListMetaobjectsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListMetaobjectsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListMetaobjectsInput.After, and is useful for accessing the field via an interface.
+func (v *__ListMetaobjectsInput) GetAfter() string { return v.After }
+
+// __ListProductMetafieldsInput is used internally by genqlient
+type __ListProductMetafieldsInput struct {
+	First int    `json:"first"`
+	After string `json:"after,omitempty"`
+}
+
+// GetFirst returns __ListProductMetafieldsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListProductMetafieldsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListProductMetafieldsInput.After, and is useful for accessing the field via an interface.
+func (v *__ListProductMetafieldsInput) GetAfter() string { return v.After }
+
+// __ListStandardMetafieldDefinitionTemplatesInput is used internally by genqlient
+type __ListStandardMetafieldDefinitionTemplatesInput struct {
+	First int    `json:"first"`
+	After string `json:"after,omitempty"`
+}
+
+// GetFirst returns __ListStandardMetafieldDefinitionTemplatesInput.First, and is useful for accessing the field via an interface.
+func (v *__ListStandardMetafieldDefinitionTemplatesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListStandardMetafieldDefinitionTemplatesInput.After, and is useful for accessing the field via an interface.
+func (v *__ListStandardMetafieldDefinitionTemplatesInput) GetAfter() string { return v.After }
+
+// __ListTranslatableResourcesInput is used internally by genqlient
+type __ListTranslatableResourcesInput struct {
+	Ids    []string `json:"ids"`
+	Locale string   `json:"locale"`
+}
+
+// GetIds returns __ListTranslatableResourcesInput.Ids, and is useful for accessing the field via an interface.
+func (v *__ListTranslatableResourcesInput) GetIds() []string { return v.Ids }
+
+// GetLocale returns __ListTranslatableResourcesInput.Locale, and is useful for accessing the field via an interface.
+func (v *__ListTranslatableResourcesInput) GetLocale() string { return v.Locale }
+
+// __PinMetafieldDefinitionInput is used internally by genqlient
+type __PinMetafieldDefinitionInput struct {
+	Identifier MetafieldDefinitionIdentifierInput `json:"identifier"`
+}
+
+// GetIdentifier returns __PinMetafieldDefinitionInput.Identifier, and is useful for accessing the field via an interface.
+func (v *__PinMetafieldDefinitionInput) GetIdentifier() MetafieldDefinitionIdentifierInput {
+	return v.Identifier
+}
+
+// __RegisterTranslationsInput is used internally by genqlient
+type __RegisterTranslationsInput struct {
+	ResourceId   string             `json:"resourceId"`
+	Translations []TranslationInput `json:"translations"`
+}
+
+// GetResourceId returns __RegisterTranslationsInput.ResourceId, and is useful for accessing the field via an interface.
+func (v *__RegisterTranslationsInput) GetResourceId() string { return v.ResourceId }
+
+// GetTranslations returns __RegisterTranslationsInput.Translations, and is useful for accessing the field via an interface.
+func (v *__RegisterTranslationsInput) GetTranslations() []TranslationInput { return v.Translations }
+
+// __RunBulkMutationInput is used internally by genqlient
+type __RunBulkMutationInput struct {
+	Mutation         string `json:"mutation"`
+	StagedUploadPath string `json:"stagedUploadPath"`
+}
+
+// GetMutation returns __RunBulkMutationInput.Mutation, and is useful for accessing the field via an interface.
+func (v *__RunBulkMutationInput) GetMutation() string { return v.Mutation }
+
+// GetStagedUploadPath returns __RunBulkMutationInput.StagedUploadPath, and is useful for accessing the field via an interface.
+func (v *__RunBulkMutationInput) GetStagedUploadPath() string { return v.StagedUploadPath }
+
+// __SearchTaxonomyCategoriesInput is used internally by genqlient
+type __SearchTaxonomyCategoriesInput struct {
+	Search string `json:"search"`
+}
+
+// GetSearch returns __SearchTaxonomyCategoriesInput.Search, and is useful for accessing the field via an interface.
+func (v *__SearchTaxonomyCategoriesInput) GetSearch() string { return v.Search }
+
+// __SetMetafieldsInput is used internally by genqlient
+type __SetMetafieldsInput struct {
+	Metafields []MetafieldsSetInput `json:"metafields"`
+}
+
+// GetMetafields returns __SetMetafieldsInput.Metafields, and is useful for accessing the field via an interface.
+func (v *__SetMetafieldsInput) GetMetafields() []MetafieldsSetInput { return v.Metafields }
+
+// __UnpinMetafieldDefinitionInput is used internally by genqlient
+type __UnpinMetafieldDefinitionInput struct {
+	Identifier MetafieldDefinitionIdentifierInput `json:"identifier"`
+}
+
+// GetIdentifier returns __UnpinMetafieldDefinitionInput.Identifier, and is useful for accessing the field via an interface.
+func (v *__UnpinMetafieldDefinitionInput) GetIdentifier() MetafieldDefinitionIdentifierInput {
+	return v.Identifier
+}
+
+// __UpdateMetafieldDefinitionInput is used internally by genqlient
+type __UpdateMetafieldDefinitionInput struct {
+	Definition MetafieldDefinitionUpdateInput `json:"definition"`
+}
+
+// GetDefinition returns __UpdateMetafieldDefinitionInput.Definition, and is useful for accessing the field via an interface.
+func (v *__UpdateMetafieldDefinitionInput) GetDefinition() MetafieldDefinitionUpdateInput {
+	return v.Definition
+}
+
+// __UpdateMetaobjectDefinitionInput is used internally by genqlient
+type __UpdateMetaobjectDefinitionInput struct {
+	Id         string                          `json:"id"`
+	Definition MetaobjectDefinitionUpdateInput `json:"definition"`
+}
+
+// GetId returns __UpdateMetaobjectDefinitionInput.Id, and is useful for accessing the field via an interface.
+func (v *__UpdateMetaobjectDefinitionInput) GetId() string { return v.Id }
+
+// GetDefinition returns __UpdateMetaobjectDefinitionInput.Definition, and is useful for accessing the field via an interface.
+func (v *__UpdateMetaobjectDefinitionInput) GetDefinition() MetaobjectDefinitionUpdateInput {
+	return v.Definition
+}
+
+// __UpdateMetaobjectInput is used internally by genqlient
+type __UpdateMetaobjectInput struct {
+	Id         string                `json:"id"`
+	Metaobject MetaobjectUpdateInput `json:"metaobject"`
+}
+
+// GetId returns __UpdateMetaobjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__UpdateMetaobjectInput) GetId() string { return v.Id }
+
+// GetMetaobject returns __UpdateMetaobjectInput.Metaobject, and is useful for accessing the field via an interface.
+func (v *__UpdateMetaobjectInput) GetMetaobject() MetaobjectUpdateInput { return v.Metaobject }
+
+// __UpsertMetaobjectInput is used internally by genqlient
+type __UpsertMetaobjectInput struct {
+	Handle     MetaobjectHandleInput `json:"handle"`
+	Metaobject MetaobjectUpsertInput `json:"metaobject"`
+}
+
+// GetHandle returns __UpsertMetaobjectInput.Handle, and is useful for accessing the field via an interface.
+func (v *__UpsertMetaobjectInput) GetHandle() MetaobjectHandleInput { return v.Handle }
+
+// GetMetaobject returns __UpsertMetaobjectInput.Metaobject, and is useful for accessing the field via an interface.
+func (v *__UpsertMetaobjectInput) GetMetaobject() MetaobjectUpsertInput { return v.Metaobject }
+
+// The mutation executed by BulkDeleteMetaobjects.
+const BulkDeleteMetaobjects_Operation = `
+mutation BulkDeleteMetaobjects ($ids: [ID!]!) {
+	metaobjectBulkDelete(where: {ids:$ids}) {
+		job {
+			id
+			done
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
+func BulkDeleteMetaobjects(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	ids []string,
+) (data_ *BulkDeleteMetaobjectsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "BulkDeleteMetaobjects",
+		Query:  BulkDeleteMetaobjects_Operation,
+		Variables: &__BulkDeleteMetaobjectsInput{
+			Ids: ids,
+		},
+	}
+
+	data_ = &BulkDeleteMetaobjectsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateFiles.
+const CreateFiles_Operation = `
+mutation CreateFiles ($files: [FileCreateInput!]!) {
+	fileCreate(files: $files) {
+		files {
+			__typename
+			... Cli_File
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+fragment Cli_File on File {
+	id
+	fileStatus
+	fileErrors {
+		message
+	}
+	... on GenericFile {
+		url
+	}
+	... on MediaImage {
+		image {
+			url
+		}
+	}
+	... on Video {
+		filename
+	}
+}
+`
+
+func CreateFiles(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	files []FileCreateInput,
+) (data_ *CreateFilesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateFiles",
+		Query:  CreateFiles_Operation,
+		Variables: &__CreateFilesInput{
+			Files: files,
+		},
+	}
+
+	data_ = &CreateFilesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateMetafieldDefinition.
+const CreateMetafieldDefinition_Operation = `
+mutation CreateMetafieldDefinition ($definition: MetafieldDefinitionInput!) {
+	metafieldDefinitionCreate(definition: $definition) {
+		createdDefinition {
+			id
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
+func CreateMetafieldDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	definition MetafieldDefinitionInput,
+) (data_ *CreateMetafieldDefinitionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateMetafieldDefinition",
+		Query:  CreateMetafieldDefinition_Operation,
+		Variables: &__CreateMetafieldDefinitionInput{
+			Definition: definition,
+		},
+	}
+
+	data_ = &CreateMetafieldDefinitionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateMetaobjectDefinition.
+const CreateMetaobjectDefinition_Operation = `
+mutation CreateMetaobjectDefinition ($definition: MetaobjectDefinitionCreateInput!) {
+	metaobjectDefinitionCreate(definition: $definition) {
+		metaobjectDefinition {
+			id
+			type
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
+func CreateMetaobjectDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	definition MetaobjectDefinitionCreateInput,
+) (data_ *CreateMetaobjectDefinitionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateMetaobjectDefinition",
+		Query:  CreateMetaobjectDefinition_Operation,
+		Variables: &__CreateMetaobjectDefinitionInput{
+			Definition: definition,
+		},
+	}
+
+	data_ = &CreateMetaobjectDefinitionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateStagedUploads.
+const CreateStagedUploads_Operation = `
+mutation CreateStagedUploads ($input: [StagedUploadInput!]!) {
+	stagedUploadsCreate(input: $input) {
+		stagedTargets {
+			url
+			resourceUrl
+			parameters {
+				name
+				value
+			}
+		}
+		userErrors {
+			field
+			message
+		}
+	}
+}
+`
+
+func CreateStagedUploads(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input []StagedUploadInput,
+) (data_ *CreateStagedUploadsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateStagedUploads",
+		Query:  CreateStagedUploads_Operation,
+		Variables: &__CreateStagedUploadsInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateStagedUploadsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by DeleteMetafields.
+const DeleteMetafields_Operation = `
+mutation DeleteMetafields ($metafields: [MetafieldIdentifierInput!]!) {
+	metafieldsDelete(metafields: $metafields) {
+		deletedMetafields {
+			key
+		}
+		userErrors {
+			field
+			message
+		}
+	}
+}
+`
+
+func DeleteMetafields(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	metafields []MetafieldIdentifierInput,
+) (data_ *DeleteMetafieldsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteMetafields",
+		Query:  DeleteMetafields_Operation,
+		Variables: &__DeleteMetafieldsInput{
+			Metafields: metafields,
+		},
+	}
+
+	data_ = &DeleteMetafieldsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by DeleteMetaobject.
+const DeleteMetaobject_Operation = `
+mutation DeleteMetaobject ($id: ID!) {
+	metaobjectDelete(id: $id) {
+		deletedId
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
+func DeleteMetaobject(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *DeleteMetaobjectResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteMetaobject",
+		Query:  DeleteMetaobject_Operation,
+		Variables: &__DeleteMetaobjectInput{
+			Id: id,
+		},
+	}
+
+	data_ = &DeleteMetaobjectResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by EnableStandardMetafieldDefinition.
+const EnableStandardMetafieldDefinition_Operation = `
+mutation EnableStandardMetafieldDefinition ($ownerType: MetafieldOwnerType!, $id: ID!) {
+	standardMetafieldDefinitionEnable(ownerType: $ownerType, id: $id) {
+		createdDefinition {
+			id
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
+func EnableStandardMetafieldDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	ownerType MetafieldOwnerType,
+	id string,
+) (data_ *EnableStandardMetafieldDefinitionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "EnableStandardMetafieldDefinition",
+		Query:  EnableStandardMetafieldDefinition_Operation,
+		Variables: &__EnableStandardMetafieldDefinitionInput{
+			OwnerType: ownerType,
+			Id:        id,
+		},
+	}
+
+	data_ = &EnableStandardMetafieldDefinitionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by EnableStandardMetaobjectDefinition.
+const EnableStandardMetaobjectDefinition_Operation = `
+mutation EnableStandardMetaobjectDefinition ($defType: String!) {
+	standardMetaobjectDefinitionEnable(type: $defType) {
+		metaobjectDefinition {
+			id
+			type
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
+func EnableStandardMetaobjectDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	defType string,
+) (data_ *EnableStandardMetaobjectDefinitionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "EnableStandardMetaobjectDefinition",
+		Query:  EnableStandardMetaobjectDefinition_Operation,
+		Variables: &__EnableStandardMetaobjectDefinitionInput{
+			DefType: defType,
+		},
+	}
+
+	data_ = &EnableStandardMetaobjectDefinitionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by FindFiles.
+const FindFiles_Operation = `
+query FindFiles ($query: String!) {
+	files(first: 10, query: $query) {
+		nodes {
+			__typename
+			... Cli_File
+		}
+	}
+}
+fragment Cli_File on File {
+	id
+	fileStatus
+	fileErrors {
+		message
+	}
+	... on GenericFile {
+		url
+	}
+	... on MediaImage {
+		image {
+			url
+		}
+	}
+	... on Video {
+		filename
+	}
+}
+`
+
+func FindFiles(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	query string,
+) (data_ *FindFilesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "FindFiles",
+		Query:  FindFiles_Operation,
+		Variables: &__FindFilesInput{
+			Query: query,
+		},
+	}
+
+	data_ = &FindFilesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by FindProductVariants.
+const FindProductVariants_Operation = `
+query FindProductVariants ($query: String!) {
+	productVariants(first: 10, query: $query) {
+		nodes {
+			id
+			sku
+		}
+	}
+}
+`
+
+func FindProductVariants(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	query string,
+) (data_ *FindProductVariantsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "FindProductVariants",
+		Query:  FindProductVariants_Operation,
+		Variables: &__FindProductVariantsInput{
+			Query: query,
+		},
+	}
+
+	data_ = &FindProductVariantsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetCollectionByHandle.
+const GetCollectionByHandle_Operation = `
+query GetCollectionByHandle ($handle: String!) {
+	collectionByIdentifier(identifier: {handle:$handle}) {
+		id
+	}
+}
+`
+
+func GetCollectionByHandle(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	handle string,
+) (data_ *GetCollectionByHandleResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetCollectionByHandle",
+		Query:  GetCollectionByHandle_Operation,
+		Variables: &__GetCollectionByHandleInput{
+			Handle: handle,
+		},
+	}
+
+	data_ = &GetCollectionByHandleResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetCollectionMetafields.
+const GetCollectionMetafields_Operation = `
+query GetCollectionMetafields ($id: ID!, $after: String!) {
+	collection(id: $id) {
+		metafields(first: 250, after: $after) {
+			... Cli_MetafieldPage
+		}
+	}
+}
+fragment Cli_MetafieldPage on MetafieldConnection {
+	nodes {
+		... Cli_Metafield
+	}
+	pageInfo {
+		hasNextPage
+		endCursor
+	}
+}
+fragment Cli_Metafield on Metafield {
+	namespace
+	key
+	type
+	value
+}
+`
+
+func GetCollectionMetafields(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	after string,
+) (data_ *GetCollectionMetafieldsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetCollectionMetafields",
+		Query:  GetCollectionMetafields_Operation,
+		Variables: &__GetCollectionMetafieldsInput{
+			Id:    id,
+			After: after,
+		},
+	}
+
+	data_ = &GetCollectionMetafieldsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetCurrentBulkMutation.
+const GetCurrentBulkMutation_Operation = `
+query GetCurrentBulkMutation {
+	currentBulkOperation(type: MUTATION) {
+		... Cli_BulkOperation
+	}
+}
+fragment Cli_BulkOperation on BulkOperation {
+	id
+	status
+	errorCode
+	objectCount
+	url
+	partialDataUrl
+}
+`
+
+func GetCurrentBulkMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *GetCurrentBulkMutationResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetCurrentBulkMutation",
+		Query:  GetCurrentBulkMutation_Operation,
+	}
+
+	data_ = &GetCurrentBulkMutationResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetJob.
+const GetJob_Operation = `
+query GetJob ($id: ID!) {
+	job(id: $id) {
+		id
+		done
+	}
+}
+`
+
+func GetJob(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetJobResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetJob",
+		Query:  GetJob_Operation,
+		Variables: &__GetJobInput{
+			Id: id,
+		},
+	}
+
+	data_ = &GetJobResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetMetaobjectByHandle.
+const GetMetaobjectByHandle_Operation = `
+query GetMetaobjectByHandle ($handle: MetaobjectHandleInput!) {
+	metaobjectByHandle(handle: $handle) {
+		id
+	}
+}
+`
+
+func GetMetaobjectByHandle(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	handle MetaobjectHandleInput,
+) (data_ *GetMetaobjectByHandleResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetMetaobjectByHandle",
+		Query:  GetMetaobjectByHandle_Operation,
+		Variables: &__GetMetaobjectByHandleInput{
+			Handle: handle,
+		},
+	}
+
+	data_ = &GetMetaobjectByHandleResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetMetaobjectDefinitionByType.
+const GetMetaobjectDefinitionByType_Operation = `
+query GetMetaobjectDefinitionByType ($defType: String!) {
+	metaobjectDefinitionByType(type: $defType) {
+		... Cli_MetaobjectDefinition
+	}
+}
+fragment Cli_MetaobjectDefinition on MetaobjectDefinition {
+	access {
+		admin
+		storefront
+	}
+	capabilities {
+		onlineStore {
+			data {
+				canCreateRedirects
+				urlHandle
+			}
+			enabled
+		}
+		publishable {
+			enabled
+		}
+		renderable {
+			enabled
+			data {
+				metaDescriptionKey
+				metaTitleKey
+			}
+		}
+		translatable {
+			enabled
+		}
+	}
+	description
+	displayNameKey
+	fieldDefinitions {
+		description
+		key
+		name
+		required
+		type {
+			category
+			name
+		}
+		validations {
+			name
+			value
+		}
+	}
+	id
+	metaobjectsCount
+	name
+	standardTemplate {
+		type
+	}
+	type
+}
+`
+
+func GetMetaobjectDefinitionByType(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	defType string,
+) (data_ *GetMetaobjectDefinitionByTypeResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetMetaobjectDefinitionByType",
+		Query:  GetMetaobjectDefinitionByType_Operation,
+		Variables: &__GetMetaobjectDefinitionByTypeInput{
+			DefType: defType,
+		},
+	}
+
+	data_ = &GetMetaobjectDefinitionByTypeResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetMetaobjectReferencedBy.
+const GetMetaobjectReferencedBy_Operation = `
+query GetMetaobjectReferencedBy ($id: ID!) {
+	metaobject(id: $id) {
+		referencedBy(first: 50) {
+			nodes {
+				namespace
+				key
+				referencer {
+					__typename
+					... Cli_Referencer
+				}
+			}
+		}
+	}
+}
+fragment Cli_Referencer on MetafieldReferencer {
+	__typename
+	... on Metaobject {
+		type
+		handle
+	}
+	... on Product {
+		handle
+	}
+	... on Collection {
+		handle
+	}
+	... on ProductVariant {
+		sku
+	}
+}
+`
+
+func GetMetaobjectReferencedBy(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetMetaobjectReferencedByResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetMetaobjectReferencedBy",
+		Query:  GetMetaobjectReferencedBy_Operation,
+		Variables: &__GetMetaobjectReferencedByInput{
+			Id: id,
+		},
+	}
+
+	data_ = &GetMetaobjectReferencedByResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetProductByHandle.
+const GetProductByHandle_Operation = `
+query GetProductByHandle ($handle: String!) {
+	productByIdentifier(identifier: {handle:$handle}) {
+		id
+	}
+}
+`
+
+func GetProductByHandle(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	handle string,
+) (data_ *GetProductByHandleResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetProductByHandle",
+		Query:  GetProductByHandle_Operation,
+		Variables: &__GetProductByHandleInput{
+			Handle: handle,
+		},
+	}
+
+	data_ = &GetProductByHandleResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetProductMetafields.
+const GetProductMetafields_Operation = `
+query GetProductMetafields ($id: ID!, $after: String!) {
+	product(id: $id) {
+		metafields(first: 250, after: $after) {
+			... Cli_MetafieldPage
+		}
+	}
+}
+fragment Cli_MetafieldPage on MetafieldConnection {
+	nodes {
+		... Cli_Metafield
+	}
+	pageInfo {
+		hasNextPage
+		endCursor
+	}
+}
+fragment Cli_Metafield on Metafield {
+	namespace
+	key
+	type
+	value
+}
+`
+
+func GetProductMetafields(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	after string,
+) (data_ *GetProductMetafieldsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetProductMetafields",
+		Query:  GetProductMetafields_Operation,
+		Variables: &__GetProductMetafieldsInput{
+			Id:    id,
+			After: after,
+		},
+	}
+
+	data_ = &GetProductMetafieldsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetReferences.
+const GetReferences_Operation = `
+query GetReferences ($ids: [ID!]!) {
+	nodes(ids: $ids) {
+		__typename
+		id
+		... Cli_Reference
+	}
+}
+fragment Cli_Reference on MetafieldReference {
+	... on Metaobject {
+		id
+		type
+		handle
+	}
+	... on Product {
+		id
+		handle
+	}
+	... on Collection {
+		id
+		handle
+	}
+	... on ProductVariant {
+		id
+		sku
+	}
+	... on GenericFile {
+		id
+		url
+	}
+	... on MediaImage {
+		id
+		image {
+			url
+		}
+	}
+	... on Video {
+		id
+		filename
+	}
+}
+`
+
+func GetReferences(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	ids []string,
+) (data_ *GetReferencesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetReferences",
+		Query:  GetReferences_Operation,
+		Variables: &__GetReferencesInput{
+			Ids: ids,
+		},
+	}
+
+	data_ = &GetReferencesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListCollectionMetafields.
+const ListCollectionMetafields_Operation = `
+query ListCollectionMetafields ($first: Int!, $after: String) {
+	collections(first: $first, after: $after) {
+		nodes {
+			id
+			handle
+			metafields(first: 25) {
+				... Cli_MetafieldPage
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment Cli_MetafieldPage on MetafieldConnection {
+	nodes {
+		... Cli_Metafield
+	}
+	pageInfo {
+		hasNextPage
+		endCursor
+	}
+}
+fragment Cli_Metafield on Metafield {
+	namespace
+	key
+	type
+	value
+}
+`
+
+func ListCollectionMetafields(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first int,
+	after string,
+) (data_ *ListCollectionMetafieldsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListCollectionMetafields",
+		Query:  ListCollectionMetafields_Operation,
+		Variables: &__ListCollectionMetafieldsInput{
+			First: first,
+			After: after,
+		},
+	}
+
+	data_ = &ListCollectionMetafieldsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListMetafieldDefinitions.
+const ListMetafieldDefinitions_Operation = `
+query ListMetafieldDefinitions ($ownerType: MetafieldOwnerType!, $first: Int!, $after: String) {
+	metafieldDefinitions(ownerType: $ownerType, first: $first, after: $after) {
+		nodes {
+			... Cli_MetafieldDefinition
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment Cli_MetafieldDefinition on MetafieldDefinition {
+	id
+	namespace
+	key
+	name
+	description
+	ownerType
+	pinnedPosition
+	standardTemplate {
+		id
+	}
+	type {
+		name
+	}
+	validations {
+		name
+		value
+	}
+	access {
+		admin
+		storefront
+		customerAccount
+	}
+	capabilities {
+		adminFilterable {
+			enabled
+		}
+		smartCollectionCondition {
+			enabled
+		}
+		uniqueValues {
+			enabled
+		}
+	}
+	constraints {
+		key
+		values(first: 250) {
+			nodes {
+				value
+			}
+		}
+	}
+}
+`
+
+func ListMetafieldDefinitions(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	ownerType MetafieldOwnerType,
+	first int,
+	after string,
+) (data_ *ListMetafieldDefinitionsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListMetafieldDefinitions",
+		Query:  ListMetafieldDefinitions_Operation,
+		Variables: &__ListMetafieldDefinitionsInput{
+			OwnerType: ownerType,
+			First:     first,
+			After:     after,
+		},
+	}
+
+	data_ = &ListMetafieldDefinitionsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListMetaobjectDefinitions.
+const ListMetaobjectDefinitions_Operation = `
+query ListMetaobjectDefinitions ($first: Int!) {
+	metaobjectDefinitions(first: $first) {
+		nodes {
+			... Cli_MetaobjectDefinition
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment Cli_MetaobjectDefinition on MetaobjectDefinition {
+	access {
+		admin
+		storefront
+	}
+	capabilities {
+		onlineStore {
+			data {
+				canCreateRedirects
+				urlHandle
+			}
+			enabled
+		}
+		publishable {
+			enabled
+		}
+		renderable {
+			enabled
+			data {
+				metaDescriptionKey
+				metaTitleKey
+			}
+		}
+		translatable {
+			enabled
+		}
+	}
+	description
+	displayNameKey
+	fieldDefinitions {
+		description
+		key
+		name
+		required
+		type {
+			category
+			name
+		}
+		validations {
+			name
+			value
+		}
+	}
+	id
+	metaobjectsCount
+	name
+	standardTemplate {
+		type
+	}
+	type
+}
+`
+
+func ListMetaobjectDefinitions(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first int,
+) (data_ *ListMetaobjectDefinitionsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListMetaobjectDefinitions",
+		Query:  ListMetaobjectDefinitions_Operation,
+		Variables: &__ListMetaobjectDefinitionsInput{
+			First: first,
+		},
+	}
+
+	data_ = &ListMetaobjectDefinitionsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListMetaobjectFieldValues.
+const ListMetaobjectFieldValues_Operation = `
+query ListMetaobjectFieldValues ($defType: String!, $first: Int!, $after: String) {
+	metaobjects(type: $defType, first: $first, after: $after) {
+		nodes {
+			... Cli_MetaobjectFieldValues
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment Cli_MetaobjectFieldValues on Metaobject {
+	fields {
+		key
+		value
+	}
+}
+`
+
+func ListMetaobjectFieldValues(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	defType string,
+	first int,
+	after string,
+) (data_ *ListMetaobjectFieldValuesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListMetaobjectFieldValues",
+		Query:  ListMetaobjectFieldValues_Operation,
+		Variables: &__ListMetaobjectFieldValuesInput{
+			DefType: defType,
+			First:   first,
+			After:   after,
+		},
+	}
+
+	data_ = &ListMetaobjectFieldValuesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListMetaobjects.
+const ListMetaobjects_Operation = `
+query ListMetaobjects ($defType: String!, $first: Int!, $after: String) {
+	metaobjects(type: $defType, first: $first, after: $after) {
+		nodes {
+			... Cli_Metaobject
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment Cli_Metaobject on Metaobject {
+	id
+	handle
+	type
+	capabilities {
+		publishable {
+			status
+		}
+	}
+	fields {
+		key
+		type
+		value
+	}
+}
+`
+
+func ListMetaobjects(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	defType string,
+	first int,
+	after string,
+) (data_ *ListMetaobjectsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListMetaobjects",
+		Query:  ListMetaobjects_Operation,
+		Variables: &__ListMetaobjectsInput{
+			DefType: defType,
+			First:   first,
+			After:   after,
+		},
+	}
+
+	data_ = &ListMetaobjectsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListProductMetafields.
+const ListProductMetafields_Operation = `
+query ListProductMetafields ($first: Int!, $after: String) {
+	products(first: $first, after: $after) {
+		nodes {
+			id
+			handle
+			metafields(first: 25) {
+				... Cli_MetafieldPage
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment Cli_MetafieldPage on MetafieldConnection {
+	nodes {
+		... Cli_Metafield
+	}
+	pageInfo {
+		hasNextPage
+		endCursor
+	}
+}
+fragment Cli_Metafield on Metafield {
+	namespace
+	key
+	type
+	value
+}
+`
+
+func ListProductMetafields(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first int,
+	after string,
+) (data_ *ListProductMetafieldsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListProductMetafields",
+		Query:  ListProductMetafields_Operation,
+		Variables: &__ListProductMetafieldsInput{
+			First: first,
+			After: after,
+		},
+	}
+
+	data_ = &ListProductMetafieldsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListShopLocales.
+const ListShopLocales_Operation = `
+query ListShopLocales {
+	shopLocales {
+		locale
+		primary
+		published
+	}
+}
+`
+
+func ListShopLocales(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *ListShopLocalesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListShopLocales",
+		Query:  ListShopLocales_Operation,
+	}
+
+	data_ = &ListShopLocalesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListStandardMetafieldDefinitionTemplates.
+const ListStandardMetafieldDefinitionTemplates_Operation = `
+query ListStandardMetafieldDefinitionTemplates ($first: Int!, $after: String) {
+	standardMetafieldDefinitionTemplates(first: $first, after: $after) {
+		nodes {
+			id
+			namespace
+			key
+			name
+			description
+			ownerTypes
+			type {
+				name
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
+
+func ListStandardMetafieldDefinitionTemplates(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first int,
+	after string,
+) (data_ *ListStandardMetafieldDefinitionTemplatesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListStandardMetafieldDefinitionTemplates",
+		Query:  ListStandardMetafieldDefinitionTemplates_Operation,
+		Variables: &__ListStandardMetafieldDefinitionTemplatesInput{
+			First: first,
+			After: after,
+		},
+	}
+
+	data_ = &ListStandardMetafieldDefinitionTemplatesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListTranslatableResources.
+const ListTranslatableResources_Operation = `
+query ListTranslatableResources ($ids: [ID!]!, $locale: String!) {
+	translatableResourcesByIds(resourceIds: $ids, first: 250) {
+		nodes {
+			resourceId
+			translatableContent {
+				key
+				value
+				digest
+			}
+			translations(locale: $locale) {
+				key
+				value
+				outdated
+			}
+		}
+	}
+}
+`
+
+func ListTranslatableResources(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	ids []string,
+	locale string,
+) (data_ *ListTranslatableResourcesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListTranslatableResources",
+		Query:  ListTranslatableResources_Operation,
+		Variables: &__ListTranslatableResourcesInput{
+			Ids:    ids,
+			Locale: locale,
+		},
+	}
+
+	data_ = &ListTranslatableResourcesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by PinMetafieldDefinition.
+const PinMetafieldDefinition_Operation = `
+mutation PinMetafieldDefinition ($identifier: MetafieldDefinitionIdentifierInput!) {
+	metafieldDefinitionPin(identifier: $identifier) {
+		pinnedDefinition {
+			id
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
+func PinMetafieldDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	identifier MetafieldDefinitionIdentifierInput,
+) (data_ *PinMetafieldDefinitionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "PinMetafieldDefinition",
+		Query:  PinMetafieldDefinition_Operation,
+		Variables: &__PinMetafieldDefinitionInput{
+			Identifier: identifier,
+		},
+	}
+
+	data_ = &PinMetafieldDefinitionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by RegisterTranslations.
+const RegisterTranslations_Operation = `
+mutation RegisterTranslations ($resourceId: ID!, $translations: [TranslationInput!]!) {
+	translationsRegister(resourceId: $resourceId, translations: $translations) {
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
+func RegisterTranslations(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	resourceId string,
+	translations []TranslationInput,
+) (data_ *RegisterTranslationsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RegisterTranslations",
+		Query:  RegisterTranslations_Operation,
+		Variables: &__RegisterTranslationsInput{
+			ResourceId:   resourceId,
+			Translations: translations,
+		},
+	}
+
+	data_ = &RegisterTranslationsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by RunBulkMutation.
+const RunBulkMutation_Operation = `
+mutation RunBulkMutation ($mutation: String!, $stagedUploadPath: String!) {
+	bulkOperationRunMutation(mutation: $mutation, stagedUploadPath: $stagedUploadPath) {
+		bulkOperation {
+			... Cli_BulkOperation
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
 }
-
-// __CreateMetaobjectDefinitionInput is used internally by genqlient
-type __CreateMetaobjectDefinitionInput struct {
-	Definition MetaobjectDefinitionCreateInput `json:"definition"`
+fragment Cli_BulkOperation on BulkOperation {
+	id
+	status
+	errorCode
+	objectCount
+	url
+	partialDataUrl
 }
+`
 
-// GetDefinition returns __CreateMetaobjectDefinitionInput.Definition, and is useful for accessing the field via an interface.
-func (v *__CreateMetaobjectDefinitionInput) GetDefinition() MetaobjectDefinitionCreateInput {
-	return v.Definition
-}
+func RunBulkMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	mutation string,
+	stagedUploadPath string,
+) (data_ *RunBulkMutationResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RunBulkMutation",
+		Query:  RunBulkMutation_Operation,
+		Variables: &__RunBulkMutationInput{
+			Mutation:         mutation,
+			StagedUploadPath: stagedUploadPath,
+		},
+	}
 
-// __GetMetaobjectDefinitionByTypeInput is used internally by genqlient
-type __GetMetaobjectDefinitionByTypeInput struct {
-	DefType string `json:"defType"`
-}
+	data_ = &RunBulkMutationResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// GetDefType returns __GetMetaobjectDefinitionByTypeInput.DefType, and is useful for accessing the field via an interface.
-func (v *__GetMetaobjectDefinitionByTypeInput) GetDefType() string { return v.DefType }
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// __ListMetaobjectDefinitionsInput is used internally by genqlient
-type __ListMetaobjectDefinitionsInput struct {
-	First int `json:"first"`
+	return data_, err_
 }
 
-// GetFirst returns __ListMetaobjectDefinitionsInput.First, and is useful for accessing the field via an interface.
-func (v *__ListMetaobjectDefinitionsInput) GetFirst() int { return v.First }
-
-// __UpdateMetaobjectDefinitionInput is used internally by genqlient
-type __UpdateMetaobjectDefinitionInput struct {
-	Id         string                          `json:"id"`
-	Definition MetaobjectDefinitionUpdateInput `json:"definition"`
+// The query executed by SearchTaxonomyCategories.
+const SearchTaxonomyCategories_Operation = `
+query SearchTaxonomyCategories ($search: String!) {
+	taxonomy {
+		categories(search: $search, first: 250) {
+			nodes {
+				id
+				name
+				fullName
+			}
+		}
+	}
 }
+`
 
-// GetId returns __UpdateMetaobjectDefinitionInput.Id, and is useful for accessing the field via an interface.
-func (v *__UpdateMetaobjectDefinitionInput) GetId() string { return v.Id }
+func SearchTaxonomyCategories(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	search string,
+) (data_ *SearchTaxonomyCategoriesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SearchTaxonomyCategories",
+		Query:  SearchTaxonomyCategories_Operation,
+		Variables: &__SearchTaxonomyCategoriesInput{
+			Search: search,
+		},
+	}
 
-// GetDefinition returns __UpdateMetaobjectDefinitionInput.Definition, and is useful for accessing the field via an interface.
-func (v *__UpdateMetaobjectDefinitionInput) GetDefinition() MetaobjectDefinitionUpdateInput {
-	return v.Definition
+	data_ = &SearchTaxonomyCategoriesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
 }
 
-// The mutation executed by CreateMetaobjectDefinition.
-const CreateMetaobjectDefinition_Operation = `
-mutation CreateMetaobjectDefinition ($definition: MetaobjectDefinitionCreateInput!) {
-	metaobjectDefinitionCreate(definition: $definition) {
-		metaobjectDefinition {
+// The mutation executed by SetMetafields.
+const SetMetafields_Operation = `
+mutation SetMetafields ($metafields: [MetafieldsSetInput!]!) {
+	metafieldsSet(metafields: $metafields) {
+		metafields {
 			id
-			type
 		}
 		userErrors {
 			field
 			message
 			code
+			elementIndex
 		}
 	}
 }
 `
 
-func CreateMetaobjectDefinition(
+func SetMetafields(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	definition MetaobjectDefinitionCreateInput,
-) (data_ *CreateMetaobjectDefinitionResponse, err_ error) {
+	metafields []MetafieldsSetInput,
+) (data_ *SetMetafieldsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "CreateMetaobjectDefinition",
-		Query:  CreateMetaobjectDefinition_Operation,
-		Variables: &__CreateMetaobjectDefinitionInput{
-			Definition: definition,
+		OpName: "SetMetafields",
+		Query:  SetMetafields_Operation,
+		Variables: &__SetMetafieldsInput{
+			Metafields: metafields,
 		},
 	}
 
-	data_ = &CreateMetaobjectDefinitionResponse{}
+	data_ = &SetMetafieldsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1077,76 +8497,36 @@ func CreateMetaobjectDefinition(
 	return data_, err_
 }
 
-// The query executed by GetMetaobjectDefinitionByType.
-const GetMetaobjectDefinitionByType_Operation = `
-query GetMetaobjectDefinitionByType ($defType: String!) {
-	metaobjectDefinitionByType(type: $defType) {
-		... Cli_MetaobjectDefinition
-	}
-}
-fragment Cli_MetaobjectDefinition on MetaobjectDefinition {
-	access {
-		admin
-		storefront
-	}
-	capabilities {
-		onlineStore {
-			data {
-				canCreateRedirects
-				urlHandle
-			}
-			enabled
-		}
-		publishable {
-			enabled
-		}
-		renderable {
-			enabled
-			data {
-				metaDescriptionKey
-				metaTitleKey
-			}
-		}
-		translatable {
-			enabled
-		}
-	}
-	description
-	displayNameKey
-	fieldDefinitions {
-		description
-		key
-		name
-		required
-		type {
-			category
-			name
+// The mutation executed by UnpinMetafieldDefinition.
+const UnpinMetafieldDefinition_Operation = `
+mutation UnpinMetafieldDefinition ($identifier: MetafieldDefinitionIdentifierInput!) {
+	metafieldDefinitionUnpin(identifier: $identifier) {
+		unpinnedDefinition {
+			id
 		}
-		validations {
-			name
-			value
+		userErrors {
+			field
+			message
+			code
 		}
 	}
-	id
-	name
-	type
 }
 `
 
-func GetMetaobjectDefinitionByType(
+func UnpinMetafieldDefinition(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	defType string,
-) (data_ *GetMetaobjectDefinitionByTypeResponse, err_ error) {
+	identifier MetafieldDefinitionIdentifierInput,
+) (data_ *UnpinMetafieldDefinitionResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetMetaobjectDefinitionByType",
-		Query:  GetMetaobjectDefinitionByType_Operation,
-		Variables: &__GetMetaobjectDefinitionByTypeInput{
-			DefType: defType,
+		OpName: "UnpinMetafieldDefinition",
+		Query:  UnpinMetafieldDefinition_Operation,
+		Variables: &__UnpinMetafieldDefinitionInput{
+			Identifier: identifier,
 		},
 	}
 
-	data_ = &GetMetaobjectDefinitionByTypeResponse{}
+	data_ = &UnpinMetafieldDefinitionResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1158,82 +8538,80 @@ func GetMetaobjectDefinitionByType(
 	return data_, err_
 }
 
-// The query executed by ListMetaobjectDefinitions.
-const ListMetaobjectDefinitions_Operation = `
-query ListMetaobjectDefinitions ($first: Int!) {
-	metaobjectDefinitions(first: $first) {
-		nodes {
-			... Cli_MetaobjectDefinition
+// The mutation executed by UpdateMetafieldDefinition.
+const UpdateMetafieldDefinition_Operation = `
+mutation UpdateMetafieldDefinition ($definition: MetafieldDefinitionUpdateInput!) {
+	metafieldDefinitionUpdate(definition: $definition) {
+		updatedDefinition {
+			id
 		}
-		pageInfo {
-			hasNextPage
-			endCursor
+		userErrors {
+			field
+			message
+			code
 		}
 	}
 }
-fragment Cli_MetaobjectDefinition on MetaobjectDefinition {
-	access {
-		admin
-		storefront
-	}
-	capabilities {
-		onlineStore {
-			data {
-				canCreateRedirects
-				urlHandle
-			}
-			enabled
-		}
-		publishable {
-			enabled
-		}
-		renderable {
-			enabled
-			data {
-				metaDescriptionKey
-				metaTitleKey
-			}
-		}
-		translatable {
-			enabled
-		}
+`
+
+func UpdateMetafieldDefinition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	definition MetafieldDefinitionUpdateInput,
+) (data_ *UpdateMetafieldDefinitionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateMetafieldDefinition",
+		Query:  UpdateMetafieldDefinition_Operation,
+		Variables: &__UpdateMetafieldDefinitionInput{
+			Definition: definition,
+		},
 	}
-	description
-	displayNameKey
-	fieldDefinitions {
-		description
-		key
-		name
-		required
-		type {
-			category
-			name
+
+	data_ = &UpdateMetafieldDefinitionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UpdateMetaobject.
+const UpdateMetaobject_Operation = `
+mutation UpdateMetaobject ($id: ID!, $metaobject: MetaobjectUpdateInput!) {
+	metaobjectUpdate(id: $id, metaobject: $metaobject) {
+		metaobject {
+			id
+			handle
 		}
-		validations {
-			name
-			value
+		userErrors {
+			field
+			message
+			code
 		}
 	}
-	id
-	name
-	type
 }
 `
 
-func ListMetaobjectDefinitions(
+func UpdateMetaobject(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	first int,
-) (data_ *ListMetaobjectDefinitionsResponse, err_ error) {
+	id string,
+	metaobject MetaobjectUpdateInput,
+) (data_ *UpdateMetaobjectResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListMetaobjectDefinitions",
-		Query:  ListMetaobjectDefinitions_Operation,
-		Variables: &__ListMetaobjectDefinitionsInput{
-			First: first,
+		OpName: "UpdateMetaobject",
+		Query:  UpdateMetaobject_Operation,
+		Variables: &__UpdateMetaobjectInput{
+			Id:         id,
+			Metaobject: metaobject,
 		},
 	}
 
-	data_ = &ListMetaobjectDefinitionsResponse{}
+	data_ = &UpdateMetaobjectResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1288,3 +8666,47 @@ func UpdateMetaobjectDefinition(
 
 	return data_, err_
 }
+
+// The mutation executed by UpsertMetaobject.
+const UpsertMetaobject_Operation = `
+mutation UpsertMetaobject ($handle: MetaobjectHandleInput!, $metaobject: MetaobjectUpsertInput!) {
+	metaobjectUpsert(handle: $handle, metaobject: $metaobject) {
+		metaobject {
+			id
+			handle
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
+func UpsertMetaobject(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	handle MetaobjectHandleInput,
+	metaobject MetaobjectUpsertInput,
+) (data_ *UpsertMetaobjectResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpsertMetaobject",
+		Query:  UpsertMetaobject_Operation,
+		Variables: &__UpsertMetaobjectInput{
+			Handle:     handle,
+			Metaobject: metaobject,
+		},
+	}
+
+	data_ = &UpsertMetaobjectResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
@@ -44,3 +44,18 @@ type MetaobjectFieldDefinitionDeleteInput struct {
 	// The key of the field definition to delete.
 	Key string `json:"key"`
 }
+
+// ReferenceNode is a resource a reference field points at, as looked up by
+// GetReferences. Only the fields selected for its type are set.
+type ReferenceNode struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Handle   string `json:"handle"`
+	Sku      string `json:"sku"`
+	Url      string `json:"url"`
+	Image    struct {
+		Url string `json:"url"`
+	} `json:"image"`
+	Filename string `json:"filename"`
+}